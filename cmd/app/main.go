@@ -1,19 +1,31 @@
 package main
 
 import (
+	"context"
 	"delivery/cmd"
 	httpin "delivery/internal/adapters/in/http"
+	"delivery/internal/adapters/in/http/auth"
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/courier"
 	"delivery/internal/core/domain/model/order"
 	"delivery/internal/generated/servers"
+	"errors"
 	"fmt"
-	"github.com/google/uuid"
 	"github.com/joho/godotenv"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/robfig/cron/v3"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
 	"log"
+	"net"
 	"net/http"
 	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
 )
 
 func main() {
@@ -25,9 +37,19 @@ func main() {
 	runCronJobs(cr)
 	startKafkaConsumer(cr)
 	subscribeToOrderChangedEvents(cr)
-	startWebServer(cr, config.HttpPort)
+	subscribeToLiveUpdates(cr)
+	grpcServer := startGrpcServer(cr, config.GrpcPort)
+	webServer := startWebServer(cr, config)
+	waitForShutdown(webServer, grpcServer)
 }
 
+const (
+	// shutdownTimeout - сколько ждать завершения открытых запросов при остановке сервиса
+	shutdownTimeout = 10 * time.Second
+	// trackingRateBurst - сколько запросов к ссылке отслеживания подряд допускается с одного адреса
+	trackingRateBurst = 5
+)
+
 func getConfig() cmd.Config {
 	_ = godotenv.Load(".env")
 	// if err != nil {
@@ -36,6 +58,7 @@ func getConfig() cmd.Config {
 
 	config := cmd.Config{
 		HttpPort:                  os.Getenv("HTTP_PORT"),
+		StorageMode:               os.Getenv("STORAGE_MODE"),
 		DbHost:                    os.Getenv("DB_HOST"),
 		DbPort:                    os.Getenv("DB_PORT"),
 		DbUser:                    os.Getenv("DB_USER"),
@@ -47,11 +70,66 @@ func getConfig() cmd.Config {
 		KafkaConsumerGroup:        os.Getenv("KAFKA_CONSUMER_GROUP"),
 		KafkaBasketConfirmedTopic: os.Getenv("KAFKA_BASKET_CONFIRMED_TOPIC"),
 		KafkaOrderChangedTopic:    os.Getenv("KAFKA_ORDER_CHANGED_TOPIC"),
+		MaxDispatchAttempts:       getIntEnv("MAX_DISPATCH_ATTEMPTS", 10),
+		ServiceAreaWidth:          getIntEnv("SERVICE_AREA_WIDTH", 10),
+		ServiceAreaHeight:         getIntEnv("SERVICE_AREA_HEIGHT", 10),
+		ServiceAreaExcludedCells:  os.Getenv("SERVICE_AREA_EXCLUDED_CELLS"),
+		DistanceMode:              os.Getenv("DISTANCE_MODE"),
+		GeoOriginLat:              getFloatEnv("GEO_ORIGIN_LAT", 0),
+		GeoOriginLon:              getFloatEnv("GEO_ORIGIN_LON", 0),
+		GeoCellSizeKm:             getFloatEnv("GEO_CELL_SIZE_KM", 1),
+		CityMapFile:               os.Getenv("CITY_MAP_FILE"),
+		RandomSeed:                int64(getIntEnv("RANDOM_SEED", 0)),
+		IdleCourierReturn:         os.Getenv("IDLE_COURIER_RETURN"),
+		IdleStagingPoint:          os.Getenv("IDLE_STAGING_POINT"),
+		DeliveryConfirmation:      os.Getenv("DELIVERY_CONFIRMATION"),
+		MaxDeliveryAttempts:       getIntEnv("MAX_DELIVERY_ATTEMPTS", 3),
+		MaxShipmentVolume:         getIntEnv("MAX_SHIPMENT_VOLUME", 0),
+		MaxShipmentWeight:         getIntEnv("MAX_SHIPMENT_WEIGHT", 0),
+		CourierMovement:           os.Getenv("COURIER_MOVEMENT"),
+		GrpcPort:                  os.Getenv("GRPC_PORT"),
+		TrackingTokenSecret:       os.Getenv("TRACKING_TOKEN_SECRET"),
+		TrackingTokenTtlHours:     getIntEnv("TRACKING_TOKEN_TTL_HOURS", 168),
+		TrackingRateLimit:         getIntEnv("TRACKING_RATE_LIMIT", 60),
+		ApiKeys:                   os.Getenv("API_KEYS"),
+		JwksFile:                  os.Getenv("JWKS_FILE"),
+		JwtIssuer:                 os.Getenv("JWT_ISSUER"),
+		JwtAudience:               os.Getenv("JWT_AUDIENCE"),
+		CorsAllowedOrigins:        os.Getenv("CORS_ALLOWED_ORIGINS"),
+		OutboxRetentionHours:      getIntEnv("OUTBOX_RETENTION_HOURS", 24),
 	}
 
 	return config
 }
 
+func getIntEnv(key string, defaultValue int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	result, err := strconv.Atoi(value)
+	if err != nil {
+		log.Fatalf("invalid %s: %v", key, err)
+	}
+
+	return result
+}
+
+func getFloatEnv(key string, defaultValue float64) float64 {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	result, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		log.Fatalf("invalid %s: %v", key, err)
+	}
+
+	return result
+}
+
 func runCronJobs(cr *cmd.CompositionRoot) {
 	c := cron.New()
 
@@ -60,12 +138,21 @@ func runCronJobs(cr *cmd.CompositionRoot) {
 		log.Fatalf("ошибка при добавлении задачи: %v", err)
 	}
 
-	_, err = c.AddJob("@every 1s", cr.NewMoveCouriersJob())
+	// При перемещении по GPS координаты курьеров присылает их приложение
+	if !cr.GpsMovement() {
+		_, err = c.AddJob("@every 1s", cr.NewMoveCouriersJob())
+		if err != nil {
+			log.Fatalf("ошибка при добавлении задачи: %v", err)
+		}
+	}
+
+	// События из outbox питают и Kafka, и карту диспетчера, поэтому разбираются каждую секунду
+	_, err = c.AddJob("@every 1s", cr.NewOutboxJob())
 	if err != nil {
 		log.Fatalf("ошибка при добавлении задачи: %v", err)
 	}
 
-	_, err = c.AddJob("@every 5s", cr.NewOutboxJob())
+	_, err = c.AddJob("@hourly", cr.NewOutboxCleanupJob())
 	if err != nil {
 		log.Fatalf("ошибка при добавлении задачи: %v", err)
 	}
@@ -73,25 +160,45 @@ func runCronJobs(cr *cmd.CompositionRoot) {
 	c.Start()
 }
 
-func startWebServer(cr *cmd.CompositionRoot, port string) {
+func startWebServer(cr *cmd.CompositionRoot, config cmd.Config) *echo.Echo {
 
 	handlers, err := httpin.NewServerHandlers(
 		cr.NewAllCouriersQueryHandler(),
+		cr.NewCourierDetailsQueryHandler(),
 		cr.NewIncompleteOrdersQueryHandler(),
 		cr.NewCreateCourierCommandHandler(),
+		cr.NewGrantQualificationCommandHandler(),
+		cr.NewRevokeQualificationCommandHandler(),
 		cr.NewCreateOrderCommandHandler(),
+		cr.NewConfirmDeliveryCommandHandler(),
+		cr.NewFailDeliveryCommandHandler(),
+		cr.NewManualAssignOrderCommandHandler(),
+		cr.NewUnassignOrderCommandHandler(),
+		cr.NewReassignOrderCommandHandler(),
+		cr.NewReportCourierLocationCommandHandler(),
+		cr.NewDispatchDecisionsQueryHandler(),
+		cr.NewSimulateDispatchQueryHandler(),
+		cr.NewTrackOrderQueryHandler(),
+		cr.IDGenerator(),
 	)
 
 	if err != nil {
 		log.Fatalf("Failed to create http handlers: %v", err)
 	}
 
+	authenticator := cr.NewAuthenticator()
+
 	e := echo.New()
+	// Сервис слушает клиентов напрямую: адрес из X-Forwarded-For подделывается и обходит ограничение запросов
+	e.IPExtractor = echo.ExtractIPDirect()
 
-	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
-		AllowOrigins: []string{"*"},
-		AllowMethods: []string{echo.GET, echo.POST, echo.PUT, echo.DELETE, echo.OPTIONS},
-	}))
+	// Без списка источников CORS не подключается: echo с пустым списком разрешил бы все источники
+	if origins := parseAllowedOrigins(config.CorsAllowedOrigins); len(origins) > 0 {
+		e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
+			AllowOrigins: origins,
+			AllowMethods: []string{echo.GET, echo.POST, echo.PUT, echo.DELETE, echo.OPTIONS},
+		}))
+	}
 
 	// e.Use(middleware.LoggerWithConfig(middleware.LoggerConfig{
 	// 	Format: `{"time":"${time_rfc3339_nano}","id":"${id}","remote_ip":"${remote_ip}",` +
@@ -103,15 +210,67 @@ func startWebServer(cr *cmd.CompositionRoot, port string) {
 
 	e.Pre(middleware.RemoveTrailingSlash())
 
+	e.Use(trackingRateLimiter(config.TrackingRateLimit))
+
 	e.GET("/health", func(c echo.Context) error {
 		return c.String(http.StatusOK, "Healthy")
 	})
 
+	e.GET("/api/v1/live-updates", cr.NewLiveUpdatesHandler().Stream,
+		cr.NewRoleMiddleware(authenticator, auth.RoleAdmin, auth.RoleDispatcher))
+
 	registerSwaggerOpenApi(e)
 	registerSwaggerUi(e)
-	servers.RegisterHandlers(e, servers.NewStrictHandler(handlers, []servers.StrictMiddlewareFunc{}))
+	servers.RegisterHandlers(e, servers.NewStrictHandler(handlers, []servers.StrictMiddlewareFunc{
+		cr.NewAuthMiddleware(authenticator),
+	}))
 
-	e.Logger.Fatal(e.Start(fmt.Sprintf("0.0.0.0:%s", port)))
+	go func() {
+		err := e.Start(fmt.Sprintf("0.0.0.0:%s", config.HttpPort))
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("http server error: %v", err)
+		}
+	}()
+
+	return e
+}
+
+// parseAllowedOrigins разбирает список источников CORS через запятую. Пустой список запрещает запросы
+// с других источников
+func parseAllowedOrigins(value string) []string {
+	var origins []string
+	for _, origin := range strings.Split(value, ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			origins = append(origins, origin)
+		}
+	}
+
+	return origins
+}
+
+// trackingRateLimiter ограничивает число запросов к публичным ссылкам отслеживания с одного адреса:
+// limit запросов в минуту, короткие всплески до trackingRateBurst запросов
+func trackingRateLimiter(limit int) echo.MiddlewareFunc {
+	if limit <= 0 {
+		log.Fatalf("invalid TRACKING_RATE_LIMIT: %d", limit)
+	}
+
+	store := middleware.NewRateLimiterMemoryStoreWithConfig(middleware.RateLimiterMemoryStoreConfig{
+		Rate:      rate.Limit(float64(limit) / time.Minute.Seconds()),
+		Burst:     min(limit, trackingRateBurst),
+		ExpiresIn: time.Minute,
+	})
+
+	return middleware.RateLimiterWithConfig(middleware.RateLimiterConfig{
+		Skipper: func(c echo.Context) bool {
+			return !strings.HasPrefix(c.Request().URL.Path, "/track/")
+		},
+		Store: store,
+		DenyHandler: func(c echo.Context, _ string, _ error) error {
+			return c.JSON(http.StatusTooManyRequests,
+				servers.Error{Code: http.StatusTooManyRequests, Message: "too many requests"})
+		},
+	})
 }
 
 func registerSwaggerOpenApi(e *echo.Echo) {
@@ -157,6 +316,55 @@ func registerSwaggerUi(e *echo.Echo) {
 	})
 }
 
+func startGrpcServer(cr *cmd.CompositionRoot, port string) *grpc.Server {
+	listener, err := net.Listen("tcp", fmt.Sprintf("0.0.0.0:%s", port))
+	if err != nil {
+		log.Fatalf("Failed to listen grpc port: %v", err)
+	}
+
+	server := cr.NewGrpcServer()
+
+	go func() {
+		if err := server.Serve(listener); err != nil {
+			log.Fatalf("gRPC server error: %v", err)
+		}
+	}()
+
+	return server
+}
+
+// waitForShutdown ждет SIGINT или SIGTERM и останавливает серверы, давая открытым запросам завершиться.
+// Потоки изменений (SSE, WatchOrder) сами не завершаются, поэтому по истечении shutdownTimeout
+// соединения закрываются принудительно
+func waitForShutdown(webServer *echo.Echo, grpcServer *grpc.Server) {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	<-ctx.Done()
+
+	log.Println("shutting down")
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := webServer.Shutdown(ctx); err != nil {
+		log.Printf("http server shutdown: %v", err)
+		_ = webServer.Close()
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		log.Printf("gRPC server shutdown: %v", ctx.Err())
+		grpcServer.Stop()
+	}
+}
+
 func startKafkaConsumer(cr *cmd.CompositionRoot) {
 	go func() {
 		if err := cr.NewBasketConfirmedEventsConsumer().Consume(); err != nil {
@@ -169,8 +377,25 @@ func subscribeToOrderChangedEvents(cr *cmd.CompositionRoot) {
 	notificationProducer := cr.NewOrderChangedNotificationProducer()
 	orderEventsHandler := cr.NewOrderEventsHandler(notificationProducer)
 
-	e1, _ := order.NewCreatedDomainEvent(uuid.New())
-	e2, _ := order.NewCompletedDomainEvent(uuid.New(), uuid.New())
+	ids, clock := cr.IDGenerator(), cr.Clock()
+	e1, _ := order.NewCreatedDomainEvent(ids.NewId(), ids, clock)
+	e2, _ := order.NewCompletedDomainEvent(ids.NewId(), ids.NewId(), ids, clock)
+	e3, _ := order.NewRejectedDomainEvent(ids.NewId(), "", ids, clock)
+	e4, _ := order.NewDeliveryFailedDomainEvent(ids.NewId(), ids.NewId(), "", ids, clock)
+	e5, _ := order.NewAssignedDomainEvent(ids.NewId(), ids.NewId(), "operator", ids, clock)
+	e6, _ := order.NewUnassignedDomainEvent(ids.NewId(), ids.NewId(), "operator", ids, clock)
+	e7, _ := order.NewReassignedDomainEvent(ids.NewId(), ids.NewId(), ids.NewId(), "operator", ids, clock)
+
+	cr.Mediatr().Subscribe(orderEventsHandler, e1, e2, e3, e4, e5, e6, e7)
+}
+
+func subscribeToLiveUpdates(cr *cmd.CompositionRoot) {
+	liveUpdatesHandler := cr.NewLiveUpdatesEventHandler()
+
+	ids, clock := cr.IDGenerator(), cr.Clock()
+	location, _ := kernel.NewLocation(1, 1)
+	e1, _ := courier.NewMovedDomainEvent(ids.NewId(), location, ids, clock)
+	e2, _ := order.NewUpdatedDomainEvent(ids.NewId(), nil, order.StatusCreated, location, ids, clock)
 
-	cr.Mediatr().Subscribe(orderEventsHandler, e1, e2)
+	cr.Mediatr().Subscribe(liveUpdatesHandler, e1, e2)
 }
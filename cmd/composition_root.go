@@ -2,51 +2,132 @@ package cmd
 
 import (
 	"context"
+	cryptorand "crypto/rand"
+	grpcin "delivery/internal/adapters/in/grpc"
+	httpin "delivery/internal/adapters/in/http"
+	"delivery/internal/adapters/in/http/auth"
 	kafkain "delivery/internal/adapters/in/kafka"
+	"delivery/internal/adapters/out/clock"
 	"delivery/internal/adapters/out/grpc/geo"
+	"delivery/internal/adapters/out/idgen"
 	kafkaout "delivery/internal/adapters/out/kafka"
+	"delivery/internal/adapters/out/live"
+	"delivery/internal/adapters/out/memory"
 	outb "delivery/internal/adapters/out/outbox"
 	"delivery/internal/adapters/out/postgres"
+	"delivery/internal/adapters/out/random"
+	"delivery/internal/adapters/out/tracking"
 	"delivery/internal/core/application/eventhandlers"
 	"delivery/internal/core/application/usecases/commands"
 	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/courier"
 	"delivery/internal/core/domain/model/order"
 	"delivery/internal/core/domain/services"
 	"delivery/internal/core/ports"
+	"delivery/internal/generated/grpcservers/deliverypb"
+	"delivery/internal/generated/grpcservers/locationpb"
+	"delivery/internal/generated/servers"
 	"delivery/internal/jobs"
 	"delivery/internal/pkg/ddd"
 	"delivery/internal/pkg/outbox"
 	"fmt"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/labstack/echo/v4"
 	"github.com/robfig/cron/v3"
+	"google.golang.org/grpc"
 	"log"
+	"os"
 	"reflect"
+	"strings"
 	"sync"
+	"time"
+)
+
+const (
+	// liveUpdatesBufferSize - сколько изменений копится для подписчика карты, который не успевает их читать
+	liveUpdatesBufferSize = 256
+	// liveUpdatesHeartbeat - интервал пустых сообщений, которые держат поток изменений открытым
+	liveUpdatesHeartbeat = 15 * time.Second
+	// defaultTrackingTokenTtl - срок действия ссылки отслеживания, если он не задан
+	defaultTrackingTokenTtl = 7 * 24 * time.Hour
 )
 
 type CompositionRoot struct {
 	cfg           Config
 	db            *pgxpool.Pool
+	storage       *memory.Storage
 	uow           ports.UnitOfWork
 	mediatr       ddd.Mediatr
 	eventRegistry outbox.EventRegistry
+	serviceArea   kernel.ServiceArea
+	distanceCalc  kernel.DistanceCalculator
+	clock         ports.Clock
+	idGenerator   ports.IDGenerator
+	randomSource  ports.RandomSource
+	idlePolicy    services.IdleCourierPolicy
+	// requireConfirmation - новые заказы получают код подтверждения доставки
+	requireConfirmation bool
+	// gpsMovement - курьеры перемещаются по отметкам GPS, симуляция перемещения отключена
+	gpsMovement bool
+	geoGrid     kernel.GeoGrid
+	// liveUpdates - подписки на изменения для карты диспетчера, общие для всех обработчиков
+	liveUpdates live.Hub
+	// trackingTokens - токены ссылок отслеживания. Выпускаются при создании заказа и проверяются по ключу
+	trackingTokens ports.TrackingTokens
 
 	closers []Closer
 }
 
 func NewCompositionRoot(cfg Config) *CompositionRoot {
 
-	db := dbConnect(cfg)
 	mediatr := ddd.NewMediatr()
-	uow := createUnitOfWork(db, mediatr)
+
+	var db *pgxpool.Pool
+	var storage *memory.Storage
+	var uow ports.UnitOfWork
+	switch cfg.StorageMode {
+	case "", "postgres":
+		db = dbConnect(cfg)
+		uow = createUnitOfWork(db, mediatr)
+	case "memory":
+		storage = memory.NewStorage()
+		uow = createMemoryUnitOfWork(storage, mediatr)
+	default:
+		log.Fatalf("unknown storage mode: %s", cfg.StorageMode)
+	}
+
 	eventRegistry := createEventRegistry()
+	cityMap := loadCityMap(cfg)
+	serviceArea := createServiceArea(cfg, cityMap)
+	gpsMovement := parseCourierMovement(cfg)
+	geoGrid := createGeoGrid(cfg, gpsMovement)
+	distanceCalc := createDistanceCalculator(cfg, cityMap, geoGrid)
+	idGenerator, randomSource := createRandomProviders(cfg)
+	idlePolicy := createIdleCourierPolicy(cfg, serviceArea)
+	requireConfirmation := parseDeliveryConfirmation(cfg)
+	liveUpdates := createLiveUpdatesHub()
+	trackingTokens := createTrackingTokens(cfg)
 
 	return &CompositionRoot{
 		cfg:           cfg,
 		db:            db,
+		storage:       storage,
 		uow:           uow,
 		mediatr:       mediatr,
 		eventRegistry: eventRegistry,
+		serviceArea:   serviceArea,
+		distanceCalc:  distanceCalc,
+		clock:         clock.NewSystemClock(),
+		idGenerator:   idGenerator,
+		randomSource:  randomSource,
+		idlePolicy:    idlePolicy,
+
+		requireConfirmation: requireConfirmation,
+		gpsMovement:         gpsMovement,
+		geoGrid:             geoGrid,
+		liveUpdates:         liveUpdates,
+		trackingTokens:      trackingTokens,
 	}
 }
 
@@ -76,22 +157,294 @@ func createUnitOfWork(db *pgxpool.Pool, mediatr ddd.Mediatr) ports.UnitOfWork {
 	return uow
 }
 
+// createMemoryUnitOfWork - хранилище в памяти для локальной разработки и симуляции, без Postgres
+func createMemoryUnitOfWork(storage *memory.Storage, mediatr ddd.Mediatr) ports.UnitOfWork {
+	uow, err := memory.NewUnitOfWork(storage, mediatr)
+	if err != nil {
+		log.Fatalf("Failed to create UnitOfWork: %v", err)
+	}
+
+	return uow
+}
+
 func createEventRegistry() outbox.EventRegistry {
 	registry, err := outbox.NewEventRegistry()
 	if err != nil {
 		log.Fatalf("cannot create EventRegistry: %v", err)
 	}
 
-	err = registry.RegisterDomainEvent(reflect.TypeOf(order.CreatedDomainEvent{}))
-	err = registry.RegisterDomainEvent(reflect.TypeOf(order.CompletedDomainEvent{}))
+	events := []any{
+		order.CreatedDomainEvent{},
+		order.CompletedDomainEvent{},
+		order.RejectedDomainEvent{},
+		order.DeliveryFailedDomainEvent{},
+		order.AssignedDomainEvent{},
+		order.UnassignedDomainEvent{},
+		order.ReassignedDomainEvent{},
+		order.UpdatedDomainEvent{},
+		courier.MovedDomainEvent{},
+	}
 
-	if err != nil {
-		log.Fatalf("cannot register domain event: %v", err)
+	for _, event := range events {
+		err = registry.RegisterDomainEvent(reflect.TypeOf(event))
+		if err != nil {
+			log.Fatalf("cannot register domain event %T: %v", event, err)
+		}
 	}
 
 	return registry
 }
 
+func createLiveUpdatesHub() live.Hub {
+	hub, err := live.NewHub(liveUpdatesBufferSize)
+	if err != nil {
+		log.Fatalf("cannot create live updates Hub: %v", err)
+	}
+
+	return hub
+}
+
+// createTrackingTokens создает подпись ссылок отслеживания. Без ключа он создается случайным при запуске:
+// так удобно для разработки, но выданные ссылки перестают работать после перезапуска
+func createTrackingTokens(cfg Config) ports.TrackingTokens {
+	secret := []byte(cfg.TrackingTokenSecret)
+	if len(secret) == 0 {
+		log.Println("TRACKING_TOKEN_SECRET is not set, tracking links will not survive restart")
+		secret = make([]byte, tracking.MinSecretSize)
+		_, _ = cryptorand.Read(secret)
+	}
+
+	if len(secret) < tracking.MinSecretSize {
+		log.Fatalf("TRACKING_TOKEN_SECRET must be at least %d bytes", tracking.MinSecretSize)
+	}
+
+	ttl := defaultTrackingTokenTtl
+	if cfg.TrackingTokenTtlHours > 0 {
+		ttl = time.Duration(cfg.TrackingTokenTtlHours) * time.Hour
+	}
+
+	tokens, err := tracking.NewHmacTokens(secret, ttl)
+	if err != nil {
+		log.Fatalf("cannot create tracking tokens: %v", err)
+	}
+
+	return tokens
+}
+
+// loadCityMap читает карту непроходимых клеток из файла. Без файла город считается открытым
+func loadCityMap(cfg Config) kernel.CityMap {
+	if cfg.CityMapFile == "" {
+		return kernel.CityMap{}
+	}
+
+	f, err := os.Open(cfg.CityMapFile)
+	if err != nil {
+		log.Fatalf("cannot open city map: %v", err)
+	}
+
+	//goland:noinspection GoUnhandledErrorResult
+	defer f.Close()
+
+	cityMap, err := kernel.ParseCityMap(f)
+	if err != nil {
+		log.Fatalf("cannot parse city map %s: %v", cfg.CityMapFile, err)
+	}
+
+	if cityMap.Width() != cfg.ServiceAreaWidth || cityMap.Height() != cfg.ServiceAreaHeight {
+		log.Fatalf("city map %dx%d does not match service area %dx%d",
+			cityMap.Width(), cityMap.Height(), cfg.ServiceAreaWidth, cfg.ServiceAreaHeight)
+	}
+
+	return cityMap
+}
+
+// createServiceArea строит зону обслуживания из конфигурации.
+// Исключенные клетки задаются списком "x:y", разделенным запятыми, например "3:4,3:5".
+// Непроходимые клетки карты города тоже исключаются - доставить туда заказ нельзя
+func createServiceArea(cfg Config, cityMap kernel.CityMap) kernel.ServiceArea {
+	var excluded []kernel.Location
+	for _, cell := range strings.Split(cfg.ServiceAreaExcludedCells, ",") {
+		cell = strings.TrimSpace(cell)
+		if cell == "" {
+			continue
+		}
+
+		loc, err := parseCell(cell)
+		if err != nil {
+			log.Fatalf("invalid excluded cell %q: %v", cell, err)
+		}
+
+		excluded = append(excluded, loc)
+	}
+
+	if !cityMap.IsEmpty() {
+		excluded = append(excluded, cityMap.BlockedCells()...)
+	}
+
+	area, err := kernel.NewServiceArea(cfg.ServiceAreaWidth, cfg.ServiceAreaHeight, excluded...)
+	if err != nil {
+		log.Fatalf("cannot create ServiceArea: %v", err)
+	}
+
+	return area
+}
+
+// parseCell разбирает клетку в формате "x:y"
+func parseCell(cell string) (kernel.Location, error) {
+	var x, y int
+	_, err := fmt.Sscanf(cell, "%d:%d", &x, &y)
+	if err != nil {
+		return kernel.Location{}, err
+	}
+
+	return kernel.NewLocation(x, y)
+}
+
+// parseDeliveryConfirmation определяет, завершается ли заказ по прибытии курьера (none) или по коду получателя (pin)
+func parseDeliveryConfirmation(cfg Config) bool {
+	switch cfg.DeliveryConfirmation {
+	case "", "none":
+		return false
+	case "pin":
+		return true
+	default:
+		log.Fatalf("unknown delivery confirmation mode: %s", cfg.DeliveryConfirmation)
+		return false
+	}
+}
+
+// parseCourierMovement определяет, как перемещаются курьеры: "simulated" (по умолчанию) - симуляцией по таймеру,
+// "gps" - по отметкам, которые присылает приложение курьера
+func parseCourierMovement(cfg Config) bool {
+	switch cfg.CourierMovement {
+	case "", "simulated":
+		return false
+	case "gps":
+		return true
+	default:
+		log.Fatalf("unknown courier movement mode: %s", cfg.CourierMovement)
+		return false
+	}
+}
+
+// createIdleCourierPolicy выбирает, куда идут курьеры без заказов: "none" (по умолчанию) - остаются на месте,
+// "depot" - на свой склад, а без склада - в точку ожидания, если она задана, "staging" - в точку ожидания
+func createIdleCourierPolicy(cfg Config, area kernel.ServiceArea) services.IdleCourierPolicy {
+	var stagingPoint kernel.Location
+	if cfg.IdleStagingPoint != "" {
+		var err error
+		stagingPoint, err = parseCell(cfg.IdleStagingPoint)
+		if err != nil {
+			log.Fatalf("invalid staging point %q: %v", cfg.IdleStagingPoint, err)
+		}
+
+		err = area.Validate(stagingPoint)
+		if err != nil {
+			log.Fatalf("invalid staging point: %v", err)
+		}
+	}
+
+	switch cfg.IdleCourierReturn {
+	case "", "none":
+		return services.NewStayPolicy()
+	case "depot":
+		return services.NewReturnToDepotPolicy(stagingPoint)
+	case "staging":
+		policy, err := services.NewReturnToStagingPointPolicy(stagingPoint)
+		if err != nil {
+			log.Fatalf("cannot create IdleCourierPolicy: %v", err)
+		}
+
+		return policy
+	default:
+		log.Fatalf("unknown idle courier return mode: %s", cfg.IdleCourierReturn)
+		return nil
+	}
+}
+
+// createDistanceCalculator выбирает метрику для развертывания: "grid" (по умолчанию) - симуляция по клеткам,
+// с картой города - по маршруту в обход непроходимых клеток, "geo" - расстояние по поверхности Земли
+// между точными координатами, скорость курьеров в км/ч
+func createDistanceCalculator(cfg Config, cityMap kernel.CityMap, geoGrid kernel.GeoGrid) kernel.DistanceCalculator {
+	switch cfg.DistanceMode {
+	case "", "grid":
+		if cityMap.IsEmpty() {
+			return kernel.NewGridDistanceCalculator()
+		}
+
+		calc, err := kernel.NewPathDistanceCalculator(cityMap)
+		if err != nil {
+			log.Fatalf("cannot create DistanceCalculator: %v", err)
+		}
+
+		return calc
+	case "geo":
+		if !cityMap.IsEmpty() {
+			log.Fatalf("city map is supported only in grid distance mode")
+		}
+
+		calc, err := kernel.NewGeoDistanceCalculator(geoGrid)
+		if err != nil {
+			log.Fatalf("cannot create DistanceCalculator: %v", err)
+		}
+
+		return calc
+	default:
+		log.Fatalf("unknown distance mode: %s", cfg.DistanceMode)
+		return nil
+	}
+}
+
+// createGeoGrid связывает клетки с координатами: переводит точные координаты адресов и отметок GPS в клетки
+// зоны обслуживания. Нужна в режиме geo и при перемещении по GPS
+func createGeoGrid(cfg Config, gpsMovement bool) kernel.GeoGrid {
+	if cfg.DistanceMode != "geo" && !gpsMovement {
+		return kernel.GeoGrid{}
+	}
+
+	origin, err := kernel.NewGeoLocation(cfg.GeoOriginLat, cfg.GeoOriginLon)
+	if err != nil {
+		log.Fatalf("invalid geo origin: %v", err)
+	}
+
+	grid, err := kernel.NewGeoGrid(origin, cfg.GeoCellSizeKm)
+	if err != nil {
+		log.Fatalf("cannot create GeoGrid: %v", err)
+	}
+
+	return grid
+}
+
+// createRandomProviders возвращает источники идентификаторов и случайных чисел.
+// С заданным RandomSeed прогон воспроизводим: те же команды дают те же идентификаторы и размещение курьеров.
+// Повтор идентификаторов допустим только в хранилище, которое не переживает перезапуск
+func createRandomProviders(cfg Config) (ports.IDGenerator, ports.RandomSource) {
+	if cfg.RandomSeed != 0 {
+		if cfg.StorageMode != "memory" {
+			log.Fatalf("RANDOM_SEED is supported only with STORAGE_MODE=memory, got %q", cfg.StorageMode)
+		}
+
+		return idgen.NewSeededGenerator(deriveSeed(cfg.RandomSeed, idSeedStream)),
+			random.NewRandomSource(deriveSeed(cfg.RandomSeed, randomSeedStream))
+	}
+
+	return idgen.NewUUIDGenerator(), random.NewRandomSource(time.Now().UnixNano())
+}
+
+// Потоки идентификаторов и случайных чисел получают разные seed, чтобы не повторять друг друга
+const (
+	idSeedStream     = 1
+	randomSeedStream = 2
+)
+
+// deriveSeed получает seed потока stream из общего seed перемешиванием SplitMix64
+func deriveSeed(seed int64, stream uint64) int64 {
+	z := uint64(seed) + stream*0x9e3779b97f4a7c15
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return int64(z ^ (z >> 31))
+}
+
 func (cr *CompositionRoot) Db() *pgxpool.Pool {
 	return cr.db
 }
@@ -104,12 +457,44 @@ func (cr *CompositionRoot) Mediatr() ddd.Mediatr {
 	return cr.mediatr
 }
 
+func (cr *CompositionRoot) ServiceArea() kernel.ServiceArea {
+	return cr.serviceArea
+}
+
+func (cr *CompositionRoot) Clock() ports.Clock {
+	return cr.clock
+}
+
+func (cr *CompositionRoot) IDGenerator() ports.IDGenerator {
+	return cr.idGenerator
+}
+
+// GpsMovement - курьеры перемещаются по отметкам GPS, задачу симуляции перемещения запускать не нужно
+func (cr *CompositionRoot) GpsMovement() bool {
+	return cr.gpsMovement
+}
+
+// SetClock подменяет часы, например на FakeClock в симуляции.
+// Вызывается до создания обработчиков, которые зависят от времени
+func (cr *CompositionRoot) SetClock(clock ports.Clock) {
+	if clock == nil {
+		log.Fatalf("clock is required")
+	}
+
+	cr.clock = clock
+}
+
 func (cr *CompositionRoot) NewOrderDispatcher() services.OrderDispatcher {
-	return services.NewOrderDispatcher()
+	dispatcher, err := services.NewOrderDispatcher(cr.distanceCalc, cr.idGenerator, cr.clock)
+	if err != nil {
+		log.Fatalf("Failed to create OrderDispatcher: %v", err)
+	}
+
+	return dispatcher
 }
 
 func (cr *CompositionRoot) NewCreateCourierCommandHandler() commands.CreateCourierCommandHandler {
-	cmdHandler, err := commands.NewCreateCourierCommandHandler(cr.uow)
+	cmdHandler, err := commands.NewCreateCourierCommandHandler(cr.uow, cr.serviceArea, cr.idGenerator, cr.randomSource)
 	if err != nil {
 		log.Fatalf("Failed to create CreateCourierCommandHandler: %v", err)
 	}
@@ -118,7 +503,7 @@ func (cr *CompositionRoot) NewCreateCourierCommandHandler() commands.CreateCouri
 }
 
 func (cr *CompositionRoot) NewAddStoragePlaceCommandHandler() commands.AddStoragePlaceCommandHandler {
-	cmdHandler, err := commands.NewAddStoragePlaceCommandHandler(cr.uow)
+	cmdHandler, err := commands.NewAddStoragePlaceCommandHandler(cr.uow, cr.idGenerator)
 	if err != nil {
 		log.Fatalf("Failed to create AddStoragePlaceCommandHandler: %v", err)
 	}
@@ -127,7 +512,8 @@ func (cr *CompositionRoot) NewAddStoragePlaceCommandHandler() commands.AddStorag
 }
 
 func (cr *CompositionRoot) NewAssignOrderCommandHandler() commands.AssignOrderCommandHandler {
-	cmdHandler, err := commands.NewAssignOrderCommandHandler(cr.uow, cr.NewOrderDispatcher())
+	cmdHandler, err := commands.NewAssignOrderCommandHandler(cr.uow, cr.NewOrderDispatcher(), cr.cfg.MaxDispatchAttempts,
+		cr.idGenerator, cr.clock)
 	if err != nil {
 		log.Fatalf("Failed to create AssignOrderCommandHandler: %v", err)
 	}
@@ -136,7 +522,9 @@ func (cr *CompositionRoot) NewAssignOrderCommandHandler() commands.AssignOrderCo
 }
 
 func (cr *CompositionRoot) NewCreateOrderCommandHandler() commands.CreateOrderCommandHandler {
-	cmdHandler, err := commands.NewCreateOrderCommandHandler(cr.uow, cr.NewGeoLocationService())
+	cmdHandler, err := commands.NewCreateOrderCommandHandler(cr.uow, cr.NewGeoLocationService(), cr.serviceArea, cr.geoGrid,
+		cr.idGenerator, cr.clock, random.NewSecureRandomSource(), cr.trackingTokens, cr.requireConfirmation,
+		cr.cfg.MaxShipmentVolume, cr.cfg.MaxShipmentWeight)
 	if err != nil {
 		log.Fatalf("Failed to create CreateOrderCommandHandler: %v", err)
 	}
@@ -144,8 +532,99 @@ func (cr *CompositionRoot) NewCreateOrderCommandHandler() commands.CreateOrderCo
 	return cmdHandler
 }
 
+func (cr *CompositionRoot) NewConfirmDeliveryCommandHandler() commands.ConfirmDeliveryCommandHandler {
+	cmdHandler, err := commands.NewConfirmDeliveryCommandHandler(cr.uow, cr.cfg.MaxDeliveryAttempts, cr.idGenerator,
+		cr.clock)
+	if err != nil {
+		log.Fatalf("Failed to create ConfirmDeliveryCommandHandler: %v", err)
+	}
+
+	return cmdHandler
+}
+
+func (cr *CompositionRoot) NewFailDeliveryCommandHandler() commands.FailDeliveryCommandHandler {
+	cmdHandler, err := commands.NewFailDeliveryCommandHandler(cr.uow, cr.idGenerator, cr.clock)
+	if err != nil {
+		log.Fatalf("Failed to create FailDeliveryCommandHandler: %v", err)
+	}
+
+	return cmdHandler
+}
+
+func (cr *CompositionRoot) NewManualAssignOrderCommandHandler() commands.ManualAssignOrderCommandHandler {
+	cmdHandler, err := commands.NewManualAssignOrderCommandHandler(cr.uow, cr.idGenerator, cr.clock)
+	if err != nil {
+		log.Fatalf("Failed to create ManualAssignOrderCommandHandler: %v", err)
+	}
+
+	return cmdHandler
+}
+
+func (cr *CompositionRoot) NewUnassignOrderCommandHandler() commands.UnassignOrderCommandHandler {
+	cmdHandler, err := commands.NewUnassignOrderCommandHandler(cr.uow, cr.idGenerator, cr.clock)
+	if err != nil {
+		log.Fatalf("Failed to create UnassignOrderCommandHandler: %v", err)
+	}
+
+	return cmdHandler
+}
+
+func (cr *CompositionRoot) NewReassignOrderCommandHandler() commands.ReassignOrderCommandHandler {
+	cmdHandler, err := commands.NewReassignOrderCommandHandler(cr.uow, cr.idGenerator, cr.clock)
+	if err != nil {
+		log.Fatalf("Failed to create ReassignOrderCommandHandler: %v", err)
+	}
+
+	return cmdHandler
+}
+
+func (cr *CompositionRoot) NewReportCourierLocationCommandHandler() commands.ReportCourierLocationCommandHandler {
+	cmdHandler, err := commands.NewReportCourierLocationCommandHandler(cr.uow, cr.geoGrid, cr.serviceArea,
+		cr.gpsMovement, cr.idGenerator, cr.clock)
+	if err != nil {
+		log.Fatalf("Failed to create ReportCourierLocationCommandHandler: %v", err)
+	}
+
+	return cmdHandler
+}
+
+func (cr *CompositionRoot) NewCourierLocationServer() locationpb.CourierLocationServer {
+	server, err := grpcin.NewCourierLocationServer(cr.NewReportCourierLocationCommandHandler())
+	if err != nil {
+		log.Fatalf("cannot create CourierLocationServer: %v", err)
+	}
+
+	return server
+}
+
+func (cr *CompositionRoot) NewDeliveryServer() deliverypb.DeliveryServer {
+	server, err := grpcin.NewDeliveryServer(cr.NewOrderDetailsQueryHandler(), cr.NewAllCouriersQueryHandler(),
+		cr.NewOrderEtaQueryHandler(), cr.liveUpdates)
+	if err != nil {
+		log.Fatalf("Failed to create DeliveryServer: %v", err)
+	}
+
+	return server
+}
+
+// NewGrpcServer создает gRPC-сервер со всеми сервисами: приемом координат курьеров и API для других микросервисов.
+// Вызовы проверяются так же, как запросы HTTP API: учетные данные - в метаданных x-api-key или authorization
+func (cr *CompositionRoot) NewGrpcServer() *grpc.Server {
+	unary, stream, err := grpcin.NewAuthInterceptors(cr.NewAuthenticator(), cr.NewAuthorizer())
+	if err != nil {
+		log.Fatalf("Failed to create gRPC auth interceptors: %v", err)
+	}
+
+	server := grpc.NewServer(grpc.UnaryInterceptor(unary), grpc.StreamInterceptor(stream))
+	locationpb.RegisterCourierLocationServer(server, cr.NewCourierLocationServer())
+	deliverypb.RegisterDeliveryServer(server, cr.NewDeliveryServer())
+
+	return server
+}
+
 func (cr *CompositionRoot) NewMoveCouriersCommandHandler() commands.MoveCouriersCommandHandler {
-	cmdHandler, err := commands.NewMoveCouriersCommandHandler(cr.uow)
+	cmdHandler, err := commands.NewMoveCouriersCommandHandler(cr.uow, cr.distanceCalc, cr.clock, cr.idGenerator,
+		cr.idlePolicy)
 	if err != nil {
 		log.Fatalf("Failed to create MoveCouriersCommandHandler: %v", err)
 	}
@@ -154,7 +633,13 @@ func (cr *CompositionRoot) NewMoveCouriersCommandHandler() commands.MoveCouriers
 }
 
 func (cr *CompositionRoot) NewAllCouriersQueryHandler() queries.AllCouriersQueryHandler {
-	cmdHandler, err := queries.NewAllCouriersQueryHandler(cr.db)
+	var cmdHandler queries.AllCouriersQueryHandler
+	var err error
+	if cr.storage != nil {
+		cmdHandler, err = memory.NewAllCouriersQueryHandler(cr.storage)
+	} else {
+		cmdHandler, err = queries.NewAllCouriersQueryHandler(cr.db)
+	}
 	if err != nil {
 		log.Fatalf("Failed to create AllCouriersQueryHandler: %v", err)
 	}
@@ -162,8 +647,47 @@ func (cr *CompositionRoot) NewAllCouriersQueryHandler() queries.AllCouriersQuery
 	return cmdHandler
 }
 
+func (cr *CompositionRoot) NewGrantQualificationCommandHandler() commands.GrantQualificationCommandHandler {
+	cmdHandler, err := commands.NewGrantQualificationCommandHandler(cr.uow)
+	if err != nil {
+		log.Fatalf("Failed to create GrantQualificationCommandHandler: %v", err)
+	}
+
+	return cmdHandler
+}
+
+func (cr *CompositionRoot) NewRevokeQualificationCommandHandler() commands.RevokeQualificationCommandHandler {
+	cmdHandler, err := commands.NewRevokeQualificationCommandHandler(cr.uow)
+	if err != nil {
+		log.Fatalf("Failed to create RevokeQualificationCommandHandler: %v", err)
+	}
+
+	return cmdHandler
+}
+
+func (cr *CompositionRoot) NewCourierDetailsQueryHandler() queries.CourierDetailsQueryHandler {
+	var cmdHandler queries.CourierDetailsQueryHandler
+	var err error
+	if cr.storage != nil {
+		cmdHandler, err = memory.NewCourierDetailsQueryHandler(cr.storage)
+	} else {
+		cmdHandler, err = queries.NewCourierDetailsQueryHandler(cr.db)
+	}
+	if err != nil {
+		log.Fatalf("Failed to create CourierDetailsQueryHandler: %v", err)
+	}
+
+	return cmdHandler
+}
+
 func (cr *CompositionRoot) NewIncompleteOrdersQueryHandler() queries.IncompleteOrdersQueryHandler {
-	cmdHandler, err := queries.NewIncompleteOrdersQueryHandler(cr.db)
+	var cmdHandler queries.IncompleteOrdersQueryHandler
+	var err error
+	if cr.storage != nil {
+		cmdHandler, err = memory.NewIncompleteOrdersQueryHandler(cr.storage)
+	} else {
+		cmdHandler, err = queries.NewIncompleteOrdersQueryHandler(cr.db)
+	}
 	if err != nil {
 		log.Fatalf("Failed to create IncompleteOrdersQueryHandler: %v", err)
 	}
@@ -171,6 +695,134 @@ func (cr *CompositionRoot) NewIncompleteOrdersQueryHandler() queries.IncompleteO
 	return cmdHandler
 }
 
+func (cr *CompositionRoot) NewDispatchDecisionsQueryHandler() queries.DispatchDecisionsQueryHandler {
+	var cmdHandler queries.DispatchDecisionsQueryHandler
+	var err error
+	if cr.storage != nil {
+		cmdHandler, err = memory.NewDispatchDecisionsQueryHandler(cr.storage)
+	} else {
+		cmdHandler, err = queries.NewDispatchDecisionsQueryHandler(cr.db)
+	}
+	if err != nil {
+		log.Fatalf("Failed to create DispatchDecisionsQueryHandler: %v", err)
+	}
+
+	return cmdHandler
+}
+
+func (cr *CompositionRoot) NewOrderDetailsQueryHandler() queries.OrderDetailsQueryHandler {
+	var cmdHandler queries.OrderDetailsQueryHandler
+	var err error
+	if cr.storage != nil {
+		cmdHandler, err = memory.NewOrderDetailsQueryHandler(cr.storage)
+	} else {
+		cmdHandler, err = queries.NewOrderDetailsQueryHandler(cr.db)
+	}
+	if err != nil {
+		log.Fatalf("Failed to create OrderDetailsQueryHandler: %v", err)
+	}
+
+	return cmdHandler
+}
+
+func (cr *CompositionRoot) NewOrderEtaQueryHandler() queries.OrderEtaQueryHandler {
+	cmdHandler, err := queries.NewOrderEtaQueryHandler(cr.uow, cr.distanceCalc)
+	if err != nil {
+		log.Fatalf("Failed to create OrderEtaQueryHandler: %v", err)
+	}
+
+	return cmdHandler
+}
+
+func (cr *CompositionRoot) NewTrackOrderQueryHandler() queries.TrackOrderQueryHandler {
+	cmdHandler, err := queries.NewTrackOrderQueryHandler(cr.uow, cr.distanceCalc, cr.serviceArea, cr.trackingTokens,
+		cr.clock)
+	if err != nil {
+		log.Fatalf("Failed to create TrackOrderQueryHandler: %v", err)
+	}
+
+	return cmdHandler
+}
+
+func (cr *CompositionRoot) NewSimulateDispatchQueryHandler() queries.SimulateDispatchQueryHandler {
+	cmdHandler, err := queries.NewSimulateDispatchQueryHandler(cr.uow, cr.NewOrderDispatcher(), cr.serviceArea,
+		cr.idGenerator, cr.clock)
+	if err != nil {
+		log.Fatalf("Failed to create SimulateDispatchQueryHandler: %v", err)
+	}
+
+	return cmdHandler
+}
+
+// NewAuthenticator собирает способы входа, заданные в настройках: статические ключи (API_KEYS)
+// и JWT, проверяемые по локальному JWKS (JWKS_FILE). Без способов входа HTTP API не запускается
+func (cr *CompositionRoot) NewAuthenticator() auth.Authenticator {
+	var authenticators []auth.Authenticator
+
+	if cr.cfg.ApiKeys != "" {
+		keys, err := auth.ParseApiKeys(cr.cfg.ApiKeys)
+		if err != nil {
+			log.Fatalf("invalid API_KEYS: %v", err)
+		}
+
+		authenticator, err := auth.NewApiKeyAuthenticator(keys)
+		if err != nil {
+			log.Fatalf("Failed to create api key Authenticator: %v", err)
+		}
+
+		authenticators = append(authenticators, authenticator)
+	}
+
+	if cr.cfg.JwksFile != "" {
+		jwks, err := auth.LoadJwks(cr.cfg.JwksFile)
+		if err != nil {
+			log.Fatalf("cannot load JWKS %q: %v", cr.cfg.JwksFile, err)
+		}
+
+		authenticator, err := auth.NewJwtAuthenticator(jwks, cr.cfg.JwtIssuer, cr.cfg.JwtAudience, cr.clock)
+		if err != nil {
+			log.Fatalf("Failed to create JWT Authenticator: %v", err)
+		}
+
+		authenticators = append(authenticators, authenticator)
+	}
+
+	authenticator, err := auth.NewChain(authenticators...)
+	if err != nil {
+		log.Fatalf("authentication is not configured, set API_KEYS or JWKS_FILE: %v", err)
+	}
+
+	return authenticator
+}
+
+func (cr *CompositionRoot) NewAuthorizer() auth.Authorizer {
+	authorizer, err := auth.NewAuthorizer(cr.NewOrderDetailsQueryHandler())
+	if err != nil {
+		log.Fatalf("Failed to create Authorizer: %v", err)
+	}
+
+	return authorizer
+}
+
+func (cr *CompositionRoot) NewAuthMiddleware(authenticator auth.Authenticator) servers.StrictMiddlewareFunc {
+	middleware, err := auth.NewStrictMiddleware(authenticator, cr.NewAuthorizer())
+	if err != nil {
+		log.Fatalf("Failed to create auth middleware: %v", err)
+	}
+
+	return middleware
+}
+
+// NewRoleMiddleware защищает маршруты, которых нет в OpenAPI
+func (cr *CompositionRoot) NewRoleMiddleware(authenticator auth.Authenticator, roles ...auth.Role) echo.MiddlewareFunc {
+	middleware, err := auth.NewEchoMiddleware(authenticator, roles...)
+	if err != nil {
+		log.Fatalf("Failed to create role middleware: %v", err)
+	}
+
+	return middleware
+}
+
 func (cr *CompositionRoot) NewAssignOrdersJob() cron.Job {
 	job, err := jobs.NewAssignOrdersJob(cr.NewAssignOrderCommandHandler())
 	if err != nil {
@@ -241,8 +893,32 @@ func (cr *CompositionRoot) NewOrderEventsHandler(np ports.NotificationProducer)
 	return eventHandler
 }
 
+func (cr *CompositionRoot) NewLiveUpdatesEventHandler() ddd.EventHandler {
+	eventHandler, err := eventhandlers.NewLiveUpdatesHandler(cr.liveUpdates)
+	if err != nil {
+		log.Fatalf("failed to create LiveUpdatesHandler: %v", err)
+	}
+
+	return eventHandler
+}
+
+func (cr *CompositionRoot) NewLiveUpdatesHandler() httpin.LiveUpdatesHandler {
+	handler, err := httpin.NewLiveUpdatesHandler(cr.liveUpdates, liveUpdatesHeartbeat)
+	if err != nil {
+		log.Fatalf("failed to create LiveUpdatesHandler: %v", err)
+	}
+
+	return handler
+}
+
 func (cr *CompositionRoot) NewOutboxRepository() outb.OutboxRepository {
-	ob, err := outb.NewRepository(cr.db)
+	var ob outb.OutboxRepository
+	var err error
+	if cr.storage != nil {
+		ob, err = memory.NewOutboxRepository(cr.storage)
+	} else {
+		ob, err = outb.NewRepository(cr.db)
+	}
 	if err != nil {
 		log.Fatalf("failed to create OutboxRepository: %v", err)
 	}
@@ -251,9 +927,18 @@ func (cr *CompositionRoot) NewOutboxRepository() outb.OutboxRepository {
 }
 
 func (cr *CompositionRoot) NewOutboxJob() cron.Job {
-	job, err := jobs.NewOutboxJob(cr.NewOutboxRepository(), cr.mediatr, cr.eventRegistry)
+	job, err := jobs.NewOutboxJob(cr.NewOutboxRepository(), cr.mediatr, cr.eventRegistry, cr.clock)
 	if err != nil {
 		log.Fatalf("cannot create OutboxJob: %v", err)
 	}
 	return job
 }
+
+func (cr *CompositionRoot) NewOutboxCleanupJob() cron.Job {
+	retention := time.Duration(cr.cfg.OutboxRetentionHours) * time.Hour
+	job, err := jobs.NewOutboxCleanupJob(cr.NewOutboxRepository(), retention, cr.clock)
+	if err != nil {
+		log.Fatalf("cannot create OutboxCleanupJob: %v", err)
+	}
+	return job
+}
@@ -2,6 +2,7 @@ package cmd
 
 type Config struct {
 	HttpPort                  string
+	StorageMode               string
 	DbHost                    string
 	DbPort                    string
 	DbUser                    string
@@ -13,4 +14,31 @@ type Config struct {
 	KafkaConsumerGroup        string
 	KafkaBasketConfirmedTopic string
 	KafkaOrderChangedTopic    string
+	MaxDispatchAttempts       int
+	ServiceAreaWidth          int
+	ServiceAreaHeight         int
+	ServiceAreaExcludedCells  string
+	DistanceMode              string
+	GeoOriginLat              float64
+	GeoOriginLon              float64
+	GeoCellSizeKm             float64
+	CityMapFile               string
+	RandomSeed                int64
+	IdleCourierReturn         string
+	IdleStagingPoint          string
+	DeliveryConfirmation      string
+	MaxDeliveryAttempts       int
+	MaxShipmentVolume         int
+	MaxShipmentWeight         int
+	CourierMovement           string
+	GrpcPort                  string
+	TrackingTokenSecret       string
+	TrackingTokenTtlHours     int
+	TrackingRateLimit         int
+	ApiKeys                   string
+	JwksFile                  string
+	JwtIssuer                 string
+	JwtAudience               string
+	CorsAllowedOrigins        string
+	OutboxRetentionHours      int
 }
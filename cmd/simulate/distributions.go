@@ -0,0 +1,100 @@
+package main
+
+import (
+	"delivery/internal/core/domain/kernel"
+	"fmt"
+	"math"
+	"math/rand"
+	"slices"
+	"time"
+)
+
+const (
+	spatialUniform  = "uniform"
+	spatialCenter   = "center"
+	spatialHotspots = "hotspots"
+
+	temporalUniform = "uniform"
+	temporalPoisson = "poisson"
+	temporalBurst   = "burst"
+
+	hotspotsCount = 3
+)
+
+// arrivalOffsets возвращает отсортированные моменты поступления заказов от начала симуляции
+func arrivalOffsets(rng *rand.Rand, distribution string, count int, window time.Duration) ([]time.Duration, error) {
+	offsets := make([]time.Duration, 0, count)
+
+	switch distribution {
+	case temporalUniform:
+		for i := range count {
+			offsets = append(offsets, time.Duration(float64(window)*float64(i)/float64(max(count, 1))))
+		}
+	case temporalPoisson:
+		// экспоненциальные интервалы между заказами со средним window / count
+		mean := float64(window) / float64(max(count, 1))
+		var offset float64
+		for range count {
+			offset += rng.ExpFloat64() * mean
+			offsets = append(offsets, time.Duration(offset))
+		}
+	case temporalBurst:
+		// все заказы в первые 10% окна - пиковая нагрузка
+		for range count {
+			offsets = append(offsets, time.Duration(rng.Float64()*float64(window)/10))
+		}
+		slices.Sort(offsets)
+	default:
+		return nil, fmt.Errorf("unknown temporal distribution: %s", distribution)
+	}
+
+	return offsets, nil
+}
+
+// newSpatialGenerator возвращает генератор точек доставки внутри зоны обслуживания
+func newSpatialGenerator(rng *rand.Rand, distribution string, area kernel.ServiceArea) (func() kernel.Location, error) {
+	switch distribution {
+	case spatialUniform:
+		return func() kernel.Location { return uniformLocation(rng, area) }, nil
+	case spatialCenter:
+		cx := float64(area.Width()+1) / 2
+		cy := float64(area.Height()+1) / 2
+		sd := float64(min(area.Width(), area.Height())) / 6
+		return func() kernel.Location { return normalLocation(rng, area, cx, cy, sd) }, nil
+	case spatialHotspots:
+		hotspots := make([]kernel.Location, 0, hotspotsCount)
+		for range hotspotsCount {
+			hotspots = append(hotspots, uniformLocation(rng, area))
+		}
+		return func() kernel.Location {
+			h := hotspots[rng.Intn(len(hotspots))]
+			return normalLocation(rng, area, float64(h.X()), float64(h.Y()), 1)
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown spatial distribution: %s", distribution)
+	}
+}
+
+func uniformLocation(rng *rand.Rand, area kernel.ServiceArea) kernel.Location {
+	for {
+		l, err := kernel.NewLocation(rng.Intn(area.Width())+1, rng.Intn(area.Height())+1)
+		if err == nil && area.Contains(l) {
+			return l
+		}
+	}
+}
+
+// normalLocation выбирает точку около (cx, cy); точки вне зоны перевыбираются
+func normalLocation(rng *rand.Rand, area kernel.ServiceArea, cx float64, cy float64, sd float64) kernel.Location {
+	for range 100 {
+		x := int(math.Round(cx + rng.NormFloat64()*sd))
+		y := int(math.Round(cy + rng.NormFloat64()*sd))
+
+		l, err := kernel.NewLocation(x, y)
+		if err == nil && area.Contains(l) {
+			return l
+		}
+	}
+
+	return uniformLocation(rng, area)
+}
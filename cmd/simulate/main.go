@@ -0,0 +1,52 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"time"
+)
+
+// simulate прогоняет диспетчеризацию и перемещение курьеров на сгенерированных заказах
+// с ускоренным временем и печатает показатели. Используется для офлайн-сравнения политик диспетчеризации
+func main() {
+	cfg := parseFlags()
+
+	report, err := run(cfg)
+	if err != nil {
+		log.Fatalf("simulation failed: %v", err)
+	}
+
+	report.Print(os.Stdout)
+}
+
+func parseFlags() simulationConfig {
+	cfg := simulationConfig{}
+
+	flag.IntVar(&cfg.Couriers, "couriers", 20, "number of couriers")
+	flag.IntVar(&cfg.MaxSpeed, "max-speed", 3, "max courier speed, cells per second")
+	flag.Float64Var(&cfg.TrunkShare, "trunk-share", 0.5, "share of couriers with an additional trunk")
+	flag.IntVar(&cfg.Orders, "orders", 200, "number of orders")
+	flag.IntVar(&cfg.MaxVolume, "max-volume", 15, "max order volume")
+	flag.IntVar(&cfg.MaxWeight, "max-weight", 0, "max order weight in grams, 0 - orders without weight")
+	flag.Float64Var(&cfg.ExpressShare, "express-share", 0.2, "share of express orders")
+	flag.StringVar(&cfg.Spatial, "spatial", spatialUniform, "spatial distribution of orders: uniform, center, hotspots")
+	flag.StringVar(&cfg.Temporal, "temporal", temporalPoisson, "temporal distribution of orders: uniform, poisson, burst")
+	flag.DurationVar(&cfg.OrderWindow, "order-window", 30*time.Minute, "simulated period during which orders arrive")
+	flag.DurationVar(&cfg.MaxDuration, "max-duration", 3*time.Hour, "simulated time limit")
+	flag.DurationVar(&cfg.Tick, "tick", time.Second, "simulated interval between job runs")
+	flag.Float64Var(&cfg.Speedup, "speedup", 1000, "simulated seconds per real second, 0 - as fast as possible")
+	flag.IntVar(&cfg.MaxDispatchAttempts, "max-dispatch-attempts", 10, "dispatch attempts before order becomes undeliverable")
+	flag.IntVar(&cfg.AreaWidth, "area-width", 10, "service area width")
+	flag.IntVar(&cfg.AreaHeight, "area-height", 10, "service area height")
+	flag.StringVar(&cfg.CityMapFile, "city-map", "", "city map file with blocked cells")
+	flag.IntVar(&cfg.Depots, "depots", 0, "number of depots couriers start from, 0 - random start locations")
+	flag.IntVar(&cfg.Stores, "stores", 0, "number of stores orders are picked up from, 0 - goods are already with couriers")
+	flag.StringVar(&cfg.IdleReturn, "idle-return", "none", "where idle couriers go: none, depot, staging")
+	flag.StringVar(&cfg.StagingPoint, "staging-point", "", "staging point for idle couriers, x:y")
+	flag.Int64Var(&cfg.Seed, "seed", 1, "random seed, 0 - seed from current time")
+
+	flag.Parse()
+
+	return cfg
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"time"
+)
+
+type report struct {
+	Orders            int
+	Undeliverable     int
+	Pending           int
+	DeliveryTimes     []time.Duration
+	BusyCourierTicks  int
+	CourierTicks      int
+	SimulatedDuration time.Duration
+	WallDuration      time.Duration
+}
+
+func (r *report) MeanDeliveryTime() time.Duration {
+	if len(r.DeliveryTimes) == 0 {
+		return 0
+	}
+
+	var total time.Duration
+	for _, d := range r.DeliveryTimes {
+		total += d
+	}
+
+	return total / time.Duration(len(r.DeliveryTimes))
+}
+
+// P95DeliveryTime - 95-й перцентиль времени доставки (метод ближайшего ранга)
+func (r *report) P95DeliveryTime() time.Duration {
+	if len(r.DeliveryTimes) == 0 {
+		return 0
+	}
+
+	sorted := slices.Clone(r.DeliveryTimes)
+	slices.Sort(sorted)
+
+	rank := int(math.Ceil(0.95*float64(len(sorted)))) - 1
+	return sorted[max(rank, 0)]
+}
+
+func (r *report) Utilization() float64 {
+	if r.CourierTicks == 0 {
+		return 0
+	}
+
+	return float64(r.BusyCourierTicks) / float64(r.CourierTicks)
+}
+
+func (r *report) Print(w io.Writer) {
+	_, _ = fmt.Fprintf(w, "simulated time:      %s (wall %s)\n", r.SimulatedDuration, r.WallDuration.Round(time.Millisecond))
+	_, _ = fmt.Fprintf(w, "orders:              %d\n", r.Orders)
+	_, _ = fmt.Fprintf(w, "delivered:           %d\n", len(r.DeliveryTimes))
+	_, _ = fmt.Fprintf(w, "undeliverable:       %d\n", r.Undeliverable)
+	_, _ = fmt.Fprintf(w, "not finished:        %d\n", r.Pending)
+	_, _ = fmt.Fprintf(w, "mean delivery time:  %s\n", r.MeanDeliveryTime())
+	_, _ = fmt.Fprintf(w, "p95 delivery time:   %s\n", r.P95DeliveryTime())
+	_, _ = fmt.Fprintf(w, "courier utilization: %.1f%%\n", r.Utilization()*100)
+}
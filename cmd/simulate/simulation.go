@@ -0,0 +1,312 @@
+package main
+
+import (
+	"context"
+	"delivery/cmd"
+	"delivery/internal/adapters/out/clock"
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/services"
+	"delivery/internal/core/ports"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"log"
+	"math/rand"
+	"os"
+	"time"
+)
+
+// simulationTrackingSecret - симуляция не выдает ссылок отслеживания, постоянный ключ только избавляет
+// от случайного ключа и предупреждения о нем при каждом запуске
+const simulationTrackingSecret = "simulation-tracking-token-secret"
+
+type simulationConfig struct {
+	Couriers            int
+	MaxSpeed            int
+	TrunkShare          float64
+	Orders              int
+	MaxVolume           int
+	MaxWeight           int
+	ExpressShare        float64
+	Spatial             string
+	Temporal            string
+	OrderWindow         time.Duration
+	MaxDuration         time.Duration
+	Tick                time.Duration
+	Speedup             float64
+	MaxDispatchAttempts int
+	AreaWidth           int
+	AreaHeight          int
+	CityMapFile         string
+	Depots              int
+	Stores              int
+	IdleReturn          string
+	StagingPoint        string
+	Seed                int64
+}
+
+type simulation struct {
+	cfg   simulationConfig
+	rng   *rand.Rand
+	area  kernel.ServiceArea
+	uow   ports.UnitOfWork
+	ids   ports.IDGenerator
+	clock *clock.FakeClock
+
+	assign commands.AssignOrderCommandHandler
+	move   commands.MoveCouriersCommandHandler
+
+	createdAt map[uuid.UUID]time.Time
+	pending   map[uuid.UUID]struct{}
+	report    *report
+}
+
+func run(cfg simulationConfig) (*report, error) {
+	if cfg.Couriers <= 0 || cfg.MaxSpeed <= 0 || cfg.Orders < 0 || cfg.MaxVolume <= 0 || cfg.Tick <= 0 {
+		return nil, errors.New("couriers, max-speed, max-volume and tick must be positive")
+	}
+
+	s, err := newSimulation(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	if err = s.createCouriers(ctx); err != nil {
+		return nil, err
+	}
+
+	return s.run(ctx)
+}
+
+func newSimulation(cfg simulationConfig) (*simulation, error) {
+	// размер зоны берется из карты города, если она задана
+	if cfg.CityMapFile != "" {
+		f, err := os.Open(cfg.CityMapFile)
+		if err != nil {
+			return nil, err
+		}
+
+		//goland:noinspection GoUnhandledErrorResult
+		defer f.Close()
+
+		cityMap, err := kernel.ParseCityMap(f)
+		if err != nil {
+			return nil, err
+		}
+
+		cfg.AreaWidth, cfg.AreaHeight = cityMap.Width(), cityMap.Height()
+	}
+
+	// seed 0 - случайный прогон. seed берется из времени и печатается, чтобы прогон можно было повторить
+	if cfg.Seed == 0 {
+		cfg.Seed = time.Now().UnixNano()
+		log.Printf("random seed: %d", cfg.Seed)
+	}
+
+	cr := cmd.NewCompositionRoot(cmd.Config{
+		StorageMode:         "memory",
+		MaxDispatchAttempts: cfg.MaxDispatchAttempts,
+		ServiceAreaWidth:    cfg.AreaWidth,
+		ServiceAreaHeight:   cfg.AreaHeight,
+		DistanceMode:        "grid",
+		CityMapFile:         cfg.CityMapFile,
+		RandomSeed:          cfg.Seed,
+		IdleCourierReturn:   cfg.IdleReturn,
+		IdleStagingPoint:    cfg.StagingPoint,
+		TrackingTokenSecret: simulationTrackingSecret,
+	})
+
+	fakeClock := clock.NewFakeClock(time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC))
+	cr.SetClock(fakeClock)
+
+	return &simulation{
+		cfg:       cfg,
+		rng:       rand.New(rand.NewSource(cfg.Seed)),
+		area:      cr.ServiceArea(),
+		uow:       cr.UnitOfWork(),
+		ids:       cr.IDGenerator(),
+		clock:     fakeClock,
+		assign:    cr.NewAssignOrderCommandHandler(),
+		move:      cr.NewMoveCouriersCommandHandler(),
+		createdAt: map[uuid.UUID]time.Time{},
+		pending:   map[uuid.UUID]struct{}{},
+		report:    &report{},
+	}, nil
+}
+
+func (s *simulation) createCouriers(ctx context.Context) error {
+	depots := make([]kernel.Location, 0, s.cfg.Depots)
+	for range s.cfg.Depots {
+		depots = append(depots, uniformLocation(s.rng, s.area))
+	}
+
+	couriers := make([]*courier.Courier, 0, s.cfg.Couriers)
+	for i := range s.cfg.Couriers {
+		start := uniformLocation(s.rng, s.area)
+		if len(depots) > 0 {
+			start = depots[i%len(depots)]
+		}
+
+		c, err := courier.NewCourier(fmt.Sprintf("courier%d", i+1), s.rng.Intn(s.cfg.MaxSpeed)+1, start, s.ids)
+		if err != nil {
+			return err
+		}
+
+		if len(depots) > 0 {
+			err = c.SetHomeDepot(start)
+			if err != nil {
+				return err
+			}
+		}
+
+		if s.rng.Float64() < s.cfg.TrunkShare {
+			err = c.AddStoragePlace("Trunk", courier.StoragePlaceStandard, 20+s.rng.Intn(31), 0, s.ids)
+			if err != nil {
+				return err
+			}
+		}
+
+		couriers = append(couriers, c)
+	}
+
+	return s.uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		return uowc.CourierRepository().Save(ctx, couriers...)
+	})
+}
+
+func (s *simulation) run(ctx context.Context) (*report, error) {
+	arrivals, err := arrivalOffsets(s.rng, s.cfg.Temporal, s.cfg.Orders, s.cfg.OrderWindow)
+	if err != nil {
+		return nil, err
+	}
+
+	spatial, err := newSpatialGenerator(s.rng, s.cfg.Spatial, s.area)
+	if err != nil {
+		return nil, err
+	}
+
+	stores := make([]kernel.Location, 0, s.cfg.Stores)
+	for range s.cfg.Stores {
+		stores = append(stores, uniformLocation(s.rng, s.area))
+	}
+
+	start := s.clock.Now()
+	wallStart := time.Now()
+
+	for next := 0; ; {
+		elapsed := s.clock.Now().Sub(start)
+
+		// новые заказы, время поступления которых наступило
+		for next < len(arrivals) && arrivals[next] <= elapsed {
+			if err = s.createOrder(ctx, stores, spatial()); err != nil {
+				return nil, err
+			}
+			next++
+		}
+
+		err = s.assign.Handle(ctx)
+		if err != nil && !errors.Is(err, services.ErrNoMatchingCourier) {
+			return nil, err
+		}
+
+		if err = s.move.Handle(ctx); err != nil {
+			return nil, err
+		}
+
+		s.clock.Advance(s.cfg.Tick)
+
+		if err = s.collect(ctx); err != nil {
+			return nil, err
+		}
+
+		if (next == len(arrivals) && len(s.pending) == 0) || s.clock.Now().Sub(start) >= s.cfg.MaxDuration {
+			break
+		}
+
+		if s.cfg.Speedup > 0 {
+			time.Sleep(time.Duration(float64(s.cfg.Tick) / s.cfg.Speedup))
+		}
+	}
+
+	s.report.Pending = len(s.pending)
+	s.report.SimulatedDuration = s.clock.Now().Sub(start)
+	s.report.WallDuration = time.Since(wallStart)
+
+	return s.report, nil
+}
+
+// createOrder создает заказ в location. Если заданы магазины, товар забирается в случайном из них
+func (s *simulation) createOrder(ctx context.Context, stores []kernel.Location, location kernel.Location) error {
+	priority := order.PriorityStandard
+	if s.rng.Float64() < s.cfg.ExpressShare {
+		priority = order.PriorityExpress
+	}
+
+	volume := s.rng.Intn(s.cfg.MaxVolume) + 1
+
+	// без max-weight заказы создаются без веса, и последовательность случайных чисел не меняется
+	weight := 0
+	if s.cfg.MaxWeight > 0 {
+		weight = s.rng.Intn(s.cfg.MaxWeight) + 1
+	}
+
+	var o *order.Order
+	var err error
+	if len(stores) > 0 {
+		pickup := stores[s.rng.Intn(len(stores))]
+		o, err = order.NewPickupOrder(s.ids.NewId(), pickup, location, volume, weight, priority, s.ids, s.clock)
+	} else {
+		o, err = order.NewOrder(s.ids.NewId(), location, volume, weight, priority, s.ids, s.clock)
+	}
+	if err != nil {
+		return err
+	}
+
+	err = s.uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		return uowc.OrderRepository().Save(ctx, o)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.createdAt[o.Id()] = s.clock.Now()
+	s.pending[o.Id()] = struct{}{}
+	s.report.Orders++
+
+	return nil
+}
+
+// collect отмечает завершенные и недоставляемые заказы и считает занятость курьеров
+func (s *simulation) collect(ctx context.Context) error {
+	return s.uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		for id := range s.pending {
+			o, err := uowc.OrderRepository().Get(ctx, id)
+			if err != nil {
+				return err
+			}
+
+			switch o.Status() {
+			case order.StatusCompleted:
+				s.report.DeliveryTimes = append(s.report.DeliveryTimes, s.clock.Now().Sub(s.createdAt[id]))
+				delete(s.pending, id)
+			case order.StatusUndeliverable:
+				s.report.Undeliverable++
+				delete(s.pending, id)
+			}
+		}
+
+		free, err := uowc.CourierRepository().GetAllFree(ctx)
+		if err != nil {
+			return err
+		}
+
+		s.report.BusyCourierTicks += s.cfg.Couriers - len(free)
+		s.report.CourierTicks += s.cfg.Couriers
+
+		return nil
+	})
+}
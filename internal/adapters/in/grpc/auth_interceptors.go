@@ -0,0 +1,117 @@
+package grpc
+
+import (
+	"context"
+	"delivery/internal/adapters/in/http/auth"
+	"delivery/internal/pkg/errs"
+	"errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"net/http"
+)
+
+// credentialHeaders - метаданные gRPC, в которых клиент передает учетные данные, как в заголовках HTTP API
+var credentialHeaders = []string{auth.HeaderApiKey, "Authorization"}
+
+// NewAuthInterceptors проверяет учетные данные и права для каждого вызова теми же Authenticator и Authorizer,
+// что и HTTP API. В потоках проверяется каждое сообщение клиента: курьер не может прислать отметку за другого
+func NewAuthInterceptors(authenticator auth.Authenticator,
+	authorizer auth.Authorizer) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor, error) {
+	if authenticator == nil {
+		return nil, nil, errs.NewValueIsRequiredError("authenticator")
+	}
+
+	if authorizer == nil {
+		return nil, nil, errs.NewValueIsRequiredError("authorizer")
+	}
+
+	unary := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		principal, err := authenticate(ctx, authenticator)
+		if err != nil {
+			return nil, err
+		}
+
+		err = authorize(ctx, authorizer, principal, info.FullMethod, req)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(auth.WithPrincipal(ctx, principal), req)
+	}
+
+	stream := func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		principal, err := authenticate(ss.Context(), authenticator)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &authorizedStream{
+			ServerStream: ss,
+			ctx:          auth.WithPrincipal(ss.Context(), principal),
+			authorizer:   authorizer,
+			principal:    principal,
+			method:       info.FullMethod,
+		})
+	}
+
+	return unary, stream, nil
+}
+
+// authorizedStream пропускает к обработчику только разрешенные сообщения клиента
+type authorizedStream struct {
+	grpc.ServerStream
+	ctx        context.Context
+	authorizer auth.Authorizer
+	principal  auth.Principal
+	method     string
+}
+
+func (s *authorizedStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *authorizedStream) RecvMsg(m any) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err != nil {
+		return err
+	}
+
+	return authorize(s.ctx, s.authorizer, s.principal, s.method, m)
+}
+
+// authenticate передает учетные данные из метаданных вызова в Authenticator HTTP API
+func authenticate(ctx context.Context, authenticator auth.Authenticator) (auth.Principal, error) {
+	r, err := http.NewRequestWithContext(ctx, http.MethodPost, "/", nil)
+	if err != nil {
+		return auth.Principal{}, status.Error(codes.Internal, err.Error())
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, header := range credentialHeaders {
+		for _, value := range md.Get(header) {
+			r.Header.Add(header, value)
+		}
+	}
+
+	principal, err := authenticator.Authenticate(r)
+	if err != nil {
+		return auth.Principal{}, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	return principal, nil
+}
+
+func authorize(ctx context.Context, authorizer auth.Authorizer, principal auth.Principal, method string,
+	request any) error {
+	err := authorizer.Authorize(ctx, principal, method, request)
+	if err != nil {
+		if errors.Is(err, auth.ErrForbidden) {
+			return status.Error(codes.PermissionDenied, err.Error())
+		}
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return nil
+}
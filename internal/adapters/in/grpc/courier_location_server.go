@@ -0,0 +1,94 @@
+package grpc
+
+import (
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/generated/grpcservers/locationpb"
+	"delivery/internal/pkg/errs"
+	"errors"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+	"log"
+	"time"
+)
+
+var _ locationpb.CourierLocationServer = &courierLocationServer{}
+
+type courierLocationServer struct {
+	locationpb.UnimplementedCourierLocationServer
+	reportCourierLocationHandler commands.ReportCourierLocationCommandHandler
+}
+
+func NewCourierLocationServer(
+	reportCourierLocationHandler commands.ReportCourierLocationCommandHandler,
+) (locationpb.CourierLocationServer, error) {
+	if reportCourierLocationHandler == nil {
+		return nil, errs.NewValueIsRequiredError("reportCourierLocationHandler")
+	}
+
+	return &courierLocationServer{
+		reportCourierLocationHandler: reportCourierLocationHandler,
+	}, nil
+}
+
+// ReportLocations обрабатывает отметки по одной, в порядке получения. Ошибочная отметка не прерывает поток:
+// она учитывается в ответе, чтобы приложение не отправляло пачку повторно
+func (s *courierLocationServer) ReportLocations(
+	stream locationpb.CourierLocation_ReportLocationsServer) error {
+
+	reply := &locationpb.ReportLocationsReply{}
+	for {
+		ping, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return stream.SendAndClose(reply)
+		}
+		if err != nil {
+			return err
+		}
+
+		cmd, err := toReportCourierLocationCommand(ping)
+		if err != nil {
+			log.Printf("rejected location ping of courier %s: %v", ping.GetCourierId(), err)
+			reply.Rejected++
+			continue
+		}
+
+		err = s.reportCourierLocationHandler.Handle(stream.Context(), cmd)
+		switch {
+		case err == nil:
+			reply.Accepted++
+		case errors.Is(err, courier.ErrStaleLocation):
+			reply.Ignored++
+		case errors.Is(err, commands.ErrSimulatedMovement):
+			return status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, errs.ErrObjectNotFound), errors.Is(err, errs.ErrValueIsOutOfRange),
+			errors.Is(err, kernel.ErrLocationOutOfServiceArea), errors.Is(err, commands.ErrLocationFromFuture):
+			log.Printf("rejected location ping of courier %s: %v", ping.GetCourierId(), err)
+			reply.Rejected++
+		default:
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+}
+
+func toReportCourierLocationCommand(ping *locationpb.LocationPing) (commands.ReportCourierLocationCommand, error) {
+	courierID, err := uuid.Parse(ping.GetCourierId())
+	if err != nil {
+		return commands.ReportCourierLocationCommand{}, errs.NewValueIsInvalidError("courierId")
+	}
+
+	location, err := kernel.NewGeoLocation(ping.GetLat(), ping.GetLon())
+	if err != nil {
+		return commands.ReportCourierLocationCommand{}, err
+	}
+
+	var recordedAt time.Time
+	if ping.GetRecordedAt() != nil {
+		recordedAt = ping.GetRecordedAt().AsTime()
+	}
+
+	return commands.NewReportCourierLocationCommand(courierID, location, recordedAt)
+}
@@ -0,0 +1,226 @@
+package grpc
+
+import (
+	"context"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/generated/grpcservers/deliverypb"
+	"delivery/internal/pkg/errs"
+	"errors"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ deliverypb.DeliveryServer = &deliveryServer{}
+
+type deliveryServer struct {
+	deliverypb.UnimplementedDeliveryServer
+	orderDetailsQueryHandler queries.OrderDetailsQueryHandler
+	allCouriersQueryHandler  queries.AllCouriersQueryHandler
+	orderEtaQueryHandler     queries.OrderEtaQueryHandler
+	liveUpdates              ports.LiveUpdatesSubscriber
+}
+
+func NewDeliveryServer(
+	orderDetailsQueryHandler queries.OrderDetailsQueryHandler,
+	allCouriersQueryHandler queries.AllCouriersQueryHandler,
+	orderEtaQueryHandler queries.OrderEtaQueryHandler,
+	liveUpdates ports.LiveUpdatesSubscriber,
+) (deliverypb.DeliveryServer, error) {
+	if orderDetailsQueryHandler == nil {
+		return nil, errs.NewValueIsRequiredError("orderDetailsQueryHandler")
+	}
+
+	if allCouriersQueryHandler == nil {
+		return nil, errs.NewValueIsRequiredError("allCouriersQueryHandler")
+	}
+
+	if orderEtaQueryHandler == nil {
+		return nil, errs.NewValueIsRequiredError("orderEtaQueryHandler")
+	}
+
+	if liveUpdates == nil {
+		return nil, errs.NewValueIsRequiredError("liveUpdates")
+	}
+
+	return &deliveryServer{
+		orderDetailsQueryHandler: orderDetailsQueryHandler,
+		allCouriersQueryHandler:  allCouriersQueryHandler,
+		orderEtaQueryHandler:     orderEtaQueryHandler,
+		liveUpdates:              liveUpdates,
+	}, nil
+}
+
+func (s *deliveryServer) GetOrder(ctx context.Context, req *deliverypb.GetOrderRequest) (*deliverypb.Order, error) {
+	orderID, err := parseOrderID(req.GetOrderId())
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.getOrder(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toOrder(response), nil
+}
+
+func (s *deliveryServer) ListCouriers(ctx context.Context,
+	_ *deliverypb.ListCouriersRequest) (*deliverypb.ListCouriersReply, error) {
+
+	response, err := s.allCouriersQueryHandler.Handle(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	reply := &deliverypb.ListCouriersReply{
+		Couriers: make([]*deliverypb.Courier, 0, len(response)),
+	}
+	for _, c := range response {
+		reply.Couriers = append(reply.Couriers, &deliverypb.Courier{
+			Id:       c.CourierID.String(),
+			Name:     c.Name,
+			Location: &deliverypb.Location{X: int32(c.LocationX), Y: int32(c.LocationY)},
+		})
+	}
+
+	return reply, nil
+}
+
+func (s *deliveryServer) GetOrderEta(ctx context.Context,
+	req *deliverypb.GetOrderEtaRequest) (*deliverypb.GetOrderEtaReply, error) {
+
+	orderID, err := parseOrderID(req.GetOrderId())
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.orderEtaQueryHandler.Handle(ctx, orderID)
+	switch {
+	case errors.Is(err, queries.ErrOrderNotOnTheWay):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, errs.ErrObjectNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case err != nil:
+		return nil, status.Error(codes.Internal, err.Error())
+	case response == nil:
+		return nil, status.Errorf(codes.NotFound, "order %s not found", orderID)
+	}
+
+	return &deliverypb.GetOrderEtaReply{
+		OrderId:    response.OrderID.String(),
+		CourierId:  response.CourierID.String(),
+		EtaSeconds: response.Eta,
+	}, nil
+}
+
+// WatchOrder подписывается на изменения заказа до чтения его текущего состояния, чтобы не пропустить
+// изменение между чтением и подпиской. Изменения служат только сигналом: заказ каждый раз читается заново,
+// поэтому клиент получает полное состояние даже если часть изменений пропущена
+func (s *deliveryServer) WatchOrder(req *deliverypb.WatchOrderRequest,
+	stream grpc.ServerStreamingServer[deliverypb.Order]) error {
+
+	orderID, err := parseOrderID(req.GetOrderId())
+	if err != nil {
+		return err
+	}
+
+	updates, unsubscribe := s.liveUpdates.Subscribe(ports.LiveUpdatesFilter{OrderIds: []uuid.UUID{orderID}})
+	defer unsubscribe()
+
+	ctx := stream.Context()
+	for {
+		response, err := s.getOrder(ctx, orderID)
+		if err != nil {
+			return err
+		}
+
+		if err := stream.Send(toOrder(response)); err != nil {
+			return err
+		}
+
+		if order.Status(response.Status).IsFinished() {
+			return nil
+		}
+
+		if err := waitOrderUpdate(ctx, updates); err != nil {
+			return err
+		}
+	}
+}
+
+// waitOrderUpdate ждет очередное изменение заказа и пропускает изменения, накопившиеся за это время
+func waitOrderUpdate(ctx context.Context, updates <-chan ports.LiveUpdate) error {
+	select {
+	case <-ctx.Done():
+		return status.FromContextError(ctx.Err()).Err()
+	case _, ok := <-updates:
+		if !ok {
+			return status.Error(codes.Unavailable, "order updates closed")
+		}
+	}
+
+	for {
+		select {
+		case _, ok := <-updates:
+			if !ok {
+				return nil
+			}
+		default:
+			return nil
+		}
+	}
+}
+
+func (s *deliveryServer) getOrder(ctx context.Context, orderID uuid.UUID) (*queries.OrderDetailsResponse, error) {
+	response, err := s.orderDetailsQueryHandler.Handle(ctx, orderID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if response == nil {
+		return nil, status.Errorf(codes.NotFound, "order %s not found", orderID)
+	}
+
+	return response, nil
+}
+
+func parseOrderID(value string) (uuid.UUID, error) {
+	orderID, err := uuid.Parse(value)
+	if err != nil || orderID == uuid.Nil {
+		return uuid.Nil, status.Error(codes.InvalidArgument, errs.NewValueIsInvalidError("orderId").Error())
+	}
+
+	return orderID, nil
+}
+
+func toOrder(response *queries.OrderDetailsResponse) *deliverypb.Order {
+	result := &deliverypb.Order{
+		Id:        response.OrderID.String(),
+		Status:    response.Status,
+		Priority:  response.Priority,
+		Location:  &deliverypb.Location{X: int32(response.LocationX), Y: int32(response.LocationY)},
+		CreatedAt: timestamppb.New(response.CreatedAt),
+	}
+
+	if response.CourierID != nil {
+		result.CourierId = response.CourierID.String()
+	}
+
+	if response.PickupLocationX != nil && response.PickupLocationY != nil {
+		result.PickupLocation = &deliverypb.Location{
+			X: int32(*response.PickupLocationX),
+			Y: int32(*response.PickupLocationY),
+		}
+	}
+
+	if response.ParentID != nil {
+		result.ParentId = response.ParentID.String()
+	}
+
+	return result
+}
@@ -0,0 +1,95 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"delivery/internal/pkg/errs"
+	"fmt"
+	"github.com/google/uuid"
+	"net/http"
+	"strings"
+)
+
+const HeaderApiKey = "X-API-Key"
+
+var _ Authenticator = &apiKeyAuthenticator{}
+
+// apiKeyAuthenticator проверяет статические ключи из заголовка X-API-Key. Ключи хранятся как SHA-256:
+// поиск по хешу не раскрывает по времени ответа, насколько ключ совпал
+type apiKeyAuthenticator struct {
+	keys map[[sha256.Size]byte]Principal
+}
+
+func NewApiKeyAuthenticator(keys map[string]Principal) (Authenticator, error) {
+	if len(keys) == 0 {
+		return nil, errs.NewValueIsRequiredError("keys")
+	}
+
+	hashed := make(map[[sha256.Size]byte]Principal, len(keys))
+	for key, principal := range keys {
+		if key == "" || principal.IsEmpty() {
+			return nil, errs.NewValueIsInvalidError("keys")
+		}
+
+		hashed[sha256.Sum256([]byte(key))] = principal
+	}
+
+	return &apiKeyAuthenticator{keys: hashed}, nil
+}
+
+func (a *apiKeyAuthenticator) Authenticate(r *http.Request) (Principal, error) {
+	key := r.Header.Get(HeaderApiKey)
+	if key == "" {
+		return Principal{}, ErrNoCredentials
+	}
+
+	principal, ok := a.keys[sha256.Sum256([]byte(key))]
+	if !ok {
+		return Principal{}, fmt.Errorf("%w: unknown api key", ErrInvalidCredentials)
+	}
+
+	return principal, nil
+}
+
+// ParseApiKeys разбирает список ключей вида "роль=ключ" через запятую. Ключу приложения курьера
+// нужен курьер: "courier:<id курьера>=ключ"
+func ParseApiKeys(spec string) (map[string]Principal, error) {
+	keys := make(map[string]Principal)
+	for i, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		owner, key, ok := strings.Cut(entry, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("api key %d: expected role=key", i+1)
+		}
+
+		roleName, courierIdValue, _ := strings.Cut(owner, ":")
+		role, err := RoleFromString(roleName)
+		if err != nil {
+			return nil, fmt.Errorf("api key %d: %w", i+1, err)
+		}
+
+		var courierId uuid.UUID
+		if courierIdValue != "" {
+			courierId, err = uuid.Parse(courierIdValue)
+			if err != nil {
+				return nil, fmt.Errorf("api key %d: invalid courier id", i+1)
+			}
+		}
+
+		principal, err := NewPrincipal(fmt.Sprintf("api-key-%d", i+1), role, courierId)
+		if err != nil {
+			return nil, fmt.Errorf("api key %d: %w", i+1, err)
+		}
+
+		if _, exists := keys[key]; exists {
+			return nil, fmt.Errorf("api key %d: duplicate key", i+1)
+		}
+
+		keys[key] = principal
+	}
+
+	return keys, nil
+}
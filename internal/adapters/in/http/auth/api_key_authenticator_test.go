@@ -0,0 +1,57 @@
+package auth
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestParseApiKeys(t *testing.T) {
+
+	courierId := uuid.New()
+	keys, err := ParseApiKeys("admin=a1, courier:" + courierId.String() + "=c1=, service=s1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(keys) != 3 || keys["a1"].Role() != RoleAdmin || keys["s1"].Role() != RoleService {
+		t.Fatalf("unexpected keys %v", keys)
+	}
+
+	// ключ может содержать "=", например в base64
+	if keys["c1="].Role() != RoleCourier || keys["c1="].CourierId() != courierId {
+		t.Fatal("courier key must be bound to courier")
+	}
+
+	for _, spec := range []string{"admin", "root=k", "courier=k", "admin:" + courierId.String() + "=k", "admin=k,service=k"} {
+		if _, err := ParseApiKeys(spec); err == nil {
+			t.Errorf("spec %q must be rejected", spec)
+		}
+	}
+}
+
+func TestApiKeyAuthenticator_Authenticate(t *testing.T) {
+
+	keys, _ := ParseApiKeys("dispatcher=secret")
+	authenticator, err := NewApiKeyAuthenticator(keys)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := httptest.NewRequest("GET", "/", nil)
+	if _, err := authenticator.Authenticate(r); !errors.Is(err, ErrNoCredentials) {
+		t.Fatalf("expected no credentials, got %v", err)
+	}
+
+	r.Header.Set(HeaderApiKey, "wrong")
+	if _, err := authenticator.Authenticate(r); !errors.Is(err, ErrInvalidCredentials) {
+		t.Fatalf("expected invalid credentials, got %v", err)
+	}
+
+	r.Header.Set(HeaderApiKey, "secret")
+	principal, err := authenticator.Authenticate(r)
+	if err != nil || principal.Role() != RoleDispatcher {
+		t.Fatalf("expected dispatcher, got %v, %v", principal, err)
+	}
+}
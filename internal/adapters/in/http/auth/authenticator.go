@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"errors"
+	"net/http"
+)
+
+var (
+	// ErrNoCredentials - в запросе нет учетных данных, которые проверяет Authenticator
+	ErrNoCredentials      = errors.New("no credentials")
+	ErrInvalidCredentials = errors.New("invalid credentials")
+)
+
+// Authenticator определяет, от чьего имени выполняется запрос
+type Authenticator interface {
+	// Authenticate возвращает ErrNoCredentials, если учетных данных нет, и ErrInvalidCredentials, если они неверны
+	Authenticate(r *http.Request) (Principal, error)
+}
+
+var _ Authenticator = chain{}
+
+// chain пробует способы по очереди. Первый способ, нашедший учетные данные в запросе, решает исход:
+// неверный ключ не дает шанса следующему способу
+type chain []Authenticator
+
+func NewChain(authenticators ...Authenticator) (Authenticator, error) {
+	if len(authenticators) == 0 {
+		return nil, errors.New("no authenticators")
+	}
+
+	for _, a := range authenticators {
+		if a == nil {
+			return nil, errors.New("nil authenticator")
+		}
+	}
+
+	return chain(authenticators), nil
+}
+
+func (c chain) Authenticate(r *http.Request) (Principal, error) {
+	for _, a := range c {
+		principal, err := a.Authenticate(r)
+		if errors.Is(err, ErrNoCredentials) {
+			continue
+		}
+
+		return principal, err
+	}
+
+	return Principal{}, ErrNoCredentials
+}
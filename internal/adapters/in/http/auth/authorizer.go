@@ -0,0 +1,129 @@
+package auth
+
+import (
+	"context"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/generated/grpcservers/deliverypb"
+	"delivery/internal/generated/grpcservers/locationpb"
+	"delivery/internal/generated/servers"
+	"delivery/internal/pkg/errs"
+	"errors"
+	"github.com/google/uuid"
+	"slices"
+)
+
+var ErrForbidden = errors.New("forbidden")
+
+// publicOperations доступны без учетных данных
+var publicOperations = []string{"TrackOrder"}
+
+// policy - роли, которым разрешена операция: operationId HTTP API или полное имя метода gRPC.
+// Операции, которой нет в списке, запрещены всем. Курьеру операция разрешена только над его ресурсами, см. Authorize
+var policy = map[string][]Role{
+	"GetCouriers":               {RoleAdmin, RoleDispatcher, RoleService},
+	"CreateCourier":             {RoleAdmin},
+	"GetCourier":                {RoleAdmin, RoleDispatcher, RoleService, RoleCourier},
+	"GrantQualification":        {RoleAdmin},
+	"RevokeQualification":       {RoleAdmin},
+	"ReportCourierLocation":     {RoleService, RoleCourier},
+	"CreateOrder":               {RoleAdmin, RoleDispatcher, RoleService},
+	"GetOrders":                 {RoleAdmin, RoleDispatcher, RoleService},
+	"ConfirmDelivery":           {RoleAdmin, RoleDispatcher, RoleCourier},
+	"FailDelivery":              {RoleAdmin, RoleDispatcher, RoleCourier},
+	"ManualAssignOrder":         {RoleAdmin, RoleDispatcher},
+	"ReassignOrder":             {RoleAdmin, RoleDispatcher},
+	"UnassignOrder":             {RoleAdmin, RoleDispatcher},
+	"GetOrderDispatchDecisions": {RoleAdmin, RoleDispatcher},
+	"SimulateDispatch":          {RoleAdmin, RoleDispatcher},
+
+	deliverypb.Delivery_GetOrder_FullMethodName:               {RoleAdmin, RoleDispatcher, RoleService},
+	deliverypb.Delivery_ListCouriers_FullMethodName:           {RoleAdmin, RoleDispatcher, RoleService},
+	deliverypb.Delivery_GetOrderEta_FullMethodName:            {RoleAdmin, RoleDispatcher, RoleService},
+	deliverypb.Delivery_WatchOrder_FullMethodName:             {RoleAdmin, RoleDispatcher, RoleService},
+	locationpb.CourierLocation_ReportLocations_FullMethodName: {RoleService, RoleCourier},
+}
+
+func IsPublic(operationID string) bool {
+	return slices.Contains(publicOperations, operationID)
+}
+
+// Authorizer решает, может ли Principal выполнить операцию HTTP API или метод gRPC
+type Authorizer interface {
+	// Authorize возвращает ErrForbidden, если операция запрещена. Для потоков gRPC request - очередное
+	// сообщение клиента
+	Authorize(ctx context.Context, principal Principal, operationID string, request any) error
+}
+
+var _ Authorizer = &authorizer{}
+
+type authorizer struct {
+	orderDetailsQueryHandler queries.OrderDetailsQueryHandler
+}
+
+func NewAuthorizer(orderDetailsQueryHandler queries.OrderDetailsQueryHandler) (Authorizer, error) {
+	if orderDetailsQueryHandler == nil {
+		return nil, errs.NewValueIsRequiredError("orderDetailsQueryHandler")
+	}
+
+	return &authorizer{orderDetailsQueryHandler: orderDetailsQueryHandler}, nil
+}
+
+func (a *authorizer) Authorize(ctx context.Context, principal Principal, operationID string, request any) error {
+	if principal.IsEmpty() {
+		return ErrForbidden
+	}
+
+	if !slices.Contains(policy[operationID], principal.Role()) {
+		return ErrForbidden
+	}
+
+	if principal.Role() != RoleCourier {
+		return nil
+	}
+
+	return a.authorizeCourier(ctx, principal.CourierId(), request)
+}
+
+// authorizeCourier пропускает запрос курьера только к нему самому и к назначенным ему заказам
+func (a *authorizer) authorizeCourier(ctx context.Context, courierId uuid.UUID, request any) error {
+	switch r := request.(type) {
+	case servers.GetCourierRequestObject:
+		return requireCourier(courierId, r.CourierId)
+	case servers.ReportCourierLocationRequestObject:
+		return requireCourier(courierId, r.CourierId)
+	case servers.ConfirmDeliveryRequestObject:
+		return a.requireOrderOfCourier(ctx, courierId, r.OrderId)
+	case servers.FailDeliveryRequestObject:
+		return a.requireOrderOfCourier(ctx, courierId, r.OrderId)
+	case *locationpb.LocationPing:
+		requested, err := uuid.Parse(r.GetCourierId())
+		if err != nil {
+			return ErrForbidden
+		}
+		return requireCourier(courierId, requested)
+	default:
+		return ErrForbidden
+	}
+}
+
+func (a *authorizer) requireOrderOfCourier(ctx context.Context, courierId uuid.UUID, orderId uuid.UUID) error {
+	order, err := a.orderDetailsQueryHandler.Handle(ctx, orderId)
+	if err != nil {
+		return err
+	}
+
+	// чужой и несуществующий заказ неразличимы, чтобы курьер не мог перебирать заказы
+	if order == nil || order.CourierID == nil {
+		return ErrForbidden
+	}
+
+	return requireCourier(courierId, *order.CourierID)
+}
+
+func requireCourier(courierId uuid.UUID, requested uuid.UUID) error {
+	if courierId != requested {
+		return ErrForbidden
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+)
+
+// Jwks - открытые ключи проверки JWT по идентификатору ключа (kid)
+type Jwks map[string]crypto.PublicKey
+
+type jsonWebKey struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	// RSA
+	N string `json:"n"`
+	E string `json:"e"`
+	// EC
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// LoadJwks читает локальный файл JWKS (RFC 7517). Поддерживаются ключи RSA и EC P-256
+func LoadJwks(path string) (Jwks, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseJwks(data)
+}
+
+func ParseJwks(data []byte) (Jwks, error) {
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("parse jwks: %w", err)
+	}
+
+	jwks := make(Jwks, len(set.Keys))
+	for i, jwk := range set.Keys {
+		// ключи шифрования не годятся для проверки подписи
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+
+		key, err := jwk.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("jwks key %d: %w", i+1, err)
+		}
+
+		if _, exists := jwks[jwk.Kid]; exists {
+			return nil, fmt.Errorf("jwks key %d: duplicate kid %q", i+1, jwk.Kid)
+		}
+
+		jwks[jwk.Kid] = key
+	}
+
+	if len(jwks) == 0 {
+		return nil, errors.New("jwks has no signing keys")
+	}
+
+	return jwks, nil
+}
+
+func (k jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("invalid n: %w", err)
+		}
+
+		e, err := decodeBigInt(k.E)
+		if err != nil || !e.IsInt64() || e.Int64() < 3 || e.Int64() > 1<<31-1 {
+			return nil, errors.New("invalid e")
+		}
+
+		if n.BitLen() < 2048 {
+			return nil, errors.New("rsa key is shorter than 2048 bits")
+		}
+
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		if k.Crv != "P-256" {
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, fmt.Errorf("invalid x: %w", err)
+		}
+
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, fmt.Errorf("invalid y: %w", err)
+		}
+
+		curve := elliptic.P256()
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.New("point is not on curve")
+		}
+
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) == 0 {
+		return nil, errors.New("empty value")
+	}
+
+	return new(big.Int).SetBytes(data), nil
+}
@@ -0,0 +1,247 @@
+package auth
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/sha256"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"math/big"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+// clockSkew - допустимое расхождение часов сервиса и выпустившего токен сервера авторизации
+const clockSkew = 30 * time.Second
+
+var _ Authenticator = &jwtAuthenticator{}
+
+// jwtAuthenticator проверяет JWT из заголовка "Authorization: Bearer". Поддерживаются подписи RS256 и ES256.
+// Роль берется из claim role, курьер приложения - из claim courier_id
+type jwtAuthenticator struct {
+	keys Jwks
+	// issuer, audience - ожидаемые iss и aud. Пустые - не проверяются
+	issuer   string
+	audience string
+	clock    ports.Clock
+}
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+type jwtClaims struct {
+	Subject   string       `json:"sub"`
+	Issuer    string       `json:"iss"`
+	Audience  jwtAudience  `json:"aud"`
+	ExpiresAt *json.Number `json:"exp"`
+	NotBefore *json.Number `json:"nbf"`
+	Role      string       `json:"role"`
+	CourierId string       `json:"courier_id"`
+}
+
+// jwtAudience - aud бывает строкой или массивом строк
+type jwtAudience []string
+
+func (a *jwtAudience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = jwtAudience{single}
+		return nil
+	}
+
+	var many []string
+	if err := json.Unmarshal(data, &many); err != nil {
+		return err
+	}
+
+	*a = many
+	return nil
+}
+
+func NewJwtAuthenticator(keys Jwks, issuer string, audience string, clock ports.Clock) (Authenticator, error) {
+	if len(keys) == 0 {
+		return nil, errs.NewValueIsRequiredError("keys")
+	}
+
+	if clock == nil {
+		return nil, errs.NewValueIsRequiredError("clock")
+	}
+
+	return &jwtAuthenticator{
+		keys:     keys,
+		issuer:   issuer,
+		audience: audience,
+		clock:    clock,
+	}, nil
+}
+
+func (a *jwtAuthenticator) Authenticate(r *http.Request) (Principal, error) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return Principal{}, ErrNoCredentials
+	}
+
+	claims, err := a.verify(strings.TrimSpace(token))
+	if err != nil {
+		return Principal{}, fmt.Errorf("%w: %v", ErrInvalidCredentials, err)
+	}
+
+	role, err := RoleFromString(claims.Role)
+	if err != nil {
+		return Principal{}, fmt.Errorf("%w: %v", ErrInvalidCredentials, err)
+	}
+
+	var courierId uuid.UUID
+	if claims.CourierId != "" {
+		courierId, err = uuid.Parse(claims.CourierId)
+		if err != nil {
+			return Principal{}, fmt.Errorf("%w: invalid courier_id", ErrInvalidCredentials)
+		}
+	}
+
+	principal, err := NewPrincipal(claims.Subject, role, courierId)
+	if err != nil {
+		return Principal{}, fmt.Errorf("%w: %v", ErrInvalidCredentials, err)
+	}
+
+	return principal, nil
+}
+
+// verify проверяет подпись и сроки действия токена и возвращает его claims
+func (a *jwtAuthenticator) verify(token string) (*jwtClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("malformed token")
+	}
+
+	var header jwtHeader
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("malformed header")
+	}
+
+	key, err := a.key(header.Kid)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("malformed signature")
+	}
+
+	// Алгоритм должен соответствовать типу ключа: иначе подпись можно подделать, подменив alg
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		if header.Alg != "RS256" || rsa.VerifyPKCS1v15(k, crypto.SHA256, digest[:], signature) != nil {
+			return nil, fmt.Errorf("invalid signature")
+		}
+	case *ecdsa.PublicKey:
+		if header.Alg != "ES256" || len(signature) != 64 {
+			return nil, fmt.Errorf("invalid signature")
+		}
+
+		r := new(big.Int).SetBytes(signature[:32])
+		s := new(big.Int).SetBytes(signature[32:])
+		if !ecdsa.Verify(k, digest[:], r, s) {
+			return nil, fmt.Errorf("invalid signature")
+		}
+	default:
+		return nil, fmt.Errorf("unsupported key")
+	}
+
+	var claims jwtClaims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("malformed claims")
+	}
+
+	if err := a.validate(&claims); err != nil {
+		return nil, err
+	}
+
+	return &claims, nil
+}
+
+func (a *jwtAuthenticator) key(kid string) (crypto.PublicKey, error) {
+	if key, ok := a.keys[kid]; ok {
+		return key, nil
+	}
+
+	// токен без kid подходит, только если ключ один
+	if kid == "" && len(a.keys) == 1 {
+		for _, key := range a.keys {
+			return key, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown key %q", kid)
+}
+
+func (a *jwtAuthenticator) validate(claims *jwtClaims) error {
+	now := a.clock.Now()
+
+	if claims.ExpiresAt == nil {
+		return fmt.Errorf("exp is required")
+	}
+
+	expiresAt, err := numericDate(*claims.ExpiresAt)
+	if err != nil {
+		return fmt.Errorf("invalid exp")
+	}
+
+	if !now.Before(expiresAt.Add(clockSkew)) {
+		return fmt.Errorf("token is expired")
+	}
+
+	if claims.NotBefore != nil {
+		notBefore, err := numericDate(*claims.NotBefore)
+		if err != nil {
+			return fmt.Errorf("invalid nbf")
+		}
+
+		if now.Add(clockSkew).Before(notBefore) {
+			return fmt.Errorf("token is not valid yet")
+		}
+	}
+
+	if a.issuer != "" && claims.Issuer != a.issuer {
+		return fmt.Errorf("unexpected issuer")
+	}
+
+	if a.audience != "" && !slices.Contains(claims.Audience, a.audience) {
+		return fmt.Errorf("unexpected audience")
+	}
+
+	return nil
+}
+
+func decodeSegment(segment string, target any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(target)
+}
+
+// numericDate - время в секундах Unix, возможно дробное (RFC 7519)
+func numericDate(value json.Number) (time.Time, error) {
+	seconds, err := value.Float64()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Unix(0, int64(seconds*float64(time.Second))), nil
+}
@@ -0,0 +1,162 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"delivery/internal/core/domain/kernel"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+var testNow = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+var testClock = kernel.ClockFunc(func() time.Time { return testNow })
+
+func TestJwtAuthenticator_Authenticate(t *testing.T) {
+
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	ecKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	jwks := parseTestJwks(t, rsaKey, ecKey)
+
+	authenticator, err := NewJwtAuthenticator(jwks, "https://auth.local", "delivery", testClock)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	courierId := uuid.New()
+	valid := map[string]any{
+		"sub": "app-1", "iss": "https://auth.local", "aud": []string{"delivery"},
+		"exp": testNow.Add(time.Hour).Unix(), "role": "courier", "courier_id": courierId.String(),
+	}
+
+	for _, alg := range []string{"RS256", "ES256"} {
+		token := signTestToken(t, alg, valid, rsaKey, ecKey)
+		principal, err := authenticate(authenticator, token)
+		if err != nil {
+			t.Fatalf("%s: %v", alg, err)
+		}
+
+		if principal.Role() != RoleCourier || principal.CourierId() != courierId || principal.Subject() != "app-1" {
+			t.Fatalf("%s: unexpected principal %v", alg, principal)
+		}
+	}
+
+	invalid := map[string]map[string]any{
+		"expired":       with(valid, "exp", testNow.Add(-time.Hour).Unix()),
+		"no exp":        with(valid, "exp", nil),
+		"not yet valid": with(valid, "nbf", testNow.Add(time.Hour).Unix()),
+		"wrong issuer":  with(valid, "iss", "https://other.local"),
+		"wrong aud":     with(valid, "aud", "other"),
+		"unknown role":  with(valid, "role", "root"),
+		"no courier":    with(valid, "courier_id", nil),
+	}
+
+	for name, claims := range invalid {
+		_, err := authenticate(authenticator, signTestToken(t, "RS256", claims, rsaKey, ecKey))
+		if !errors.Is(err, ErrInvalidCredentials) {
+			t.Errorf("%s: expected invalid credentials, got %v", name, err)
+		}
+	}
+}
+
+func TestJwtAuthenticator_RejectsForgedTokens(t *testing.T) {
+
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	ecKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	authenticator, _ := NewJwtAuthenticator(parseTestJwks(t, rsaKey, ecKey), "", "", testClock)
+
+	claims := map[string]any{"sub": "admin", "exp": testNow.Add(time.Hour).Unix(), "role": "admin"}
+	token := signTestToken(t, "RS256", claims, rsaKey, ecKey)
+
+	otherKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	forged := []string{
+		"garbage",
+		encode(t, map[string]string{"alg": "none", "kid": "rsa"}) + "." + encode(t, claims) + ".",
+		signTestToken(t, "RS256", claims, otherKey, ecKey),
+		token[:len(token)-4] + "AAAA",
+	}
+
+	for i, f := range forged {
+		_, err := authenticate(authenticator, f)
+		if !errors.Is(err, ErrInvalidCredentials) {
+			t.Errorf("forged token %d: expected invalid credentials, got %v", i, err)
+		}
+	}
+
+	r := httptest.NewRequest("GET", "/", nil)
+	if _, err := authenticator.Authenticate(r); !errors.Is(err, ErrNoCredentials) {
+		t.Fatalf("expected no credentials, got %v", err)
+	}
+}
+
+func authenticate(authenticator Authenticator, token string) (Principal, error) {
+	r := httptest.NewRequest("GET", "/", nil)
+	r.Header.Set("Authorization", "Bearer "+token)
+	return authenticator.Authenticate(r)
+}
+
+func with(claims map[string]any, key string, value any) map[string]any {
+	result := make(map[string]any, len(claims))
+	for k, v := range claims {
+		result[k] = v
+	}
+
+	if value == nil {
+		delete(result, key)
+	} else {
+		result[key] = value
+	}
+
+	return result
+}
+
+func parseTestJwks(t *testing.T, rsaKey *rsa.PrivateKey, ecKey *ecdsa.PrivateKey) Jwks {
+	b64 := base64.RawURLEncoding.EncodeToString
+	data := fmt.Sprintf(`{"keys":[
+		{"kid":"rsa","kty":"RSA","use":"sig","n":%q,"e":%q},
+		{"kid":"ec","kty":"EC","crv":"P-256","x":%q,"y":%q}]}`,
+		b64(rsaKey.N.Bytes()), b64([]byte{1, 0, 1}),
+		b64(ecKey.X.FillBytes(make([]byte, 32))), b64(ecKey.Y.FillBytes(make([]byte, 32))))
+
+	jwks, err := ParseJwks([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return jwks
+}
+
+func signTestToken(t *testing.T, alg string, claims map[string]any, rsaKey *rsa.PrivateKey, ecKey *ecdsa.PrivateKey) string {
+	kid := map[string]string{"RS256": "rsa", "ES256": "ec"}[alg]
+	signingInput := encode(t, map[string]string{"alg": alg, "kid": kid, "typ": "JWT"}) + "." + encode(t, claims)
+	digest := sha256.Sum256([]byte(signingInput))
+
+	var signature []byte
+	switch alg {
+	case "RS256":
+		signature, _ = rsa.SignPKCS1v15(rand.Reader, rsaKey, crypto.SHA256, digest[:])
+	case "ES256":
+		r, s, _ := ecdsa.Sign(rand.Reader, ecKey, digest[:])
+		signature = append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func encode(t *testing.T, value any) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
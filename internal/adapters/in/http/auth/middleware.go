@@ -0,0 +1,84 @@
+package auth
+
+import (
+	"delivery/internal/generated/servers"
+	"delivery/internal/pkg/errs"
+	"errors"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"slices"
+)
+
+// NewStrictMiddleware проверяет учетные данные и права для каждой операции strict-обработчика.
+// Principal запроса передается обработчику в контексте
+func NewStrictMiddleware(authenticator Authenticator, authorizer Authorizer) (servers.StrictMiddlewareFunc, error) {
+	if authenticator == nil {
+		return nil, errs.NewValueIsRequiredError("authenticator")
+	}
+
+	if authorizer == nil {
+		return nil, errs.NewValueIsRequiredError("authorizer")
+	}
+
+	return func(f servers.StrictHandlerFunc, operationID string) servers.StrictHandlerFunc {
+		if IsPublic(operationID) {
+			return f
+		}
+
+		return func(ctx echo.Context, request any) (any, error) {
+			principal, err := authenticator.Authenticate(ctx.Request())
+			if err != nil {
+				return nil, unauthorized(ctx, err)
+			}
+
+			err = authorizer.Authorize(ctx.Request().Context(), principal, operationID, request)
+			if err != nil {
+				if errors.Is(err, ErrForbidden) {
+					return nil, forbidden()
+				}
+				return nil, err
+			}
+
+			ctx.SetRequest(ctx.Request().WithContext(WithPrincipal(ctx.Request().Context(), principal)))
+			return f(ctx, request)
+		}
+	}, nil
+}
+
+// NewEchoMiddleware защищает маршруты вне OpenAPI, например поток изменений для карты диспетчера
+func NewEchoMiddleware(authenticator Authenticator, roles ...Role) (echo.MiddlewareFunc, error) {
+	if authenticator == nil {
+		return nil, errs.NewValueIsRequiredError("authenticator")
+	}
+
+	if len(roles) == 0 {
+		return nil, errs.NewValueIsRequiredError("roles")
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			principal, err := authenticator.Authenticate(ctx.Request())
+			if err != nil {
+				return unauthorized(ctx, err)
+			}
+
+			if !slices.Contains(roles, principal.Role()) {
+				return forbidden()
+			}
+
+			ctx.SetRequest(ctx.Request().WithContext(WithPrincipal(ctx.Request().Context(), principal)))
+			return next(ctx)
+		}
+	}, nil
+}
+
+func unauthorized(ctx echo.Context, err error) error {
+	ctx.Response().Header().Set(echo.HeaderWWWAuthenticate, "Bearer")
+	return echo.NewHTTPError(http.StatusUnauthorized,
+		servers.Error{Code: http.StatusUnauthorized, Message: err.Error()})
+}
+
+func forbidden() error {
+	return echo.NewHTTPError(http.StatusForbidden,
+		servers.Error{Code: http.StatusForbidden, Message: ErrForbidden.Error()})
+}
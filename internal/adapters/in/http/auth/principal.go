@@ -0,0 +1,104 @@
+package auth
+
+import (
+	"context"
+	"delivery/internal/pkg/errs"
+	"errors"
+	"github.com/google/uuid"
+)
+
+type Role string
+
+const (
+	RoleAdmin      Role = "admin"
+	RoleDispatcher Role = "dispatcher"
+	// RoleCourier - приложение курьера: доступ только к своему курьеру и своим заказам
+	RoleCourier Role = "courier"
+	// RoleService - другие микросервисы
+	RoleService Role = "service"
+)
+
+func (r Role) String() string {
+	return string(r)
+}
+
+func (r Role) IsValid() bool {
+	switch r {
+	case RoleAdmin, RoleDispatcher, RoleCourier, RoleService:
+		return true
+	default:
+		return false
+	}
+}
+
+func RoleFromString(s string) (Role, error) {
+	role := Role(s)
+	if role.IsValid() {
+		return role, nil
+	}
+
+	return role, errors.New("invalid role")
+}
+
+// Principal - тот, от чьего имени выполняется запрос
+type Principal struct {
+	subject string
+	role    Role
+	// courierId - курьер, от имени которого работает приложение. Задан только для роли courier
+	courierId uuid.UUID
+
+	isSet bool
+}
+
+func NewPrincipal(subject string, role Role, courierId uuid.UUID) (Principal, error) {
+	if subject == "" {
+		return Principal{}, errs.NewValueIsRequiredError("subject")
+	}
+
+	if !role.IsValid() {
+		return Principal{}, errs.NewValueIsInvalidError("role")
+	}
+
+	if role == RoleCourier && courierId == uuid.Nil {
+		return Principal{}, errs.NewValueIsRequiredError("courierId")
+	}
+
+	if role != RoleCourier && courierId != uuid.Nil {
+		return Principal{}, errs.NewValueIsInvalidError("courierId")
+	}
+
+	return Principal{
+		subject:   subject,
+		role:      role,
+		courierId: courierId,
+		isSet:     true,
+	}, nil
+}
+
+func (p Principal) Subject() string {
+	return p.subject
+}
+
+func (p Principal) Role() Role {
+	return p.role
+}
+
+func (p Principal) CourierId() uuid.UUID {
+	return p.courierId
+}
+
+func (p Principal) IsEmpty() bool {
+	return !p.isSet
+}
+
+type principalKey struct{}
+
+func WithPrincipal(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext возвращает пустой Principal для публичных операций
+func PrincipalFromContext(ctx context.Context) Principal {
+	principal, _ := ctx.Value(principalKey{}).(Principal)
+	return principal
+}
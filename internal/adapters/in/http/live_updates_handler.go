@@ -0,0 +1,161 @@
+package http
+
+import (
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/ports"
+	"delivery/internal/generated/servers"
+	"delivery/internal/pkg/errs"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"time"
+)
+
+// LiveUpdatesHandler отдает перемещения курьеров и изменения заказов потоком Server-Sent Events.
+// Подписка задается параметрами запроса: courierId и orderId (можно повторять), area=x1:y1,x2:y2
+type LiveUpdatesHandler interface {
+	Stream(c echo.Context) error
+}
+
+var _ LiveUpdatesHandler = &liveUpdatesHandler{}
+
+type liveUpdatesHandler struct {
+	subscriber ports.LiveUpdatesSubscriber
+	// heartbeat - как часто отправлять комментарий, чтобы прокси не закрыли простаивающее соединение
+	heartbeat time.Duration
+}
+
+type liveUpdate struct {
+	CourierId  *uuid.UUID       `json:"courierId,omitempty"`
+	OrderId    *uuid.UUID       `json:"orderId,omitempty"`
+	Status     string           `json:"status,omitempty"`
+	Location   servers.Location `json:"location"`
+	OccurredAt time.Time        `json:"occurredAt"`
+}
+
+func NewLiveUpdatesHandler(subscriber ports.LiveUpdatesSubscriber, heartbeat time.Duration) (LiveUpdatesHandler, error) {
+	if subscriber == nil {
+		return nil, errs.NewValueIsRequiredError("subscriber")
+	}
+
+	if heartbeat <= 0 {
+		return nil, errs.NewValueIsInvalidError("heartbeat")
+	}
+
+	return &liveUpdatesHandler{
+		subscriber: subscriber,
+		heartbeat:  heartbeat,
+	}, nil
+}
+
+func (h *liveUpdatesHandler) Stream(c echo.Context) error {
+	filter, err := toLiveUpdatesFilter(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, servers.Error{Code: http.StatusBadRequest, Message: err.Error()})
+	}
+
+	updates, unsubscribe := h.subscriber.Subscribe(filter)
+	defer unsubscribe()
+
+	w := c.Response()
+	w.Header().Set(echo.HeaderContentType, "text/event-stream")
+	w.Header().Set(echo.HeaderCacheControl, "no-cache")
+	w.Header().Set(echo.HeaderConnection, "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	w.Flush()
+
+	ticker := time.NewTicker(h.heartbeat)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.Request().Context().Done():
+			return nil
+
+		case <-ticker.C:
+			_, err = fmt.Fprint(w, ": heartbeat\n\n")
+
+		case update, ok := <-updates:
+			if !ok {
+				return nil
+			}
+
+			err = writeLiveUpdate(w, update)
+		}
+
+		if err != nil {
+			return nil // клиент отключился
+		}
+
+		w.Flush()
+	}
+}
+
+func writeLiveUpdate(w *echo.Response, update ports.LiveUpdate) error {
+	dto := liveUpdate{
+		Status:     update.Status,
+		Location:   servers.Location{X: update.Location.X(), Y: update.Location.Y()},
+		OccurredAt: update.OccurredAt,
+	}
+
+	if update.CourierId != uuid.Nil {
+		dto.CourierId = &update.CourierId
+	}
+
+	if update.OrderId != uuid.Nil {
+		dto.OrderId = &update.OrderId
+	}
+
+	data, err := json.Marshal(dto)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", update.Type, data)
+	return err
+}
+
+func toLiveUpdatesFilter(c echo.Context) (ports.LiveUpdatesFilter, error) {
+	filter := ports.LiveUpdatesFilter{}
+	query := c.QueryParams()
+
+	for _, value := range query["courierId"] {
+		id, err := uuid.Parse(value)
+		if err != nil || id == uuid.Nil {
+			return ports.LiveUpdatesFilter{}, errs.NewValueIsInvalidError("courierId")
+		}
+
+		filter.CourierIds = append(filter.CourierIds, id)
+	}
+
+	for _, value := range query["orderId"] {
+		id, err := uuid.Parse(value)
+		if err != nil || id == uuid.Nil {
+			return ports.LiveUpdatesFilter{}, errs.NewValueIsInvalidError("orderId")
+		}
+
+		filter.OrderIds = append(filter.OrderIds, id)
+	}
+
+	if area := query.Get("area"); area != "" {
+		var x1, y1, x2, y2 int
+		_, err := fmt.Sscanf(area, "%d:%d,%d:%d", &x1, &y1, &x2, &y2)
+		if err != nil {
+			return ports.LiveUpdatesFilter{}, errs.NewValueIsInvalidError("area")
+		}
+
+		filter.AreaFrom, err = kernel.NewLocation(x1, y1)
+		if err != nil {
+			return ports.LiveUpdatesFilter{}, errs.NewValueIsInvalidError("area")
+		}
+
+		filter.AreaTo, err = kernel.NewLocation(x2, y2)
+		if err != nil {
+			return ports.LiveUpdatesFilter{}, errs.NewValueIsInvalidError("area")
+		}
+	}
+
+	return filter, nil
+}
@@ -2,33 +2,70 @@ package http
 
 import (
 	"context"
+	"delivery/internal/adapters/in/http/auth"
 	"delivery/internal/core/application/usecases/commands"
 	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
 	"delivery/internal/generated/servers"
 	"delivery/internal/pkg/errs"
-	"github.com/google/uuid"
+	"errors"
+	"net/http"
+	"time"
 )
 
 var _ servers.StrictServerInterface = &serverHandlers{}
 
 type serverHandlers struct {
-	allCouriersQueryHandler      queries.AllCouriersQueryHandler
-	incompleteOrdersQueryHandler queries.IncompleteOrdersQueryHandler
-	createCourierCommandHandler  commands.CreateCourierCommandHandler
-	createOrderCommandHandler    commands.CreateOrderCommandHandler
+	allCouriersQueryHandler       queries.AllCouriersQueryHandler
+	courierDetailsQueryHandler    queries.CourierDetailsQueryHandler
+	incompleteOrdersQueryHandler  queries.IncompleteOrdersQueryHandler
+	createCourierCommandHandler   commands.CreateCourierCommandHandler
+	grantQualificationHandler     commands.GrantQualificationCommandHandler
+	revokeQualificationHandler    commands.RevokeQualificationCommandHandler
+	createOrderCommandHandler     commands.CreateOrderCommandHandler
+	confirmDeliveryCommandHandler commands.ConfirmDeliveryCommandHandler
+	failDeliveryCommandHandler    commands.FailDeliveryCommandHandler
+	manualAssignOrderHandler      commands.ManualAssignOrderCommandHandler
+	unassignOrderHandler          commands.UnassignOrderCommandHandler
+	reassignOrderHandler          commands.ReassignOrderCommandHandler
+	reportCourierLocationHandler  commands.ReportCourierLocationCommandHandler
+	dispatchDecisionsQueryHandler queries.DispatchDecisionsQueryHandler
+	simulateDispatchQueryHandler  queries.SimulateDispatchQueryHandler
+	trackOrderQueryHandler        queries.TrackOrderQueryHandler
+	ids                           ports.IDGenerator
 }
 
 func NewServerHandlers(
 	allCouriersQueryHandler queries.AllCouriersQueryHandler,
+	courierDetailsQueryHandler queries.CourierDetailsQueryHandler,
 	incompleteOrdersQueryHandler queries.IncompleteOrdersQueryHandler,
 	createCourierCommandHandler commands.CreateCourierCommandHandler,
+	grantQualificationHandler commands.GrantQualificationCommandHandler,
+	revokeQualificationHandler commands.RevokeQualificationCommandHandler,
 	createOrderCommandHandler commands.CreateOrderCommandHandler,
+	confirmDeliveryCommandHandler commands.ConfirmDeliveryCommandHandler,
+	failDeliveryCommandHandler commands.FailDeliveryCommandHandler,
+	manualAssignOrderHandler commands.ManualAssignOrderCommandHandler,
+	unassignOrderHandler commands.UnassignOrderCommandHandler,
+	reassignOrderHandler commands.ReassignOrderCommandHandler,
+	reportCourierLocationHandler commands.ReportCourierLocationCommandHandler,
+	dispatchDecisionsQueryHandler queries.DispatchDecisionsQueryHandler,
+	simulateDispatchQueryHandler queries.SimulateDispatchQueryHandler,
+	trackOrderQueryHandler queries.TrackOrderQueryHandler,
+	ids ports.IDGenerator,
 ) (servers.StrictServerInterface, error) {
 
 	if allCouriersQueryHandler == nil {
 		return nil, errs.NewValueIsRequiredError("allCouriersQueryHandler")
 	}
 
+	if courierDetailsQueryHandler == nil {
+		return nil, errs.NewValueIsRequiredError("courierDetailsQueryHandler")
+	}
+
 	if incompleteOrdersQueryHandler == nil {
 		return nil, errs.NewValueIsRequiredError("incompleteOrdersQueryHandler")
 	}
@@ -37,15 +74,76 @@ func NewServerHandlers(
 		return nil, errs.NewValueIsRequiredError("createCourierCommandHandler")
 	}
 
+	if grantQualificationHandler == nil {
+		return nil, errs.NewValueIsRequiredError("grantQualificationHandler")
+	}
+
+	if revokeQualificationHandler == nil {
+		return nil, errs.NewValueIsRequiredError("revokeQualificationHandler")
+	}
+
 	if createOrderCommandHandler == nil {
 		return nil, errs.NewValueIsRequiredError("createOrderCommandHandler")
 	}
 
+	if confirmDeliveryCommandHandler == nil {
+		return nil, errs.NewValueIsRequiredError("confirmDeliveryCommandHandler")
+	}
+
+	if failDeliveryCommandHandler == nil {
+		return nil, errs.NewValueIsRequiredError("failDeliveryCommandHandler")
+	}
+
+	if manualAssignOrderHandler == nil {
+		return nil, errs.NewValueIsRequiredError("manualAssignOrderHandler")
+	}
+
+	if unassignOrderHandler == nil {
+		return nil, errs.NewValueIsRequiredError("unassignOrderHandler")
+	}
+
+	if reassignOrderHandler == nil {
+		return nil, errs.NewValueIsRequiredError("reassignOrderHandler")
+	}
+
+	if reportCourierLocationHandler == nil {
+		return nil, errs.NewValueIsRequiredError("reportCourierLocationHandler")
+	}
+
+	if dispatchDecisionsQueryHandler == nil {
+		return nil, errs.NewValueIsRequiredError("dispatchDecisionsQueryHandler")
+	}
+
+	if simulateDispatchQueryHandler == nil {
+		return nil, errs.NewValueIsRequiredError("simulateDispatchQueryHandler")
+	}
+
+	if trackOrderQueryHandler == nil {
+		return nil, errs.NewValueIsRequiredError("trackOrderQueryHandler")
+	}
+
+	if ids == nil {
+		return nil, errs.NewValueIsRequiredError("ids")
+	}
+
 	return &serverHandlers{
-		allCouriersQueryHandler:      allCouriersQueryHandler,
-		incompleteOrdersQueryHandler: incompleteOrdersQueryHandler,
-		createCourierCommandHandler:  createCourierCommandHandler,
-		createOrderCommandHandler:    createOrderCommandHandler,
+		allCouriersQueryHandler:       allCouriersQueryHandler,
+		courierDetailsQueryHandler:    courierDetailsQueryHandler,
+		incompleteOrdersQueryHandler:  incompleteOrdersQueryHandler,
+		createCourierCommandHandler:   createCourierCommandHandler,
+		grantQualificationHandler:     grantQualificationHandler,
+		revokeQualificationHandler:    revokeQualificationHandler,
+		createOrderCommandHandler:     createOrderCommandHandler,
+		confirmDeliveryCommandHandler: confirmDeliveryCommandHandler,
+		failDeliveryCommandHandler:    failDeliveryCommandHandler,
+		manualAssignOrderHandler:      manualAssignOrderHandler,
+		unassignOrderHandler:          unassignOrderHandler,
+		reassignOrderHandler:          reassignOrderHandler,
+		reportCourierLocationHandler:  reportCourierLocationHandler,
+		dispatchDecisionsQueryHandler: dispatchDecisionsQueryHandler,
+		simulateDispatchQueryHandler:  simulateDispatchQueryHandler,
+		trackOrderQueryHandler:        trackOrderQueryHandler,
+		ids:                           ids,
 	}, nil
 }
 
@@ -71,40 +169,340 @@ func (s serverHandlers) GetCouriers(ctx context.Context, _ servers.GetCouriersRe
 	return responseCouriers, nil
 }
 
+func (s serverHandlers) GetCourier(ctx context.Context, request servers.GetCourierRequestObject) (servers.GetCourierResponseObject, error) {
+	courier, err := s.courierDetailsQueryHandler.Handle(ctx, request.CourierId)
+	if err != nil {
+		return nil, err
+	}
+
+	if courier == nil {
+		return servers.GetCourier404JSONResponse{Code: http.StatusNotFound, Message: "courier not found"}, nil
+	}
+
+	storagePlaces := make([]servers.StoragePlace, 0, len(courier.StoragePlaces))
+	for _, place := range courier.StoragePlaces {
+		storagePlaces = append(storagePlaces,
+			servers.StoragePlace{
+				Id:          place.StoragePlaceID,
+				Name:        place.Name,
+				Type:        servers.StoragePlaceType(place.Type),
+				TotalVolume: place.TotalVolume,
+				MaxWeight:   place.MaxWeight,
+				OrderId:     place.OrderID,
+			})
+	}
+
+	qualifications := make([]servers.Qualification, 0, len(courier.Qualifications))
+	for _, q := range courier.Qualifications {
+		qualifications = append(qualifications, servers.Qualification(q))
+	}
+
+	return servers.GetCourier200JSONResponse{
+		Id:   courier.CourierID,
+		Name: courier.Name,
+		Location: servers.Location{
+			X: courier.LocationX,
+			Y: courier.LocationY,
+		},
+		Speed:          courier.Speed,
+		StoragePlaces:  storagePlaces,
+		Qualifications: qualifications,
+	}, nil
+}
+
+func (s serverHandlers) GrantQualification(ctx context.Context, request servers.GrantQualificationRequestObject) (servers.GrantQualificationResponseObject, error) {
+	cmd, err := commands.NewGrantQualificationCommand(request.CourierId, string(request.Qualification))
+	if err != nil {
+		return servers.GrantQualification400JSONResponse{Code: http.StatusBadRequest, Message: err.Error()}, nil
+	}
+
+	err = s.grantQualificationHandler.Handle(ctx, cmd)
+	if err != nil {
+		if errors.Is(err, errs.ErrObjectNotFound) {
+			return servers.GrantQualification404JSONResponse{Code: http.StatusNotFound, Message: err.Error()}, nil
+		}
+		return nil, err
+	}
+
+	return servers.GrantQualification204Response{}, nil
+}
+
+func (s serverHandlers) RevokeQualification(ctx context.Context, request servers.RevokeQualificationRequestObject) (servers.RevokeQualificationResponseObject, error) {
+	cmd, err := commands.NewRevokeQualificationCommand(request.CourierId, string(request.Qualification))
+	if err != nil {
+		return servers.RevokeQualification400JSONResponse{Code: http.StatusBadRequest, Message: err.Error()}, nil
+	}
+
+	err = s.revokeQualificationHandler.Handle(ctx, cmd)
+	if err != nil {
+		if errors.Is(err, errs.ErrObjectNotFound) {
+			return servers.RevokeQualification404JSONResponse{Code: http.StatusNotFound, Message: err.Error()}, nil
+		}
+		return nil, err
+	}
+
+	return servers.RevokeQualification204Response{}, nil
+}
+
+func (s serverHandlers) ReportCourierLocation(ctx context.Context, request servers.ReportCourierLocationRequestObject) (servers.ReportCourierLocationResponseObject, error) {
+	if request.Body == nil {
+		return servers.ReportCourierLocation400JSONResponse{Code: http.StatusBadRequest, Message: "empty body"}, nil
+	}
+
+	location, err := kernel.NewGeoLocation(request.Body.Lat, request.Body.Lon)
+	if err != nil {
+		return servers.ReportCourierLocation400JSONResponse{Code: http.StatusBadRequest, Message: err.Error()}, nil
+	}
+
+	var reportedAt time.Time
+	if request.Body.ReportedAt != nil {
+		reportedAt = *request.Body.ReportedAt
+	}
+
+	cmd, err := commands.NewReportCourierLocationCommand(request.CourierId, location, reportedAt)
+	if err != nil {
+		return servers.ReportCourierLocation400JSONResponse{Code: http.StatusBadRequest, Message: err.Error()}, nil
+	}
+
+	err = s.reportCourierLocationHandler.Handle(ctx, cmd)
+	if err != nil {
+		switch {
+		case errors.Is(err, kernel.ErrLocationOutOfServiceArea), errors.Is(err, errs.ErrValueIsOutOfRange),
+			errors.Is(err, commands.ErrLocationFromFuture):
+			return servers.ReportCourierLocation400JSONResponse{Code: http.StatusBadRequest, Message: err.Error()}, nil
+		case errors.Is(err, errs.ErrObjectNotFound):
+			return servers.ReportCourierLocation404JSONResponse{Code: http.StatusNotFound, Message: err.Error()}, nil
+		case errors.Is(err, courier.ErrStaleLocation), errors.Is(err, commands.ErrSimulatedMovement):
+			return servers.ReportCourierLocation409JSONResponse{Code: http.StatusConflict, Message: err.Error()}, nil
+		}
+		return nil, err
+	}
+
+	return servers.ReportCourierLocation204Response{}, nil
+}
+
 func (s serverHandlers) CreateCourier(ctx context.Context, request servers.CreateCourierRequestObject) (servers.CreateCourierResponseObject, error) {
-	cmd, err := commands.NewCreateCourierCommand(request.Body.Name, request.Body.Speed)
+	start, err := toLocation(request.Body.Location)
+	if err != nil {
+		return servers.CreateCourier400JSONResponse{Code: http.StatusBadRequest, Message: err.Error()}, nil
+	}
+
+	homeDepot, err := toLocation(request.Body.HomeDepot)
+	if err != nil {
+		return servers.CreateCourier400JSONResponse{Code: http.StatusBadRequest, Message: err.Error()}, nil
+	}
+
+	cmd, err := commands.NewCreateCourierCommand(request.Body.Name, request.Body.Speed, start, homeDepot)
 	if err != nil {
 		return nil, err
 	}
 
 	err = s.createCourierCommandHandler.Handle(ctx, cmd)
 	if err != nil {
+		if errors.Is(err, kernel.ErrLocationOutOfServiceArea) {
+			return servers.CreateCourier400JSONResponse{Code: http.StatusBadRequest, Message: err.Error()}, nil
+		}
 		return nil, err
 	}
 
 	return servers.CreateCourier201Response{}, nil
 }
 
-func (s serverHandlers) CreateOrder(ctx context.Context, _ servers.CreateOrderRequestObject) (servers.CreateOrderResponseObject, error) {
+// toLocation преобразует необязательную точку из запроса (nil - пустая точка)
+func toLocation(l *servers.Location) (kernel.Location, error) {
+	if l == nil {
+		return kernel.Location{}, nil
+	}
+
+	return kernel.NewLocation(l.X, l.Y)
+}
+
+func (s serverHandlers) CreateOrder(ctx context.Context, request servers.CreateOrderRequestObject) (servers.CreateOrderResponseObject, error) {
 
 	// cmd, err := commands.NewCreateOrderCommand(request)
 	// if err != nil {
 	// 	return nil, err
 	// }
 
-	cmd, err := commands.NewCreateOrderCommand(uuid.New(), "Несуществующая", 5)
+	var priority string
+	var pickup kernel.Location
+	var weight int
+	var handlings []string
+	var restrictions []string
+	if request.Body != nil {
+		if request.Body.Priority != nil {
+			priority = string(*request.Body.Priority)
+		}
+
+		if request.Body.Weight != nil {
+			weight = *request.Body.Weight
+		}
+
+		if request.Body.Handling != nil {
+			handlings = append(handlings, string(*request.Body.Handling))
+		}
+
+		if request.Body.Restrictions != nil {
+			for _, r := range *request.Body.Restrictions {
+				restrictions = append(restrictions, string(r))
+			}
+		}
+
+		var err error
+		pickup, err = toLocation(request.Body.Pickup)
+		if err != nil {
+			return servers.CreateOrder400JSONResponse{Code: http.StatusBadRequest, Message: err.Error()}, nil
+		}
+	}
+
+	cmd, err := commands.NewCreateOrderCommand(s.ids.NewId(), "Несуществующая", pickup, 5, weight, priority, handlings,
+		restrictions, nil)
 	if err != nil {
-		return nil, err
+		return servers.CreateOrder400JSONResponse{Code: http.StatusBadRequest, Message: err.Error()}, nil
 	}
 
 	err = s.createOrderCommandHandler.Handle(ctx, cmd)
 	if err != nil {
+		if errors.Is(err, kernel.ErrLocationOutOfServiceArea) {
+			return servers.CreateOrder400JSONResponse{Code: http.StatusBadRequest, Message: err.Error()}, nil
+		}
 		return nil, err
 	}
 
 	return servers.CreateOrder201Response{}, nil
 }
 
+func (s serverHandlers) ConfirmDelivery(ctx context.Context, request servers.ConfirmDeliveryRequestObject) (servers.ConfirmDeliveryResponseObject, error) {
+	var code string
+	if request.Body != nil {
+		code = request.Body.Code
+	}
+
+	cmd, err := commands.NewConfirmDeliveryCommand(request.OrderId, code)
+	if err != nil {
+		return servers.ConfirmDelivery400JSONResponse{Code: http.StatusBadRequest, Message: err.Error()}, nil
+	}
+
+	err = s.confirmDeliveryCommandHandler.Handle(ctx, cmd)
+	if err != nil {
+		switch {
+		case errors.Is(err, errs.ErrObjectNotFound):
+			return servers.ConfirmDelivery404JSONResponse{Code: http.StatusNotFound, Message: err.Error()}, nil
+		case errors.Is(err, commands.ErrCourierNotArrived):
+			return servers.ConfirmDelivery409JSONResponse{Code: http.StatusConflict, Message: err.Error()}, nil
+		case errors.Is(err, commands.ErrInvalidConfirmationCode), errors.Is(err, order.ErrNotAwaitingConfirmation):
+			return servers.ConfirmDelivery400JSONResponse{Code: http.StatusBadRequest, Message: err.Error()}, nil
+		}
+		return nil, err
+	}
+
+	return servers.ConfirmDelivery200Response{}, nil
+}
+
+func (s serverHandlers) FailDelivery(ctx context.Context, request servers.FailDeliveryRequestObject) (servers.FailDeliveryResponseObject, error) {
+	var reason string
+	var retry bool
+	if request.Body != nil {
+		reason = request.Body.Reason
+		if request.Body.Retry != nil {
+			retry = *request.Body.Retry
+		}
+	}
+
+	cmd, err := commands.NewFailDeliveryCommand(request.OrderId, reason, retry)
+	if err != nil {
+		return servers.FailDelivery400JSONResponse{Code: http.StatusBadRequest, Message: err.Error()}, nil
+	}
+
+	err = s.failDeliveryCommandHandler.Handle(ctx, cmd)
+	if err != nil {
+		switch {
+		case errors.Is(err, errs.ErrObjectNotFound):
+			return servers.FailDelivery404JSONResponse{Code: http.StatusNotFound, Message: err.Error()}, nil
+		case errors.Is(err, order.ErrNotOutForDelivery):
+			return servers.FailDelivery409JSONResponse{Code: http.StatusConflict, Message: err.Error()}, nil
+		}
+		return nil, err
+	}
+
+	return servers.FailDelivery200Response{}, nil
+}
+
+func (s serverHandlers) ManualAssignOrder(ctx context.Context, request servers.ManualAssignOrderRequestObject) (servers.ManualAssignOrderResponseObject, error) {
+	if request.Body == nil {
+		return servers.ManualAssignOrder400JSONResponse{Code: http.StatusBadRequest, Message: "empty body"}, nil
+	}
+
+	cmd, err := commands.NewManualAssignOrderCommand(request.OrderId, request.Body.CourierId, operator(ctx))
+	if err != nil {
+		return servers.ManualAssignOrder400JSONResponse{Code: http.StatusBadRequest, Message: err.Error()}, nil
+	}
+
+	err = s.manualAssignOrderHandler.Handle(ctx, cmd)
+	if err != nil {
+		switch {
+		case errors.Is(err, errs.ErrObjectNotFound):
+			return servers.ManualAssignOrder404JSONResponse{Code: http.StatusNotFound, Message: err.Error()}, nil
+		case errors.Is(err, commands.ErrCourierCannotTakeOrder), errors.Is(err, order.ErrAlreadyAssigned):
+			return servers.ManualAssignOrder409JSONResponse{Code: http.StatusConflict, Message: err.Error()}, nil
+		}
+		return nil, err
+	}
+
+	return servers.ManualAssignOrder204Response{}, nil
+}
+
+func (s serverHandlers) ReassignOrder(ctx context.Context, request servers.ReassignOrderRequestObject) (servers.ReassignOrderResponseObject, error) {
+	if request.Body == nil {
+		return servers.ReassignOrder400JSONResponse{Code: http.StatusBadRequest, Message: "empty body"}, nil
+	}
+
+	cmd, err := commands.NewReassignOrderCommand(request.OrderId, request.Body.CourierId, operator(ctx))
+	if err != nil {
+		return servers.ReassignOrder400JSONResponse{Code: http.StatusBadRequest, Message: err.Error()}, nil
+	}
+
+	err = s.reassignOrderHandler.Handle(ctx, cmd)
+	if err != nil {
+		switch {
+		case errors.Is(err, errs.ErrObjectNotFound):
+			return servers.ReassignOrder404JSONResponse{Code: http.StatusNotFound, Message: err.Error()}, nil
+		case errors.Is(err, commands.ErrCourierCannotTakeOrder), errors.Is(err, order.ErrAlreadyAssigned),
+			errors.Is(err, order.ErrNotReassignable):
+			return servers.ReassignOrder409JSONResponse{Code: http.StatusConflict, Message: err.Error()}, nil
+		}
+		return nil, err
+	}
+
+	return servers.ReassignOrder204Response{}, nil
+}
+
+func (s serverHandlers) UnassignOrder(ctx context.Context, request servers.UnassignOrderRequestObject) (servers.UnassignOrderResponseObject, error) {
+	cmd, err := commands.NewUnassignOrderCommand(request.OrderId, operator(ctx))
+	if err != nil {
+		return servers.UnassignOrder400JSONResponse{Code: http.StatusBadRequest, Message: err.Error()}, nil
+	}
+
+	err = s.unassignOrderHandler.Handle(ctx, cmd)
+	if err != nil {
+		switch {
+		case errors.Is(err, errs.ErrObjectNotFound):
+			return servers.UnassignOrder404JSONResponse{Code: http.StatusNotFound, Message: err.Error()}, nil
+		case errors.Is(err, order.ErrNotReassignable):
+			return servers.UnassignOrder409JSONResponse{Code: http.StatusConflict, Message: err.Error()}, nil
+		}
+		return nil, err
+	}
+
+	return servers.UnassignOrder204Response{}, nil
+}
+
+// operator - тот, кто вошел в API: он сохраняется в заказе и передается в событиях.
+// Имя из запроса не принимается, чтобы нельзя было действовать от чужого имени
+func operator(ctx context.Context) string {
+	return auth.PrincipalFromContext(ctx).Subject()
+}
+
 func (s serverHandlers) GetOrders(ctx context.Context, _ servers.GetOrdersRequestObject) (servers.GetOrdersResponseObject, error) {
 	orders, err := s.incompleteOrdersQueryHandler.Handle(ctx)
 	if err != nil {
@@ -125,3 +523,139 @@ func (s serverHandlers) GetOrders(ctx context.Context, _ servers.GetOrdersReques
 
 	return responseOrders, nil
 }
+
+func (s serverHandlers) GetOrderDispatchDecisions(ctx context.Context, request servers.GetOrderDispatchDecisionsRequestObject) (servers.GetOrderDispatchDecisionsResponseObject, error) {
+	decisions, err := s.dispatchDecisionsQueryHandler.Handle(ctx, request.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	responseDecisions := servers.GetOrderDispatchDecisions200JSONResponse{}
+	for _, decision := range decisions {
+		candidates := make([]servers.DispatchCandidate, 0, len(decision.Candidates))
+		for _, candidate := range decision.Candidates {
+			var rejectionReason *string
+			if candidate.RejectionReason != "" {
+				rejectionReason = &candidate.RejectionReason
+			}
+
+			var missingQualification *string
+			if candidate.MissingQualification != "" {
+				missingQualification = &candidate.MissingQualification
+			}
+
+			candidates = append(candidates,
+				servers.DispatchCandidate{
+					CourierId:            candidate.CourierID,
+					CourierName:          candidate.CourierName,
+					Eta:                  candidate.Eta,
+					RejectionReason:      rejectionReason,
+					MissingQualification: missingQualification,
+				})
+		}
+
+		responseDecisions = append(responseDecisions,
+			servers.DispatchDecision{
+				Id:         decision.DecisionID,
+				OrderId:    decision.OrderID,
+				CourierId:  decision.CourierID,
+				DecidedAt:  decision.DecidedAt,
+				Candidates: candidates,
+			})
+	}
+
+	return responseDecisions, nil
+}
+
+func (s serverHandlers) SimulateDispatch(ctx context.Context, request servers.SimulateDispatchRequestObject) (servers.SimulateDispatchResponseObject, error) {
+	var weight int
+	if request.Body.Weight != nil {
+		weight = *request.Body.Weight
+	}
+
+	q, err := queries.NewSimulateDispatchQuery(request.Body.Location.X, request.Body.Location.Y, request.Body.Volume, weight)
+	if err != nil {
+		return servers.SimulateDispatch400JSONResponse{Code: http.StatusBadRequest, Message: err.Error()}, nil
+	}
+
+	simulation, err := s.simulateDispatchQueryHandler.Handle(ctx, q)
+	if err != nil {
+		if errors.Is(err, kernel.ErrLocationOutOfServiceArea) {
+			return servers.SimulateDispatch400JSONResponse{Code: http.StatusBadRequest, Message: err.Error()}, nil
+		}
+		return nil, err
+	}
+
+	response := servers.SimulateDispatch200JSONResponse{Alternatives: []servers.SimulatedCourier{}}
+	if simulation.Courier != nil {
+		response.Courier = &servers.SimulatedCourier{
+			CourierId:   simulation.Courier.CourierID,
+			CourierName: simulation.Courier.Name,
+			Eta:         simulation.Courier.Eta,
+		}
+	}
+
+	for _, alternative := range simulation.Alternatives {
+		response.Alternatives = append(response.Alternatives,
+			servers.SimulatedCourier{
+				CourierId:   alternative.CourierID,
+				CourierName: alternative.Name,
+				Eta:         alternative.Eta,
+			})
+	}
+
+	return response, nil
+}
+
+// TrackOrder - публичный запрос получателя. Недействительный токен и неизвестный заказ неразличимы,
+// чтобы по ответам нельзя было подбирать токены
+func (s serverHandlers) TrackOrder(ctx context.Context, request servers.TrackOrderRequestObject) (servers.TrackOrderResponseObject, error) {
+	const notFound = "tracking link not found"
+
+	tracking, err := s.trackOrderQueryHandler.Handle(ctx, request.Token)
+	if err != nil {
+		switch {
+		case errors.Is(err, ports.ErrTrackingTokenInvalid):
+			return servers.TrackOrder404JSONResponse{Code: http.StatusNotFound, Message: notFound}, nil
+		case errors.Is(err, ports.ErrTrackingTokenExpired):
+			return servers.TrackOrder410JSONResponse{Code: http.StatusGone, Message: err.Error()}, nil
+		}
+		return nil, err
+	}
+
+	if tracking == nil {
+		return servers.TrackOrder404JSONResponse{Code: http.StatusNotFound, Message: notFound}, nil
+	}
+
+	response := servers.TrackOrder200JSONResponse{
+		Status:          tracking.Status,
+		EtaSeconds:      tracking.Eta,
+		CourierLocation: toTrackingLocation(tracking.CourierLocation),
+	}
+
+	if len(tracking.Shipments) > 0 {
+		shipments := make([]servers.ShipmentTracking, 0, len(tracking.Shipments))
+		for _, shipment := range tracking.Shipments {
+			shipments = append(shipments, servers.ShipmentTracking{
+				Status:          shipment.Status,
+				EtaSeconds:      shipment.Eta,
+				CourierLocation: toTrackingLocation(shipment.CourierLocation),
+			})
+		}
+
+		response.Shipments = &shipments
+	}
+
+	return response, nil
+}
+
+func toTrackingLocation(location *kernel.Location) *servers.Location {
+	if location == nil {
+		return nil
+	}
+
+	return &servers.Location{
+		X: location.X(),
+		Y: location.Y(),
+	}
+}
@@ -3,6 +3,8 @@ package kafka
 import (
 	"context"
 	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/order"
 	"delivery/internal/generated/queues/basketpb"
 	"delivery/internal/pkg/errs"
 	"encoding/json"
@@ -91,6 +93,63 @@ func (c *basketConfirmedEventsConsumer) Consume() error {
 	}
 }
 
+// basketWeight считает вес корзины в граммах. Товары без веса не учитываются
+func basketWeight(items []*basketpb.Item) int {
+	weight := 0
+	for _, item := range items {
+		weight += int(item.GetWeight()) * int(item.GetQuantity())
+	}
+	return weight
+}
+
+// basketHandlings собирает условия перевозки товаров корзины. Товары без условий считаются обычными
+func basketHandlings(items []*basketpb.Item) []string {
+	handlings := make([]string, 0, len(items))
+	for _, item := range items {
+		if item.GetHandling() != "" {
+			handlings = append(handlings, item.GetHandling())
+		}
+	}
+	return handlings
+}
+
+// basketRestrictions собирает ограничения на вручение товаров корзины
+func basketRestrictions(items []*basketpb.Item) []string {
+	restrictions := make([]string, 0)
+	for _, item := range items {
+		if item.GetRestriction() != "" {
+			restrictions = append(restrictions, item.GetRestriction())
+		}
+	}
+	return restrictions
+}
+
+// basketItems раскладывает корзину на единицы товара, по которым заказ можно разделить на отправления.
+// Если объем хотя бы одного товара неизвестен, границы отправлений определить нельзя и список пуст
+func basketItems(items []*basketpb.Item) ([]order.Item, error) {
+	units := make([]order.Item, 0, len(items))
+	for _, item := range items {
+		if item.GetVolume() <= 0 {
+			return nil, nil
+		}
+
+		handling := order.HandlingStandard
+		if item.GetHandling() != "" {
+			handling = order.Handling(item.GetHandling())
+		}
+
+		unit, err := order.NewItem(int(item.GetVolume()), int(item.GetWeight()), handling)
+		if err != nil {
+			return nil, err
+		}
+
+		for range item.GetQuantity() {
+			units = append(units, unit)
+		}
+	}
+	return units, nil
+}
+
 // Реализация sarama.ConsumerGroupHandler:
 
 func (c *basketConfirmedEventsConsumer) Setup(_ sarama.ConsumerGroupSession) error   { return nil }
@@ -109,8 +168,16 @@ func (c *basketConfirmedEventsConsumer) ConsumeClaim(session sarama.ConsumerGrou
 			continue
 		}
 
+		items, err := basketItems(event.Items)
+		if err != nil {
+			log.Printf("Failed to get basket items: %v", err)
+			session.MarkMessage(message, "")
+			continue
+		}
+
 		cmd, err := commands.NewCreateOrderCommand(
-			uuid.MustParse(event.BasketId), event.Address.Street, int(event.Volume),
+			uuid.MustParse(event.BasketId), event.Address.Street, kernel.Location{}, int(event.Volume),
+			basketWeight(event.Items), event.Priority, basketHandlings(event.Items), basketRestrictions(event.Items), items,
 		)
 
 		if err != nil {
@@ -0,0 +1,42 @@
+package clock
+
+import (
+	"delivery/internal/core/ports"
+	"sync"
+	"time"
+)
+
+var _ ports.Clock = &FakeClock{}
+
+// FakeClock - часы, которые идут только по команде. Для тестов и ускоренной симуляции
+type FakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+// Advance переводит часы вперед на d
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+// Set устанавливает текущее время
+func (c *FakeClock) Set(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = now
+}
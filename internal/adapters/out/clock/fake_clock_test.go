@@ -0,0 +1,25 @@
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFakeClock(t *testing.T) {
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	c := NewFakeClock(start)
+
+	if !c.Now().Equal(start) {
+		t.Error("fake clock must not move by itself")
+	}
+
+	c.Advance(90 * time.Second)
+	if !c.Now().Equal(start.Add(90 * time.Second)) {
+		t.Error("wrong time after Advance")
+	}
+
+	c.Set(start)
+	if !c.Now().Equal(start) {
+		t.Error("wrong time after Set")
+	}
+}
@@ -0,0 +1,19 @@
+package clock
+
+import (
+	"delivery/internal/core/ports"
+	"time"
+)
+
+var _ ports.Clock = &systemClock{}
+
+type systemClock struct {
+}
+
+func NewSystemClock() ports.Clock {
+	return &systemClock{}
+}
+
+func (c *systemClock) Now() time.Time {
+	return time.Now().UTC()
+}
@@ -37,7 +37,7 @@ func NewClient(host string) (ports.GeoClient, error) {
 	}, nil
 }
 
-func (g geoClient) GetGeolocation(ctx context.Context, street string) (kernel.Location, error) {
+func (g geoClient) GetGeolocation(ctx context.Context, street string) (kernel.Location, kernel.GeoLocation, error) {
 	// запрос
 	req := &geopb.GetGeolocationRequest{
 		Street: street,
@@ -49,11 +49,16 @@ func (g geoClient) GetGeolocation(ctx context.Context, street string) (kernel.Lo
 
 	resp, err := g.pbGeoClient.GetGeolocation(ctx, req)
 	if err != nil {
-		return kernel.Location{}, err
+		return kernel.Location{}, kernel.GeoLocation{}, err
 	}
 
-	// Создаем и возвращаем Value Object
-	return kernel.NewLocation(int(resp.Location.X), int(resp.Location.Y))
+	// Создаем и возвращаем Value Object. Geo сервис знает только клетку адреса, точных координат он не отдает
+	loc, err := kernel.NewLocation(int(resp.Location.X), int(resp.Location.Y))
+	if err != nil {
+		return kernel.Location{}, kernel.GeoLocation{}, err
+	}
+
+	return loc, kernel.GeoLocation{}, nil
 }
 
 func (g geoClient) Close() error {
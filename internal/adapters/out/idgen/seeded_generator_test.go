@@ -0,0 +1,31 @@
+package idgen
+
+import (
+	"github.com/google/uuid"
+	"testing"
+)
+
+func TestSeededGenerator_NewId(t *testing.T) {
+	g1 := NewSeededGenerator(42)
+	g2 := NewSeededGenerator(42)
+	other := NewSeededGenerator(43)
+
+	seen := map[uuid.UUID]bool{}
+	for range 100 {
+		id := g1.NewId()
+
+		if id != g2.NewId() {
+			t.Fatal("expected same sequence for same seed")
+		}
+
+		if id == other.NewId() {
+			t.Fatal("expected different sequences for different seeds")
+		}
+
+		if id.Version() != 4 || seen[id] {
+			t.Fatalf("unexpected id %s", id)
+		}
+
+		seen[id] = true
+	}
+}
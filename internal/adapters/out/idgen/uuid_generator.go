@@ -0,0 +1,19 @@
+package idgen
+
+import (
+	"delivery/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+var _ ports.IDGenerator = &uuidGenerator{}
+
+type uuidGenerator struct {
+}
+
+func NewUUIDGenerator() ports.IDGenerator {
+	return &uuidGenerator{}
+}
+
+func (g *uuidGenerator) NewId() uuid.UUID {
+	return uuid.New()
+}
@@ -64,6 +64,26 @@ func (p *orderChangedNotificationProducer) Publish(ctx context.Context, domainEv
 		completedEvent := domainEvent.(*order.CompletedDomainEvent)
 		integrationEvent = p.mapCompletedDomainEventToIntegrationEvent(completedEvent)
 		key = completedEvent.OrderId.String()
+	case *order.RejectedDomainEvent:
+		rejectedEvent := domainEvent.(*order.RejectedDomainEvent)
+		integrationEvent = p.mapRejectedDomainEventToIntegrationEvent(rejectedEvent)
+		key = rejectedEvent.OrderId.String()
+	case *order.DeliveryFailedDomainEvent:
+		deliveryFailedEvent := domainEvent.(*order.DeliveryFailedDomainEvent)
+		integrationEvent = p.mapDeliveryFailedDomainEventToIntegrationEvent(deliveryFailedEvent)
+		key = deliveryFailedEvent.OrderId.String()
+	case *order.AssignedDomainEvent:
+		assignedEvent := domainEvent.(*order.AssignedDomainEvent)
+		integrationEvent = p.mapAssignedDomainEventToIntegrationEvent(assignedEvent)
+		key = assignedEvent.OrderId.String()
+	case *order.UnassignedDomainEvent:
+		unassignedEvent := domainEvent.(*order.UnassignedDomainEvent)
+		integrationEvent = p.mapUnassignedDomainEventToIntegrationEvent(unassignedEvent)
+		key = unassignedEvent.OrderId.String()
+	case *order.ReassignedDomainEvent:
+		reassignedEvent := domainEvent.(*order.ReassignedDomainEvent)
+		integrationEvent = p.mapReassignedDomainEventToIntegrationEvent(reassignedEvent)
+		key = reassignedEvent.OrderId.String()
 	default:
 		return errors.New("unknown order changed event type")
 	}
@@ -100,6 +120,9 @@ func (p *orderChangedNotificationProducer) mapCreatedDomainEventToIntegrationEve
 		EventType:  domainEvent.GetName(),
 		OccurredAt: timestamppb.New(domainEvent.OccurredAt),
 		OrderId:    domainEvent.OrderId.String(),
+
+		ConfirmationCode: domainEvent.ConfirmationCode,
+		TrackingToken:    domainEvent.TrackingToken,
 	}
 }
 
@@ -112,3 +135,58 @@ func (p *orderChangedNotificationProducer) mapCompletedDomainEventToIntegrationE
 		CourierId:  domainEvent.CourierId.String(),
 	}
 }
+
+func (p *orderChangedNotificationProducer) mapRejectedDomainEventToIntegrationEvent(domainEvent *order.RejectedDomainEvent) *orderpb.OrderRejectedIntegrationEvent {
+	return &orderpb.OrderRejectedIntegrationEvent{
+		EventId:    domainEvent.GetID().String(),
+		EventType:  domainEvent.GetName(),
+		OccurredAt: timestamppb.New(domainEvent.OccurredAt),
+		OrderId:    domainEvent.OrderId.String(),
+		Reason:     domainEvent.Reason,
+	}
+}
+
+func (p *orderChangedNotificationProducer) mapDeliveryFailedDomainEventToIntegrationEvent(domainEvent *order.DeliveryFailedDomainEvent) *orderpb.OrderDeliveryFailedIntegrationEvent {
+	return &orderpb.OrderDeliveryFailedIntegrationEvent{
+		EventId:    domainEvent.GetID().String(),
+		EventType:  domainEvent.GetName(),
+		OccurredAt: timestamppb.New(domainEvent.OccurredAt),
+		OrderId:    domainEvent.OrderId.String(),
+		CourierId:  domainEvent.CourierId.String(),
+		Reason:     domainEvent.Reason,
+	}
+}
+
+func (p *orderChangedNotificationProducer) mapAssignedDomainEventToIntegrationEvent(domainEvent *order.AssignedDomainEvent) *orderpb.OrderAssignedIntegrationEvent {
+	return &orderpb.OrderAssignedIntegrationEvent{
+		EventId:    domainEvent.GetID().String(),
+		EventType:  domainEvent.GetName(),
+		OccurredAt: timestamppb.New(domainEvent.OccurredAt),
+		OrderId:    domainEvent.OrderId.String(),
+		CourierId:  domainEvent.CourierId.String(),
+		Operator:   domainEvent.Operator,
+	}
+}
+
+func (p *orderChangedNotificationProducer) mapUnassignedDomainEventToIntegrationEvent(domainEvent *order.UnassignedDomainEvent) *orderpb.OrderUnassignedIntegrationEvent {
+	return &orderpb.OrderUnassignedIntegrationEvent{
+		EventId:    domainEvent.GetID().String(),
+		EventType:  domainEvent.GetName(),
+		OccurredAt: timestamppb.New(domainEvent.OccurredAt),
+		OrderId:    domainEvent.OrderId.String(),
+		CourierId:  domainEvent.CourierId.String(),
+		Operator:   domainEvent.Operator,
+	}
+}
+
+func (p *orderChangedNotificationProducer) mapReassignedDomainEventToIntegrationEvent(domainEvent *order.ReassignedDomainEvent) *orderpb.OrderReassignedIntegrationEvent {
+	return &orderpb.OrderReassignedIntegrationEvent{
+		EventId:       domainEvent.GetID().String(),
+		EventType:     domainEvent.GetName(),
+		OccurredAt:    timestamppb.New(domainEvent.OccurredAt),
+		OrderId:       domainEvent.OrderId.String(),
+		FromCourierId: domainEvent.FromCourierId.String(),
+		ToCourierId:   domainEvent.ToCourierId.String(),
+		Operator:      domainEvent.Operator,
+	}
+}
@@ -0,0 +1,111 @@
+package live
+
+import (
+	"context"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+	"slices"
+	"sync"
+)
+
+// Hub раздает изменения подписчикам в памяти процесса. Изменения не сохраняются: подписчик получает
+// только то, что произошло после подписки
+type Hub interface {
+	ports.LiveUpdatesPublisher
+	ports.LiveUpdatesSubscriber
+}
+
+var _ Hub = &hub{}
+
+type hub struct {
+	mu          sync.RWMutex
+	subscribers map[*subscriber]struct{}
+	// bufferSize - сколько изменений копится для подписчика, который не успевает их читать
+	bufferSize int
+}
+
+type subscriber struct {
+	filter  ports.LiveUpdatesFilter
+	updates chan ports.LiveUpdate
+}
+
+func NewHub(bufferSize int) (Hub, error) {
+	if bufferSize <= 0 {
+		return nil, errs.NewValueIsInvalidError("bufferSize")
+	}
+
+	return &hub{
+		subscribers: make(map[*subscriber]struct{}),
+		bufferSize:  bufferSize,
+	}, nil
+}
+
+// Publish не ждет подписчиков: если буфер подписчика заполнен, изменение для него пропускается.
+// Следующее изменение того же курьера или заказа все равно передаст актуальное состояние
+func (h *hub) Publish(_ context.Context, update ports.LiveUpdate) error {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for s := range h.subscribers {
+		if !matches(s.filter, update) {
+			continue
+		}
+
+		select {
+		case s.updates <- update:
+		default:
+		}
+	}
+
+	return nil
+}
+
+func (h *hub) Subscribe(filter ports.LiveUpdatesFilter) (<-chan ports.LiveUpdate, func()) {
+	s := &subscriber{
+		filter:  filter,
+		updates: make(chan ports.LiveUpdate, h.bufferSize),
+	}
+
+	h.mu.Lock()
+	h.subscribers[s] = struct{}{}
+	h.mu.Unlock()
+
+	unsubscribe := sync.OnceFunc(func() {
+		h.mu.Lock()
+		delete(h.subscribers, s)
+		h.mu.Unlock()
+
+		close(s.updates)
+	})
+
+	return s.updates, unsubscribe
+}
+
+func matches(filter ports.LiveUpdatesFilter, update ports.LiveUpdate) bool {
+	hasArea := !filter.AreaFrom.IsEmpty() && !filter.AreaTo.IsEmpty()
+	if len(filter.CourierIds) == 0 && len(filter.OrderIds) == 0 && !hasArea {
+		return true
+	}
+
+	if slices.Contains(filter.CourierIds, update.CourierId) {
+		return true
+	}
+
+	if slices.Contains(filter.OrderIds, update.OrderId) {
+		return true
+	}
+
+	return hasArea && inArea(filter, update)
+}
+
+func inArea(filter ports.LiveUpdatesFilter, update ports.LiveUpdate) bool {
+	if update.Location.IsEmpty() {
+		return false
+	}
+
+	x, y := update.Location.X(), update.Location.Y()
+	minX, maxX := min(filter.AreaFrom.X(), filter.AreaTo.X()), max(filter.AreaFrom.X(), filter.AreaTo.X())
+	minY, maxY := min(filter.AreaFrom.Y(), filter.AreaTo.Y()), max(filter.AreaFrom.Y(), filter.AreaTo.Y())
+
+	return x >= minX && x <= maxX && y >= minY && y <= maxY
+}
@@ -0,0 +1,81 @@
+package live
+
+import (
+	"context"
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/ports"
+	"github.com/google/uuid"
+	"testing"
+)
+
+func newLocation(t *testing.T, x, y int) kernel.Location {
+	l, err := kernel.NewLocation(x, y)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return l
+}
+
+func TestHub_FiltersUpdates(t *testing.T) {
+	ctx := context.Background()
+	hub, _ := NewHub(10)
+
+	courierId, orderId := uuid.New(), uuid.New()
+	byCourier, unsubscribeCourier := hub.Subscribe(ports.LiveUpdatesFilter{CourierIds: []uuid.UUID{courierId}})
+	defer unsubscribeCourier()
+	byOrder, unsubscribeOrder := hub.Subscribe(ports.LiveUpdatesFilter{OrderIds: []uuid.UUID{orderId}})
+	defer unsubscribeOrder()
+	byArea, unsubscribeArea := hub.Subscribe(ports.LiveUpdatesFilter{
+		AreaFrom: newLocation(t, 3, 3),
+		AreaTo:   newLocation(t, 1, 1),
+	})
+	defer unsubscribeArea()
+	all, unsubscribeAll := hub.Subscribe(ports.LiveUpdatesFilter{})
+	defer unsubscribeAll()
+
+	updates := []ports.LiveUpdate{
+		{Type: ports.LiveUpdateCourierMoved, CourierId: courierId, Location: newLocation(t, 5, 5)},
+		{Type: ports.LiveUpdateOrderUpdated, OrderId: orderId, Location: newLocation(t, 2, 2)},
+		{Type: ports.LiveUpdateCourierMoved, CourierId: uuid.New(), Location: newLocation(t, 9, 9)},
+	}
+	for _, u := range updates {
+		_ = hub.Publish(ctx, u)
+	}
+
+	tests := map[string]struct {
+		ch   <-chan ports.LiveUpdate
+		want int
+	}{
+		"courier": {byCourier, 1},
+		"order":   {byOrder, 1},
+		"area":    {byArea, 1},
+		"all":     {all, 3},
+	}
+
+	for name, tt := range tests {
+		if len(tt.ch) != tt.want {
+			t.Errorf("%s: got %d updates, want %d", name, len(tt.ch), tt.want)
+		}
+	}
+}
+
+func TestHub_Unsubscribe(t *testing.T) {
+	hub, _ := NewHub(1)
+	updates, unsubscribe := hub.Subscribe(ports.LiveUpdatesFilter{})
+
+	// переполненный буфер не блокирует публикацию
+	for range 3 {
+		_ = hub.Publish(context.Background(), ports.LiveUpdate{Type: ports.LiveUpdateCourierMoved})
+	}
+
+	unsubscribe()
+	unsubscribe()
+
+	<-updates
+	if _, ok := <-updates; ok {
+		t.Error("channel must be closed after unsubscribe")
+	}
+
+	_ = hub.Publish(context.Background(), ports.LiveUpdate{Type: ports.LiveUpdateCourierMoved})
+}
@@ -0,0 +1,104 @@
+package memory
+
+import (
+	"context"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/ddd"
+	"delivery/internal/pkg/outbox"
+	"github.com/google/uuid"
+	"maps"
+	"slices"
+)
+
+var _ ports.CourierRepository = &courierRepository{}
+
+type courierRepository struct {
+	tables *tables
+	// transient - события, которые публикуются после фиксации, минуя outbox
+	transient *[]ddd.DomainEvent
+}
+
+func newCourierRepository(tables *tables, transient *[]ddd.DomainEvent) ports.CourierRepository {
+	return &courierRepository{
+		tables:    tables,
+		transient: transient,
+	}
+}
+
+func (cr *courierRepository) Get(_ context.Context, id uuid.UUID) (*courier.Courier, error) {
+	r, found := cr.tables.couriers[id]
+	if !found {
+		return nil, nil // not found (no error here)
+	}
+
+	return r.ToCourier(), nil
+}
+
+func (cr *courierRepository) GetAllFree(_ context.Context) ([]*courier.Courier, error) {
+	records := make([]courierRecord, 0, 100)
+	for _, r := range cr.tables.couriers {
+		if r.IsFree() {
+			records = append(records, r)
+		}
+	}
+
+	if len(records) == 0 {
+		return nil, nil // not found (no error here)
+	}
+
+	slices.SortFunc(records, func(a, b courierRecord) int {
+		return compareIds(a.Id, b.Id)
+	})
+
+	couriers := make([]*courier.Courier, 0, len(records))
+	for _, r := range records {
+		couriers = append(couriers, r.ToCourier())
+	}
+
+	return couriers, nil
+}
+
+func (cr *courierRepository) GetAll(_ context.Context) ([]*courier.Courier, error) {
+	records := slices.SortedFunc(maps.Values(cr.tables.couriers), func(a, b courierRecord) int {
+		return compareIds(a.Id, b.Id)
+	})
+
+	if len(records) == 0 {
+		return nil, nil // not found (no error here)
+	}
+
+	couriers := make([]*courier.Courier, 0, len(records))
+	for _, r := range records {
+		couriers = append(couriers, r.ToCourier())
+	}
+
+	return couriers, nil
+}
+
+func (cr *courierRepository) Save(_ context.Context, couriers ...*courier.Courier) error {
+	for _, c := range couriers {
+		cr.tables.couriers[c.Id()] = newCourierRecord(c)
+
+		// save events (outbox pattern)
+		for _, event := range c.GetDomainEvents() {
+			if ddd.IsTransient(event) {
+				*cr.transient = append(*cr.transient, event)
+				continue
+			}
+
+			msg, err := outbox.EncodeDomainEvent(event)
+			if err != nil {
+				return err
+			}
+
+			if _, found := cr.tables.outbox[msg.ID]; !found {
+				cr.tables.outbox[msg.ID] = msg
+			}
+		}
+
+		c.ClearDomainEvents()
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package memory
+
+import (
+	"context"
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/ports"
+	"testing"
+	"time"
+)
+
+func TestCourierRepository_GetAllFree(t *testing.T) {
+
+	ctx, _, uow := setupTest(t)
+
+	couriers := createCouriers(4)
+	orders := createOrders(2)
+
+	// двое курьеров заняты
+	_ = couriers[0].TakeOrder(orders[0])
+	_ = couriers[2].TakeOrder(orders[1])
+
+	var free []*courier.Courier
+	err := uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		var err error
+		free, err = uowc.CourierRepository().GetAllFree(ctx)
+		if err != nil {
+			return err
+		}
+
+		if free != nil {
+			t.Fatal("expected no free couriers in empty storage")
+		}
+
+		err = uowc.CourierRepository().Save(ctx, couriers...)
+		if err != nil {
+			return err
+		}
+
+		free, err = uowc.CourierRepository().GetAllFree(ctx)
+		return err
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(free) != 2 {
+		t.Fatalf("expected 2 free couriers, got %d", len(free))
+	}
+
+	for _, c := range free {
+		if !c.Equals(couriers[1]) && !c.Equals(couriers[3]) {
+			t.Fatal("unexpected free courier")
+		}
+	}
+}
+
+func TestCourierRepository_GetAll(t *testing.T) {
+
+	ctx, _, uow := setupTest(t)
+
+	couriers := createCouriers(3)
+	orders := createOrders(1)
+	_ = couriers[1].TakeOrder(orders[0])
+
+	var all []*courier.Courier
+	err := uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		err := uowc.CourierRepository().Save(ctx, couriers...)
+		if err != nil {
+			return err
+		}
+
+		all, err = uowc.CourierRepository().GetAll(ctx)
+		return err
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(all) != len(couriers) {
+		t.Fatalf("expected %d couriers including busy, got %d", len(couriers), len(all))
+	}
+}
+
+func TestCourierRepository_SaveLastMovedAt(t *testing.T) {
+
+	ctx, _, uow := setupTest(t)
+
+	start, _ := kernel.NewLocation(1, 1)
+	target, _ := kernel.NewLocation(5, 5)
+	c, _ := courier.NewCourier("courier", 2, start, testIds)
+	_ = c.AddStoragePlace("Trunk", courier.StoragePlaceStandard, 30, 0, testIds)
+
+	// первое перемещение только запоминает время
+	now := time.Now().UTC()
+	err := c.Move(target, kernel.NewGridDistanceCalculator(), now, testIds)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var saved *courier.Courier
+	err = uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		err := uowc.CourierRepository().Save(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		saved, err = uowc.CourierRepository().Get(ctx, c.Id())
+		return err
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if saved == nil || saved.LastMovedAt() == nil || !saved.LastMovedAt().Equal(now) ||
+		len(saved.StoragePlaces()) != 2 {
+		t.Fatal("wrong courier data")
+	}
+}
@@ -0,0 +1,62 @@
+package memory
+
+import (
+	"context"
+	"delivery/internal/core/domain/model/dispatch"
+	"delivery/internal/core/ports"
+	"github.com/google/uuid"
+	"slices"
+)
+
+var _ ports.DispatchDecisionRepository = &dispatchDecisionRepository{}
+
+type dispatchDecisionRepository struct {
+	tables *tables
+}
+
+func newDispatchDecisionRepository(tables *tables) ports.DispatchDecisionRepository {
+	return &dispatchDecisionRepository{
+		tables: tables,
+	}
+}
+
+func (dr *dispatchDecisionRepository) Save(_ context.Context, decisions ...*dispatch.Decision) error {
+	for _, d := range decisions {
+		dr.tables.decisions[d.Id()] = newDecisionRecord(d)
+	}
+
+	return nil
+}
+
+func (dr *dispatchDecisionRepository) GetAllByOrderId(_ context.Context, orderId uuid.UUID) ([]*dispatch.Decision, error) {
+	records := make([]decisionRecord, 0, 10)
+	for _, r := range dr.tables.decisions {
+		if r.OrderId == orderId {
+			records = append(records, r)
+		}
+	}
+
+	slices.SortFunc(records, func(a, b decisionRecord) int {
+		if c := a.DecidedAt.Compare(b.DecidedAt); c != 0 {
+			return c
+		}
+
+		return compareIds(a.Id, b.Id)
+	})
+
+	decisions := make([]*dispatch.Decision, 0, len(records))
+	for _, r := range records {
+		decisions = append(decisions, r.ToDecision())
+	}
+
+	return decisions, nil
+}
+
+func (dr *dispatchDecisionRepository) GetLastByOrderId(ctx context.Context, orderId uuid.UUID) (*dispatch.Decision, error) {
+	decisions, err := dr.GetAllByOrderId(ctx, orderId)
+	if err != nil || len(decisions) == 0 {
+		return nil, err
+	}
+
+	return decisions[len(decisions)-1], nil
+}
@@ -0,0 +1,157 @@
+package memory
+
+import (
+	"context"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/outbox"
+	"github.com/google/uuid"
+	"slices"
+)
+
+var _ ports.OrderRepository = &orderRepository{}
+
+type orderRepository struct {
+	tables *tables
+}
+
+func newOrderRepository(tables *tables) ports.OrderRepository {
+	return &orderRepository{
+		tables: tables,
+	}
+}
+
+func (or *orderRepository) Save(_ context.Context, orders ...*order.Order) error {
+	for _, o := range orders {
+		or.tables.orders[o.Id()] = newOrderRecord(o)
+
+		// save events (outbox pattern)
+		for _, event := range o.GetDomainEvents() {
+			msg, err := outbox.EncodeDomainEvent(event)
+			if err != nil {
+				return err
+			}
+
+			if _, found := or.tables.outbox[msg.ID]; !found {
+				or.tables.outbox[msg.ID] = msg
+			}
+		}
+
+		o.ClearDomainEvents()
+	}
+
+	return nil
+}
+
+func (or *orderRepository) Get(_ context.Context, id uuid.UUID) (*order.Order, error) {
+	r, found := or.tables.orders[id]
+	if !found {
+		return nil, nil // not found (no error here)
+	}
+
+	return r.ToOrder(), nil
+}
+
+func (or *orderRepository) GetFirstInCreatedStatus(ctx context.Context) (*order.Order, error) {
+	orders, err := or.GetAllInCreatedStatus(ctx, 1)
+	if err != nil || len(orders) == 0 {
+		return nil, err // not found (no error here)
+	}
+
+	return orders[0], nil
+}
+
+// GetAllInCreatedStatus повторяет порядок выборки из Postgres: по времени создания
+// с учетом форы срочных заказов, затем по времени создания и id
+func (or *orderRepository) GetAllInCreatedStatus(_ context.Context, limit int) ([]*order.Order, error) {
+	records := make([]orderRecord, 0)
+	for _, r := range or.tables.orders {
+		if r.Status == order.StatusCreated {
+			records = append(records, r)
+		}
+	}
+
+	slices.SortFunc(records, compareQueuePosition)
+
+	orders := make([]*order.Order, 0, min(limit, len(records)))
+	for _, r := range records[:min(limit, len(records))] {
+		orders = append(orders, r.ToOrder())
+	}
+
+	return orders, nil
+}
+
+func (or *orderRepository) GetAllInAssignedStatus(_ context.Context) ([]*order.Order, error) {
+	records := make([]orderRecord, 0, 100)
+	for _, r := range or.tables.orders {
+		if r.Status.IsInProgress() {
+			records = append(records, r)
+		}
+	}
+
+	slices.SortFunc(records, func(a, b orderRecord) int {
+		return compareIds(a.Id, b.Id)
+	})
+
+	orders := make([]*order.Order, 0, len(records))
+	for _, r := range records {
+		orders = append(orders, r.ToOrder())
+	}
+
+	return orders, nil
+}
+
+func (or *orderRepository) GetActiveByCourier(_ context.Context, courierId uuid.UUID) ([]*order.Order, error) {
+	records := make([]orderRecord, 0)
+	for _, r := range or.tables.orders {
+		if r.Status.IsInProgress() && r.CourierId != nil && *r.CourierId == courierId {
+			records = append(records, r)
+		}
+	}
+
+	slices.SortFunc(records, func(a, b orderRecord) int {
+		return compareIds(a.Id, b.Id)
+	})
+
+	orders := make([]*order.Order, 0, len(records))
+	for _, r := range records {
+		orders = append(orders, r.ToOrder())
+	}
+
+	return orders, nil
+}
+
+func (or *orderRepository) GetShipments(_ context.Context, parentId uuid.UUID) ([]*order.Order, error) {
+	records := make([]orderRecord, 0)
+	for _, r := range or.tables.orders {
+		if r.ParentId != nil && *r.ParentId == parentId {
+			records = append(records, r)
+		}
+	}
+
+	slices.SortFunc(records, func(a, b orderRecord) int {
+		return compareIds(a.Id, b.Id)
+	})
+
+	orders := make([]*order.Order, 0, len(records))
+	for _, r := range records {
+		orders = append(orders, r.ToOrder())
+	}
+
+	return orders, nil
+}
+
+func compareQueuePosition(a orderRecord, b orderRecord) int {
+	aQueuedAt := a.CreatedAt.Add(-a.Priority.HeadStart())
+	bQueuedAt := b.CreatedAt.Add(-b.Priority.HeadStart())
+
+	if c := aQueuedAt.Compare(bQueuedAt); c != 0 {
+		return c
+	}
+
+	if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+		return c
+	}
+
+	return compareIds(a.Id, b.Id)
+}
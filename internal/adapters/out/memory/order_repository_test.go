@@ -0,0 +1,269 @@
+package memory
+
+import (
+	"context"
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"github.com/google/uuid"
+	"testing"
+	"time"
+)
+
+func TestOrderRepository_GetFirstInCreatedStatus_Priority(t *testing.T) {
+
+	ctx, _, uow := setupTest(t)
+
+	loc, _ := kernel.NewLocation(1, 1)
+	now := time.Now().UTC()
+	newOrder := func(priority order.Priority, createdAt time.Time) *order.Order {
+		return order.RestoreOrder(uuid.New(), nil, kernel.Location{}, loc, 5, 0, order.HandlingStandard, nil, order.StatusCreated,
+			priority, createdAt, 0, "", 0, kernel.Location{}, false, nil, "")
+	}
+
+	getFirst := func() *order.Order {
+		var o *order.Order
+		err := uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+			var err error
+			o, err = uowc.OrderRepository().GetFirstInCreatedStatus(ctx)
+			return err
+		})
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return o
+	}
+
+	save := func(orders ...*order.Order) {
+		err := uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+			return uowc.OrderRepository().Save(ctx, orders...)
+		})
+
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if o := getFirst(); o != nil {
+		t.Fatal("expected no orders")
+	}
+
+	// срочный заказ обгоняет обычные, прождавшие меньше его форы
+	express := newOrder(order.PriorityExpress, now)
+	save(newOrder(order.PriorityStandard, now.Add(-time.Second)), express,
+		newOrder(order.PriorityStandard, now.Add(-time.Minute)))
+
+	if o := getFirst(); o == nil || !o.Equals(express) {
+		t.Fatal("expected express order first")
+	}
+
+	// обычный заказ, прождавший дольше форы, назначается раньше срочного
+	oldStandard := newOrder(order.PriorityStandard, now.Add(-order.PriorityExpress.HeadStart()-time.Minute))
+	save(oldStandard)
+
+	if o := getFirst(); o == nil || !o.Equals(oldStandard) {
+		t.Fatal("expected old standard order first")
+	}
+}
+
+func TestOrderRepository_GetAllInAssignedStatus(t *testing.T) {
+
+	ctx, _, uow := setupTest(t)
+
+	orders := createOrders(10)
+	couriers := createCouriers(1)
+
+	// назначаем каждый второй заказ
+	for i := 0; i < len(orders); i += 2 {
+		_ = orders[i].AssignCourier(couriers[0].Id(), testIds, testClock)
+	}
+
+	var assigned []*order.Order
+	err := uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		err := uowc.OrderRepository().Save(ctx, orders...)
+		if err != nil {
+			return err
+		}
+
+		assigned, err = uowc.OrderRepository().GetAllInAssignedStatus(ctx)
+		return err
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(assigned) != len(orders)/2 {
+		t.Fatalf("expected %d assigned orders, got %d", len(orders)/2, len(assigned))
+	}
+
+	for i, o := range assigned {
+		if o.Status() != order.StatusAssigned || o.CourierId() == nil || *o.CourierId() != couriers[0].Id() {
+			t.Fatal("wrong assigned order data")
+		}
+
+		if i > 0 && compareIds(assigned[i-1].Id(), o.Id()) >= 0 {
+			t.Fatal("expected orders sorted by id")
+		}
+	}
+}
+
+func TestOrderRepository_GetActiveByCourier(t *testing.T) {
+
+	ctx, _, uow := setupTest(t)
+
+	orders := createOrders(4)
+	couriers := createCouriers(2)
+
+	// первому курьеру назначены три заказа, один из них уже доставлен, второму - один
+	_ = orders[0].AssignCourier(couriers[0].Id(), testIds, testClock)
+	_ = orders[1].AssignCourier(couriers[0].Id(), testIds, testClock)
+	_ = orders[2].AssignCourier(couriers[0].Id(), testIds, testClock)
+	_ = orders[2].Complete(testIds, testClock)
+	_ = orders[3].AssignCourier(couriers[1].Id(), testIds, testClock)
+
+	var active []*order.Order
+	err := uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		err := uowc.OrderRepository().Save(ctx, orders...)
+		if err != nil {
+			return err
+		}
+
+		active, err = uowc.OrderRepository().GetActiveByCourier(ctx, couriers[0].Id())
+		return err
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(active) != 2 {
+		t.Fatalf("expected 2 active orders, got %d", len(active))
+	}
+
+	for _, o := range active {
+		if !o.Equals(orders[0]) && !o.Equals(orders[1]) {
+			t.Fatal("wrong active order")
+		}
+	}
+
+	if compareIds(active[0].Id(), active[1].Id()) >= 0 {
+		t.Fatal("expected orders sorted by id")
+	}
+}
+
+func TestOrderRepository_PickupOrderInProgress(t *testing.T) {
+
+	ctx, _, uow := setupTest(t)
+
+	pickup, _ := kernel.NewLocation(2, 2)
+	dropoff, _ := kernel.NewLocation(8, 8)
+	o, _ := order.NewPickupOrder(uuid.New(), pickup, dropoff, 5, 0, order.PriorityStandard, testIds, testClock)
+	_ = o.AssignCourier(uuid.New(), testIds, testClock)
+	_ = o.PickUp(testIds, testClock)
+
+	var assigned []*order.Order
+	err := uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		err := uowc.OrderRepository().Save(ctx, o)
+		if err != nil {
+			return err
+		}
+
+		assigned, err = uowc.OrderRepository().GetAllInAssignedStatus(ctx)
+		return err
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(assigned) != 1 || assigned[0].Status() != order.StatusPickedUp ||
+		!assigned[0].PickupLocation().Equals(pickup) || !assigned[0].Location().Equals(dropoff) {
+		t.Fatal("wrong pickup order data")
+	}
+}
+
+func TestOrderRepository_ChangesVisibleOnlyAfterSave(t *testing.T) {
+
+	ctx, _, uow := setupTest(t)
+
+	orders := createOrders(1)
+	couriers := createCouriers(1)
+
+	err := uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		err := uowc.OrderRepository().Save(ctx, orders...)
+		if err != nil {
+			return err
+		}
+
+		// изменение агрегата без Save не попадает в хранилище
+		err = orders[0].AssignCourier(couriers[0].Id(), testIds, testClock)
+		if err != nil {
+			return err
+		}
+
+		o, err := uowc.OrderRepository().Get(ctx, orders[0].Id())
+		if err != nil {
+			return err
+		}
+
+		if o.Status() != order.StatusCreated || o.CourierId() != nil {
+			t.Fatal("expected unsaved changes invisible")
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestOrderRepository_GetShipments(t *testing.T) {
+
+	ctx, _, uow := setupTest(t)
+
+	orders := createOrders(2)
+	small, _ := order.NewItem(2, 0, order.HandlingStandard)
+	shipments, err := orders[0].Split([][]order.Item{{small}, {small, small}}, testIds, testClock)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var found []*order.Order
+	var next *order.Order
+	err = uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		err := uowc.OrderRepository().Save(ctx, append(orders, shipments...)...)
+		if err != nil {
+			return err
+		}
+
+		found, err = uowc.OrderRepository().GetShipments(ctx, orders[0].Id())
+		if err != nil {
+			return err
+		}
+
+		next, err = uowc.OrderRepository().GetFirstInCreatedStatus(ctx)
+		return err
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(found) != 2 {
+		t.Fatalf("expected 2 shipments, got %d", len(found))
+	}
+
+	for _, s := range found {
+		if s.ParentId() == nil || *s.ParentId() != orders[0].Id() {
+			t.Fatal("wrong shipment parent")
+		}
+	}
+
+	if next == nil || next.Equals(orders[0]) {
+		t.Fatal("split order must not be dispatched")
+	}
+}
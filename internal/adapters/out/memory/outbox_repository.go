@@ -0,0 +1,83 @@
+package memory
+
+import (
+	"context"
+	outb "delivery/internal/adapters/out/outbox"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/outbox"
+	"slices"
+	"time"
+)
+
+var _ outb.OutboxRepository = &outboxRepository{}
+
+type outboxRepository struct {
+	storage *Storage
+}
+
+func NewOutboxRepository(storage *Storage) (outb.OutboxRepository, error) {
+	if storage == nil {
+		return nil, errs.NewValueIsRequiredError("storage")
+	}
+
+	return &outboxRepository{
+		storage: storage,
+	}, nil
+}
+
+func (r *outboxRepository) Save(_ context.Context, messages ...*outbox.Message) error {
+	r.storage.mu.Lock()
+	defer r.storage.mu.Unlock()
+
+	for _, m := range messages {
+		stored, found := r.storage.outbox[m.ID]
+		if !found {
+			stored = *m
+		}
+
+		stored.ProcessedAtUtc = copyPtr(m.ProcessedAtUtc)
+		r.storage.outbox[m.ID] = stored
+	}
+
+	return nil
+}
+
+func (r *outboxRepository) GetNotPublishedMessages(_ context.Context) ([]*outbox.Message, error) {
+	r.storage.mu.RLock()
+	defer r.storage.mu.RUnlock()
+
+	messages := make([]*outbox.Message, 0, 100)
+	for _, m := range r.storage.outbox {
+		if m.ProcessedAtUtc == nil {
+			msg := m
+			messages = append(messages, &msg)
+		}
+	}
+
+	slices.SortFunc(messages, func(a, b *outbox.Message) int {
+		if c := a.OccurredAtUtc.Compare(b.OccurredAtUtc); c != 0 {
+			return c
+		}
+
+		return compareIds(a.ID, b.ID)
+	})
+
+	if len(messages) > 100 {
+		messages = messages[:100]
+	}
+
+	return messages, nil
+}
+
+func (r *outboxRepository) DeleteProcessedMessages(_ context.Context, before time.Time) error {
+	r.storage.mu.Lock()
+	defer r.storage.mu.Unlock()
+
+	for id, m := range r.storage.outbox {
+		if m.ProcessedAtUtc != nil && m.ProcessedAtUtc.Before(before) {
+			delete(r.storage.outbox, id)
+		}
+	}
+
+	return nil
+}
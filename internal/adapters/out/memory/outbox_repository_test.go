@@ -0,0 +1,181 @@
+package memory
+
+import (
+	"bytes"
+	"context"
+	"delivery/internal/adapters/out/clock"
+	"delivery/internal/adapters/out/idgen"
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/services"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/outbox"
+	"testing"
+	"time"
+)
+
+func TestOutboxRepository_SaveAndGetNotPublishedMessages(t *testing.T) {
+
+	ctx, storage, uow := setupTest(t)
+
+	orders := createOrders(3)
+	err := uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		return uowc.OrderRepository().Save(ctx, orders...)
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ob, err := NewOutboxRepository(storage)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	messages, err := ob.GetNotPublishedMessages(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// у каждого заказа - событие о создании и снимок состояния
+	if len(messages) != 2*len(orders) {
+		t.Fatalf("expected %d messages, got %d", 2*len(orders), len(messages))
+	}
+
+	for i := 1; i < len(messages); i++ {
+		if messages[i].OccurredAtUtc.Before(messages[i-1].OccurredAtUtc) {
+			t.Fatal("expected messages sorted by occurred time")
+		}
+	}
+
+	// отмечаем первое сообщение опубликованным
+	processed := time.Now().UTC()
+	messages[0].ProcessedAtUtc = &processed
+
+	err = ob.Save(ctx, messages[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	notPublished, err := ob.GetNotPublishedMessages(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(notPublished) != len(messages)-1 {
+		t.Fatalf("expected %d messages, got %d", len(messages)-1, len(notPublished))
+	}
+
+	for _, m := range notPublished {
+		if m.ID == messages[0].ID {
+			t.Fatal("processed message returned")
+		}
+	}
+}
+
+func TestOutboxRepository_DeleteProcessedMessages(t *testing.T) {
+
+	ctx, storage, uow := setupTest(t)
+
+	orders := createOrders(2)
+	err := uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		return uowc.OrderRepository().Save(ctx, orders...)
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ob, err := NewOutboxRepository(storage)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	messages, err := ob.GetNotPublishedMessages(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// первое сообщение опубликовано давно, второе - только что, остальные еще не опубликованы
+	now := time.Now().UTC()
+	old, recent := now.Add(-2*time.Hour), now
+	messages[0].ProcessedAtUtc = &old
+	messages[1].ProcessedAtUtc = &recent
+
+	err = ob.Save(ctx, messages[0], messages[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = ob.DeleteProcessedMessages(ctx, now.Add(-time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(storage.outbox) != len(messages)-1 {
+		t.Fatalf("expected %d messages, got %d", len(messages)-1, len(storage.outbox))
+	}
+
+	if _, found := storage.outbox[messages[0].ID]; found {
+		t.Fatal("expected old processed message deleted")
+	}
+}
+
+func TestOutboxRepository_SeededRunsAreIdentical(t *testing.T) {
+
+	// прогон: создание курьера и заказа, назначение и доставка
+	run := func() []*outbox.Message {
+		ctx, storage, uow := setupTest(t)
+		ids := idgen.NewSeededGenerator(42)
+		clk := clock.NewFakeClock(time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC))
+
+		loc, _ := kernel.NewLocation(3, 3)
+		c, _ := courier.NewCourier("courier", 1, loc, ids)
+		o, _ := order.NewOrder(ids.NewId(), loc, 5, 0, order.PriorityStandard, ids, clk)
+
+		dispatcher, _ := services.NewOrderDispatcher(kernel.NewGridDistanceCalculator(), ids, clk)
+		_, _, err := dispatcher.Dispatch(o, []*courier.Courier{c})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		clk.Advance(time.Minute)
+		_ = c.CompleteOrder(o)
+		err = o.Complete(ids, clk)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+			return uowc.OrderRepository().Save(ctx, o)
+		})
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ob, _ := NewOutboxRepository(storage)
+		messages, err := ob.GetNotPublishedMessages(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return messages
+	}
+
+	first := run()
+	second := run()
+
+	// создание и завершение заказа плюс снимки после создания, назначения и завершения
+	if len(first) != 5 || len(first) != len(second) {
+		t.Fatalf("expected 5 messages in both runs, got %d and %d", len(first), len(second))
+	}
+
+	for i := range first {
+		if first[i].ID != second[i].ID || !bytes.Equal(first[i].Payload, second[i].Payload) ||
+			!first[i].OccurredAtUtc.Equal(second[i].OccurredAtUtc) {
+			t.Fatalf("message %d differs between runs", i)
+		}
+	}
+}
@@ -0,0 +1,242 @@
+package memory
+
+import (
+	"context"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+	"github.com/google/uuid"
+	"slices"
+)
+
+// Обработчики запросов читают зафиксированное состояние хранилища напрямую, как их Postgres-версии
+// читают таблицы вне транзакции. Поэтому их можно вызывать и внутри единицы работы
+
+var _ queries.AllCouriersQueryHandler = &allCouriersQueryHandler{}
+var _ queries.CourierDetailsQueryHandler = &courierDetailsQueryHandler{}
+var _ queries.IncompleteOrdersQueryHandler = &incompleteOrdersQueryHandler{}
+var _ queries.OrderDetailsQueryHandler = &orderDetailsQueryHandler{}
+var _ queries.DispatchDecisionsQueryHandler = &dispatchDecisionsQueryHandler{}
+
+type allCouriersQueryHandler struct {
+	storage *Storage
+}
+
+func NewAllCouriersQueryHandler(storage *Storage) (queries.AllCouriersQueryHandler, error) {
+	if storage == nil {
+		return nil, errs.NewValueIsRequiredError("storage")
+	}
+
+	return &allCouriersQueryHandler{storage: storage}, nil
+}
+
+func (aq *allCouriersQueryHandler) Handle(_ context.Context) (queries.AllCouriersResponse, error) {
+	aq.storage.mu.RLock()
+	defer aq.storage.mu.RUnlock()
+
+	response := make(queries.AllCouriersResponse, 0, len(aq.storage.couriers))
+	for _, r := range aq.storage.couriers {
+		response = append(response, &queries.CourierResponse{
+			CourierID: r.Id,
+			Name:      r.Name,
+			LocationX: r.LocationX,
+			LocationY: r.LocationY,
+		})
+	}
+
+	slices.SortFunc(response, func(a, b *queries.CourierResponse) int {
+		return compareIds(a.CourierID, b.CourierID)
+	})
+
+	return response, nil
+}
+
+type courierDetailsQueryHandler struct {
+	storage *Storage
+}
+
+func NewCourierDetailsQueryHandler(storage *Storage) (queries.CourierDetailsQueryHandler, error) {
+	if storage == nil {
+		return nil, errs.NewValueIsRequiredError("storage")
+	}
+
+	return &courierDetailsQueryHandler{storage: storage}, nil
+}
+
+func (cq *courierDetailsQueryHandler) Handle(_ context.Context, courierID uuid.UUID) (*queries.CourierDetailsResponse, error) {
+	if courierID == uuid.Nil {
+		return nil, errs.NewValueIsRequiredError("courierID")
+	}
+
+	cq.storage.mu.RLock()
+	defer cq.storage.mu.RUnlock()
+
+	r, ok := cq.storage.couriers[courierID]
+	if !ok {
+		return nil, nil
+	}
+
+	storagePlaces := make([]queries.StoragePlaceResponse, 0, len(r.StoragePlaces))
+	for _, sp := range r.StoragePlaces {
+		storagePlaces = append(storagePlaces, queries.StoragePlaceResponse{
+			StoragePlaceID: sp.Id,
+			Name:           sp.Name,
+			Type:           sp.Type.String(),
+			TotalVolume:    sp.Volume,
+			MaxWeight:      sp.MaxWeight,
+			OrderID:        copyPtr(sp.OrderId),
+		})
+	}
+
+	slices.SortFunc(storagePlaces, func(a, b queries.StoragePlaceResponse) int {
+		return compareIds(a.StoragePlaceID, b.StoragePlaceID)
+	})
+
+	qualifications := make([]string, 0, len(r.Qualifications))
+	for _, q := range r.Qualifications {
+		qualifications = append(qualifications, q.String())
+	}
+
+	return &queries.CourierDetailsResponse{
+		CourierID:      r.Id,
+		Name:           r.Name,
+		Speed:          r.Speed,
+		LocationX:      r.LocationX,
+		LocationY:      r.LocationY,
+		Qualifications: qualifications,
+		StoragePlaces:  storagePlaces,
+	}, nil
+}
+
+type incompleteOrdersQueryHandler struct {
+	storage *Storage
+}
+
+func NewIncompleteOrdersQueryHandler(storage *Storage) (queries.IncompleteOrdersQueryHandler, error) {
+	if storage == nil {
+		return nil, errs.NewValueIsRequiredError("storage")
+	}
+
+	return &incompleteOrdersQueryHandler{storage: storage}, nil
+}
+
+func (iq *incompleteOrdersQueryHandler) Handle(_ context.Context) (queries.IncompleteOrdersResponse, error) {
+	iq.storage.mu.RLock()
+	defer iq.storage.mu.RUnlock()
+
+	response := make(queries.IncompleteOrdersResponse, 0, len(iq.storage.orders))
+	for _, r := range iq.storage.orders {
+		if r.Status == order.StatusCompleted || r.Status == order.StatusUndeliverable ||
+			r.Status == order.StatusReturned {
+			continue
+		}
+
+		response = append(response, &queries.IncompleteOrder{
+			OrderID:   r.Id,
+			LocationX: r.LocationX,
+			LocationY: r.LocationY,
+		})
+	}
+
+	slices.SortFunc(response, func(a, b *queries.IncompleteOrder) int {
+		return compareIds(a.OrderID, b.OrderID)
+	})
+
+	return response, nil
+}
+
+type orderDetailsQueryHandler struct {
+	storage *Storage
+}
+
+func NewOrderDetailsQueryHandler(storage *Storage) (queries.OrderDetailsQueryHandler, error) {
+	if storage == nil {
+		return nil, errs.NewValueIsRequiredError("storage")
+	}
+
+	return &orderDetailsQueryHandler{storage: storage}, nil
+}
+
+func (oq *orderDetailsQueryHandler) Handle(_ context.Context, orderID uuid.UUID) (*queries.OrderDetailsResponse, error) {
+	if orderID == uuid.Nil {
+		return nil, errs.NewValueIsRequiredError("orderID")
+	}
+
+	oq.storage.mu.RLock()
+	defer oq.storage.mu.RUnlock()
+
+	r, ok := oq.storage.orders[orderID]
+	if !ok {
+		return nil, nil
+	}
+
+	response := &queries.OrderDetailsResponse{
+		OrderID:   r.Id,
+		CourierID: copyPtr(r.CourierId),
+		Status:    r.Status.String(),
+		Priority:  r.Priority.String(),
+		LocationX: r.LocationX,
+		LocationY: r.LocationY,
+		CreatedAt: r.CreatedAt,
+		ParentID:  copyPtr(r.ParentId),
+	}
+
+	if !r.PickupLocation.IsEmpty() {
+		x, y := r.PickupLocation.X(), r.PickupLocation.Y()
+		response.PickupLocationX = &x
+		response.PickupLocationY = &y
+	}
+
+	return response, nil
+}
+
+type dispatchDecisionsQueryHandler struct {
+	storage *Storage
+}
+
+func NewDispatchDecisionsQueryHandler(storage *Storage) (queries.DispatchDecisionsQueryHandler, error) {
+	if storage == nil {
+		return nil, errs.NewValueIsRequiredError("storage")
+	}
+
+	return &dispatchDecisionsQueryHandler{storage: storage}, nil
+}
+
+func (dq *dispatchDecisionsQueryHandler) Handle(ctx context.Context, orderID uuid.UUID) (queries.DispatchDecisionsResponse, error) {
+	if orderID == uuid.Nil {
+		return nil, errs.NewValueIsRequiredError("orderID")
+	}
+
+	dq.storage.mu.RLock()
+	defer dq.storage.mu.RUnlock()
+
+	repo := newDispatchDecisionRepository(&dq.storage.tables)
+	decisions, err := repo.GetAllByOrderId(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make(queries.DispatchDecisionsResponse, 0, len(decisions))
+	for _, d := range decisions {
+		candidates := make([]queries.DispatchCandidate, 0, len(d.Candidates()))
+		for _, c := range d.Candidates() {
+			candidates = append(candidates, queries.DispatchCandidate{
+				CourierID:            c.CourierId(),
+				CourierName:          c.CourierName(),
+				Eta:                  c.Eta(),
+				RejectionReason:      c.RejectionReason(),
+				MissingQualification: c.MissingQualification(),
+			})
+		}
+
+		response = append(response, &queries.DispatchDecision{
+			DecisionID: d.Id(),
+			OrderID:    d.OrderId(),
+			CourierID:  d.CourierId(),
+			Candidates: candidates,
+			DecidedAt:  d.DecidedAt(),
+		})
+	}
+
+	return response, nil
+}
@@ -0,0 +1,147 @@
+package memory
+
+import (
+	"context"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"github.com/google/uuid"
+	"testing"
+)
+
+func TestIncompleteOrdersQueryHandler_Handle(t *testing.T) {
+
+	ctx, storage, uow := setupTest(t)
+
+	orders := createOrders(5)
+	couriers := createCouriers(1)
+
+	// первый заказ доставлен, второй недоставляем, третий не подтвержден получателем и возвращен на склад
+	_ = orders[0].AssignCourier(couriers[0].Id(), testIds, testClock)
+	_ = orders[0].Complete(testIds, testClock)
+	_ = orders[1].FailDispatch(1, "no couriers", testIds, testClock)
+	_ = orders[2].RequireConfirmation(testRnd)
+	_ = orders[2].AssignCourier(couriers[0].Id(), testIds, testClock)
+	_, _ = orders[2].ConfirmDelivery("wrong", 1, couriers[0].Location(), testIds, testClock)
+	_ = orders[2].Return(testIds, testClock)
+
+	err := uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		return uowc.OrderRepository().Save(ctx, orders...)
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	handler, err := NewIncompleteOrdersQueryHandler(storage)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	response, err := handler.Handle(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(response) != 2 {
+		t.Fatalf("expected 2 incomplete orders, got %d", len(response))
+	}
+
+	for _, r := range response {
+		if r.OrderID == orders[0].Id() || r.OrderID == orders[1].Id() || r.OrderID == orders[2].Id() {
+			t.Fatal("finished order returned")
+		}
+	}
+
+	if orders[0].Status() != order.StatusCompleted || orders[1].Status() != order.StatusUndeliverable ||
+		orders[2].Status() != order.StatusReturned {
+		t.Fatal("wrong test setup")
+	}
+}
+
+func TestCourierDetailsQueryHandler_Handle(t *testing.T) {
+
+	ctx, storage, uow := setupTest(t)
+
+	couriers := createCouriers(1)
+	_ = couriers[0].AddStoragePlace("Thermal bag", courier.StoragePlaceThermal, 20, 5000, testIds)
+
+	err := uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		return uowc.CourierRepository().Save(ctx, couriers...)
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	handler, err := NewCourierDetailsQueryHandler(storage)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	response, err := handler.Handle(ctx, couriers[0].Id())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if response == nil || len(response.StoragePlaces) != 2 {
+		t.Fatal("expected courier with 2 storage places")
+	}
+
+	thermal := 0
+	for _, sp := range response.StoragePlaces {
+		if sp.Type == courier.StoragePlaceThermal.String() && sp.MaxWeight == 5000 {
+			thermal++
+		}
+	}
+
+	if thermal != 1 {
+		t.Fatal("thermal storage place not returned")
+	}
+
+	response, err = handler.Handle(ctx, uuid.New())
+	if err != nil || response != nil {
+		t.Fatal("unknown courier must not be found")
+	}
+}
+
+func TestOrderDetailsQueryHandler_Handle(t *testing.T) {
+
+	ctx, storage, uow := setupTest(t)
+
+	orders := createOrders(1)
+	couriers := createCouriers(1)
+	_ = orders[0].AssignCourier(couriers[0].Id(), testIds, testClock)
+
+	err := uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		return uowc.OrderRepository().Save(ctx, orders...)
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	handler, err := NewOrderDetailsQueryHandler(storage)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	response, err := handler.Handle(ctx, orders[0].Id())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if response == nil || response.Status != order.StatusAssigned.String() ||
+		response.CourierID == nil || *response.CourierID != couriers[0].Id() {
+		t.Fatal("expected assigned order")
+	}
+
+	if response.PickupLocationX != nil || response.ParentID != nil {
+		t.Fatal("order without pickup and parent expected")
+	}
+
+	response, err = handler.Handle(ctx, uuid.New())
+	if err != nil || response != nil {
+		t.Fatal("unknown order must not be found")
+	}
+}
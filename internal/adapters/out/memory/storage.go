@@ -0,0 +1,260 @@
+package memory
+
+import (
+	"bytes"
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/dispatch"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/outbox"
+	"github.com/google/uuid"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Storage хранит копии агрегатов, а не сами объекты: изменения агрегата
+// видны другим только после Save, как и в БД.
+// Единица работы меняет собственную копию таблиц и подменяет ими зафиксированные
+// только при успешном завершении, поэтому запросы и outbox читают зафиксированное
+// состояние под отдельной блокировкой и не ждут завершения единицы работы
+type Storage struct {
+	tx sync.Mutex   // единицы работы выполняются последовательно
+	mu sync.RWMutex // защищает зафиксированные таблицы
+	tables
+}
+
+// tables - набор таблиц хранилища. Записи не изменяются на месте (Save заменяет их целиком),
+// поэтому для копии таблиц достаточно копировать карты
+type tables struct {
+	orders    map[uuid.UUID]orderRecord
+	couriers  map[uuid.UUID]courierRecord
+	decisions map[uuid.UUID]decisionRecord
+	outbox    map[uuid.UUID]outbox.Message
+}
+
+func NewStorage() *Storage {
+	return &Storage{
+		tables: tables{
+			orders:    map[uuid.UUID]orderRecord{},
+			couriers:  map[uuid.UUID]courierRecord{},
+			decisions: map[uuid.UUID]decisionRecord{},
+			outbox:    map[uuid.UUID]outbox.Message{},
+		},
+	}
+}
+
+func (t *tables) clone() tables {
+	return tables{
+		orders:    maps.Clone(t.orders),
+		couriers:  maps.Clone(t.couriers),
+		decisions: maps.Clone(t.decisions),
+		outbox:    maps.Clone(t.outbox),
+	}
+}
+
+// begin возвращает рабочую копию таблиц для единицы работы. Outbox в копии содержит
+// только новые сообщения: отметки об их обработке ставит outbox-джоба, а не единица работы.
+// Вызывается под блокировкой tx, а зафиксированные агрегаты меняет только commit под ней же
+func (s *Storage) begin() *tables {
+	return &tables{
+		orders:    maps.Clone(s.orders),
+		couriers:  maps.Clone(s.couriers),
+		decisions: maps.Clone(s.decisions),
+		outbox:    map[uuid.UUID]outbox.Message{},
+	}
+}
+
+// commit подменяет зафиксированные таблицы рабочей копией и добавляет новые сообщения outbox
+func (s *Storage) commit(t *tables) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.orders = t.orders
+	s.couriers = t.couriers
+	s.decisions = t.decisions
+	for id, msg := range t.outbox {
+		if _, found := s.outbox[id]; !found {
+			s.outbox[id] = msg
+		}
+	}
+}
+
+type orderRecord struct {
+	Id               uuid.UUID
+	CourierId        *uuid.UUID
+	PickupLocation   kernel.Location
+	LocationX        int
+	LocationY        int
+	LocationGeo      kernel.GeoLocation
+	Volume           int
+	Weight           int
+	Handling         order.Handling
+	Restrictions     []order.Restriction
+	Status           order.Status
+	Priority         order.Priority
+	CreatedAt        time.Time
+	DispatchAttempts int
+	ConfirmationCode string
+	DeliveryAttempts int
+	ReturnLocation   kernel.Location
+	RetryDelivery    bool
+	ParentId         *uuid.UUID
+	AssignedBy       string
+}
+
+func newOrderRecord(o *order.Order) orderRecord {
+	return orderRecord{
+		Id:               o.Id(),
+		CourierId:        copyPtr(o.CourierId()),
+		PickupLocation:   o.PickupLocation(),
+		LocationX:        o.Location().X(),
+		LocationY:        o.Location().Y(),
+		LocationGeo:      o.Location().Geo(),
+		Volume:           o.Volume(),
+		Weight:           o.Weight(),
+		Handling:         o.Handling(),
+		Restrictions:     slices.Clone(o.Restrictions()),
+		Status:           o.Status(),
+		Priority:         o.Priority(),
+		CreatedAt:        o.CreatedAt(),
+		DispatchAttempts: o.DispatchAttempts(),
+		ConfirmationCode: o.ConfirmationCode(),
+		DeliveryAttempts: o.DeliveryAttempts(),
+		ReturnLocation:   o.ReturnLocation(),
+		RetryDelivery:    o.RetryDelivery(),
+		ParentId:         copyPtr(o.ParentId()),
+		AssignedBy:       o.AssignedBy(),
+	}
+}
+
+func (r orderRecord) ToOrder() *order.Order {
+	loc := restoreLocation(r.LocationX, r.LocationY, r.LocationGeo)
+	return order.RestoreOrder(r.Id, copyPtr(r.CourierId), r.PickupLocation, loc, r.Volume, r.Weight, r.Handling,
+		slices.Clone(r.Restrictions), r.Status, r.Priority, r.CreatedAt, r.DispatchAttempts, r.ConfirmationCode,
+		r.DeliveryAttempts, r.ReturnLocation, r.RetryDelivery, copyPtr(r.ParentId),
+		r.AssignedBy)
+}
+
+type storagePlaceRecord struct {
+	Id        uuid.UUID
+	Name      string
+	Type      courier.StoragePlaceType
+	Volume    int
+	MaxWeight int
+	OrderId   *uuid.UUID
+}
+
+type courierRecord struct {
+	Id             uuid.UUID
+	Name           string
+	Speed          int
+	LocationX      int
+	LocationY      int
+	LocationGeo    kernel.GeoLocation
+	LastMovedAt    *time.Time
+	HomeDepot      kernel.Location
+	Qualifications []courier.Qualification
+	StoragePlaces  []storagePlaceRecord
+
+	LocationReportedAt *time.Time
+}
+
+func newCourierRecord(c *courier.Courier) courierRecord {
+	storagePlaces := make([]storagePlaceRecord, 0, len(c.StoragePlaces()))
+	for _, sp := range c.StoragePlaces() {
+		storagePlaces = append(storagePlaces, storagePlaceRecord{
+			Id:        sp.Id(),
+			Name:      sp.Name(),
+			Type:      sp.Type(),
+			Volume:    sp.TotalVolume(),
+			MaxWeight: sp.MaxWeight(),
+			OrderId:   copyPtr(sp.OrderID()),
+		})
+	}
+
+	return courierRecord{
+		Id:             c.Id(),
+		Name:           c.Name(),
+		Speed:          c.Speed(),
+		LocationX:      c.Location().X(),
+		LocationY:      c.Location().Y(),
+		LocationGeo:    c.Location().Geo(),
+		LastMovedAt:    copyPtr(c.LastMovedAt()),
+		HomeDepot:      c.HomeDepot(),
+		Qualifications: slices.Clone(c.Qualifications()),
+		StoragePlaces:  storagePlaces,
+
+		LocationReportedAt: copyPtr(c.LocationReportedAt()),
+	}
+}
+
+func (r courierRecord) ToCourier() *courier.Courier {
+	loc := restoreLocation(r.LocationX, r.LocationY, r.LocationGeo)
+
+	storagePlaces := make([]*courier.StoragePlace, 0, len(r.StoragePlaces))
+	for _, sp := range r.StoragePlaces {
+		storagePlaces = append(storagePlaces, courier.RestoreStoragePlace(sp.Id, sp.Name, sp.Type, sp.Volume, sp.MaxWeight,
+			copyPtr(sp.OrderId)))
+	}
+
+	return courier.RestoreCourier(r.Id, r.Name, r.Speed, loc, storagePlaces, copyPtr(r.LastMovedAt), r.HomeDepot,
+		slices.Clone(r.Qualifications), copyPtr(r.LocationReportedAt))
+}
+
+func (r courierRecord) IsFree() bool {
+	for _, sp := range r.StoragePlaces {
+		if sp.OrderId != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// restoreLocation восстанавливает точку вместе с точными координатами, если они сохранены
+func restoreLocation(x, y int, geo kernel.GeoLocation) kernel.Location {
+	if geo.IsEmpty() {
+		return kernel.RestoreLocation(x, y)
+	}
+
+	return kernel.RestoreGeoLocation(x, y, geo.Lat(), geo.Lon())
+}
+
+type decisionRecord struct {
+	Id         uuid.UUID
+	OrderId    uuid.UUID
+	CourierId  *uuid.UUID
+	Candidates []dispatch.Candidate
+	DecidedAt  time.Time
+}
+
+func newDecisionRecord(d *dispatch.Decision) decisionRecord {
+	return decisionRecord{
+		Id:         d.Id(),
+		OrderId:    d.OrderId(),
+		CourierId:  copyPtr(d.CourierId()),
+		Candidates: append([]dispatch.Candidate{}, d.Candidates()...),
+		DecidedAt:  d.DecidedAt(),
+	}
+}
+
+func (r decisionRecord) ToDecision() *dispatch.Decision {
+	return dispatch.RestoreDecision(r.Id, r.OrderId, copyPtr(r.CourierId),
+		append([]dispatch.Candidate{}, r.Candidates...), r.DecidedAt)
+}
+
+func copyPtr[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+
+	v := *p
+	return &v
+}
+
+// compareIds упорядочивает идентификаторы так же, как Postgres сравнивает uuid
+func compareIds(a uuid.UUID, b uuid.UUID) int {
+	return bytes.Compare(a[:], b[:])
+}
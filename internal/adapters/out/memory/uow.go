@@ -0,0 +1,111 @@
+package memory
+
+import (
+	"context"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/ddd"
+	"delivery/internal/pkg/errs"
+	"log"
+)
+
+var _ ports.UnitOfWork = &unitOfWork{}
+var _ ports.UnitOfWorkComponents = &unitOfWorkComponents{}
+
+// unitOfWork выполняет работу последовательно над рабочей копией хранилища и фиксирует ее
+// только при успешном завершении. Вложенная работа при ошибке откатывается отдельно -
+// как транзакция и savepoint в Postgres
+type unitOfWork struct {
+	storage *Storage
+	mediatr ddd.Mediatr
+}
+
+type uowKeyType struct{}
+
+var uowKey = uowKeyType{}
+
+// transaction - открытая единица работы, передается вложенной работе через контекст
+type transaction struct {
+	uow    *unitOfWork
+	tables *tables
+	// transient - события, которые публикуются после фиксации, минуя outbox
+	transient []ddd.DomainEvent
+}
+
+type unitOfWorkComponents struct {
+	tx *transaction
+}
+
+func NewUnitOfWork(storage *Storage, mediatr ddd.Mediatr) (ports.UnitOfWork, error) {
+	if storage == nil {
+		return nil, errs.NewValueIsRequiredError("storage")
+	}
+
+	if mediatr == nil {
+		return nil, errs.NewValueIsRequiredError("mediatr")
+	}
+
+	return &unitOfWork{
+		storage: storage,
+		mediatr: mediatr,
+	}, nil
+}
+
+func (u *unitOfWork) Do(ctx context.Context, fn ports.UnitOfWorkDoFunc) error {
+	// вложенная работа выполняется в уже открытой транзакции
+	if tx, ok := ctx.Value(uowKey).(*transaction); ok && tx.uow == u {
+		savepoint, transient := tx.tables.clone(), len(tx.transient)
+
+		err := fn(ctx, &unitOfWorkComponents{tx: tx})
+		if err != nil {
+			*tx.tables = savepoint
+			tx.transient = tx.transient[:transient]
+			return err
+		}
+
+		return nil
+	}
+
+	tx, err := u.commit(ctx, fn)
+	if err != nil {
+		return err
+	}
+
+	// изменения уже зафиксированы, поэтому ошибка подписчика только записывается в лог
+	for _, event := range tx.transient {
+		err = u.mediatr.Publish(ctx, event)
+		if err != nil {
+			log.Printf("cannot publish %s: %v", event.GetName(), err)
+		}
+	}
+
+	return nil
+}
+
+// commit выполняет работу верхнего уровня над рабочей копией и фиксирует ее
+func (u *unitOfWork) commit(ctx context.Context, fn ports.UnitOfWorkDoFunc) (*transaction, error) {
+	u.storage.tx.Lock()
+	defer u.storage.tx.Unlock()
+
+	tx := &transaction{uow: u, tables: u.storage.begin()}
+
+	// при ошибке рабочая копия просто отбрасывается
+	err := fn(context.WithValue(ctx, uowKey, tx), &unitOfWorkComponents{tx: tx})
+	if err != nil {
+		return nil, err
+	}
+
+	u.storage.commit(tx.tables)
+	return tx, nil
+}
+
+func (uowc *unitOfWorkComponents) OrderRepository() ports.OrderRepository {
+	return newOrderRepository(uowc.tx.tables)
+}
+
+func (uowc *unitOfWorkComponents) CourierRepository() ports.CourierRepository {
+	return newCourierRepository(uowc.tx.tables, &uowc.tx.transient)
+}
+
+func (uowc *unitOfWorkComponents) DispatchDecisionRepository() ports.DispatchDecisionRepository {
+	return newDispatchDecisionRepository(uowc.tx.tables)
+}
@@ -0,0 +1,309 @@
+package memory
+
+import (
+	"context"
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/ddd"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestUnitOfWork_Do_WithCommit(t *testing.T) {
+
+	ctx, storage, uow := setupTest(t)
+
+	couriers := createCouriers(5)
+	orders := createOrders(10)
+
+	err := uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		err := uowc.CourierRepository().Save(ctx, couriers...)
+		if err != nil {
+			return err
+		}
+
+		return uowc.OrderRepository().Save(ctx, orders...)
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(storage.couriers) != len(couriers) || len(storage.orders) != len(orders) {
+		t.Fatal("expected couriers and orders saved")
+	}
+
+	// события созданных заказов (создание и снимок состояния) попадают в outbox
+	if len(storage.outbox) != 2*len(orders) {
+		t.Fatalf("expected %d outbox messages, got %d", 2*len(orders), len(storage.outbox))
+	}
+
+	// сохраненные агрегаты читаются обратно
+	err = uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		for _, o := range orders {
+			saved, err := uowc.OrderRepository().Get(ctx, o.Id())
+			if err != nil {
+				return err
+			}
+
+			if saved == nil || !saved.Equals(o) || saved.Location() != o.Location() ||
+				saved.Volume() != o.Volume() || saved.Status() != o.Status() {
+				return errors.New("wrong order data")
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUnitOfWork_Do_WithRollback(t *testing.T) {
+
+	ctx, storage, uow := setupTest(t)
+
+	couriers := createCouriers(2)
+	orders := createOrders(10)
+
+	err := uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		err := uowc.CourierRepository().Save(ctx, couriers...)
+		if err != nil {
+			return err
+		}
+
+		err = uowc.OrderRepository().Save(ctx, orders...)
+		if err != nil {
+			return err
+		}
+
+		// делаем Rollback
+		return errors.New("undo")
+	})
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if countAll(storage) != 0 {
+		t.Fatal("expected empty storage")
+	}
+}
+
+func TestUnitOfWork_Do_WithRollbackInNestedTransaction(t *testing.T) {
+
+	ctx, storage, uow := setupTest(t)
+
+	couriers := createCouriers(3)
+	orders := createOrders(10)
+
+	err := uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+
+		// сохраняем заказы во вложенной транзакции, но откатываем ее
+		err := uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+			err := uowc.OrderRepository().Save(ctx, orders...)
+			if err != nil {
+				return err
+			}
+
+			return errors.New("undo orders")
+		})
+
+		if err == nil {
+			return errors.New("expected error")
+		}
+
+		return uowc.CourierRepository().Save(ctx, couriers...)
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(storage.orders) != 0 || len(storage.outbox) != 0 {
+		t.Fatal("orders should be 0")
+	}
+
+	if len(storage.couriers) != len(couriers) {
+		t.Fatalf("couriers should be %d", len(couriers))
+	}
+}
+
+func TestUnitOfWork_Do_RollbackRestoresUpdatedAggregates(t *testing.T) {
+
+	ctx, _, uow := setupTest(t)
+
+	orders := createOrders(1)
+	couriers := createCouriers(1)
+
+	err := uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		return uowc.OrderRepository().Save(ctx, orders...)
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// назначаем заказ и откатываем изменения
+	err = uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		err := orders[0].AssignCourier(couriers[0].Id(), testIds, testClock)
+		if err != nil {
+			return err
+		}
+
+		err = uowc.OrderRepository().Save(ctx, orders...)
+		if err != nil {
+			return err
+		}
+
+		return errors.New("undo")
+	})
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	err = uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		o, err := uowc.OrderRepository().GetFirstInCreatedStatus(ctx)
+		if err != nil {
+			return err
+		}
+
+		if o == nil || !o.Equals(orders[0]) || o.CourierId() != nil {
+			return errors.New("expected unassigned order")
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUnitOfWork_Do_QueriesAndOutboxInsideWork(t *testing.T) {
+
+	ctx, storage, uow := setupTest(t)
+
+	orders := createOrders(3)
+
+	incompleteOrders, err := NewIncompleteOrdersQueryHandler(storage)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ob, err := NewOutboxRepository(storage)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// запросы и outbox не ждут блокировку единицы работы и не видят ее незафиксированных изменений
+	err = uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		err := uowc.OrderRepository().Save(ctx, orders...)
+		if err != nil {
+			return err
+		}
+
+		response, err := incompleteOrders.Handle(ctx)
+		if err != nil {
+			return err
+		}
+
+		messages, err := ob.GetNotPublishedMessages(ctx)
+		if err != nil {
+			return err
+		}
+
+		if len(response) != 0 || len(messages) != 0 {
+			return errors.New("uncommitted changes are visible")
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	response, err := incompleteOrders.Handle(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(response) != len(orders) {
+		t.Fatalf("expected %d committed orders, got %d", len(orders), len(response))
+	}
+}
+
+type recordingHandler struct {
+	events []ddd.DomainEvent
+}
+
+func (h *recordingHandler) Handle(_ context.Context, event ddd.DomainEvent) error {
+	h.events = append(h.events, event)
+	return nil
+}
+
+func TestUnitOfWork_Do_PublishesTransientEventsAfterCommit(t *testing.T) {
+
+	ctx := context.Background()
+	storage := NewStorage()
+	mediatr := ddd.NewMediatr()
+	handler := &recordingHandler{}
+
+	uow, err := NewUnitOfWork(storage, mediatr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	from, _ := kernel.NewLocation(1, 1)
+	moved, _ := kernel.NewLocation(2, 1)
+	rolledBack, _ := kernel.NewLocation(3, 1)
+	c, _ := courier.NewCourier("courier", 1, from, testIds)
+	event, _ := courier.NewMovedDomainEvent(c.Id(), from, testIds, testClock)
+	mediatr.Subscribe(handler, event)
+
+	now := time.Now().UTC()
+	err = uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		_ = c.ReportLocation(moved, now, testIds)
+		err := uowc.CourierRepository().Save(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		// перемещение во вложенной работе, которая откатилась, не публикуется
+		_ = uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+			_ = c.ReportLocation(rolledBack, now.Add(time.Second), testIds)
+			err := uowc.CourierRepository().Save(ctx, c)
+			if err != nil {
+				return err
+			}
+
+			return errors.New("rollback")
+		})
+
+		if len(handler.events) != 0 {
+			return errors.New("event published before commit")
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(handler.events) != 1 || handler.events[0].(*courier.MovedDomainEvent).LocationX != moved.X() {
+		t.Fatalf("expected one published move, got %d", len(handler.events))
+	}
+
+	// перемещения не попадают в outbox
+	for _, msg := range storage.outbox {
+		if msg.Name == event.GetName() {
+			t.Fatal("unexpected move in outbox")
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package memory
+
+import (
+	"context"
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/ddd"
+	"fmt"
+	"github.com/google/uuid"
+	"math/rand"
+	"testing"
+	"time"
+)
+
+var testIds = kernel.IDGeneratorFunc(uuid.New)
+var testClock = kernel.ClockFunc(func() time.Time { return time.Now().UTC() })
+var testRnd = rand.New(rand.NewSource(time.Now().UnixNano()))
+
+func setupTest(t *testing.T) (context.Context, *Storage, ports.UnitOfWork) {
+	storage := NewStorage()
+
+	uow, err := NewUnitOfWork(storage, ddd.NewMediatr())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return context.Background(), storage, uow
+}
+
+func createOrders(count int) []*order.Order {
+	area, _ := kernel.NewServiceArea(10, 10)
+	orders := make([]*order.Order, count)
+	for i := range count {
+		orders[i], _ = order.NewOrder(uuid.New(), area.RandomLocation(testRnd), i%10+1, 0, order.PriorityStandard, testIds, testClock)
+	}
+
+	return orders
+}
+
+func createCouriers(count int) []*courier.Courier {
+	area, _ := kernel.NewServiceArea(10, 10)
+	couriers := make([]*courier.Courier, count)
+	for i := range count {
+		couriers[i], _ = courier.NewCourier(fmt.Sprintf("courier%d", i), i%5+1, area.RandomLocation(testRnd), testIds)
+	}
+
+	return couriers
+}
+
+func countAll(s *Storage) int {
+	return len(s.orders) + len(s.couriers) + len(s.decisions) + len(s.outbox)
+}
@@ -5,11 +5,14 @@ import (
 	"delivery/internal/pkg/errs"
 	"delivery/internal/pkg/outbox"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"time"
 )
 
 type OutboxRepository interface {
 	Save(ctx context.Context, messages ...*outbox.Message) error
 	GetNotPublishedMessages(ctx context.Context) ([]*outbox.Message, error)
+	// DeleteProcessedMessages удаляет сообщения, опубликованные раньше before
+	DeleteProcessedMessages(ctx context.Context, before time.Time) error
 }
 
 var _ OutboxRepository = &repository{}
@@ -80,3 +83,11 @@ func (r *repository) GetNotPublishedMessages(ctx context.Context) ([]*outbox.Mes
 
 	return messages, nil
 }
+
+func (r *repository) DeleteProcessedMessages(ctx context.Context, before time.Time) error {
+	query := `delete from outbox
+			  where processed < $1`
+
+	_, err := r.db.Exec(ctx, query, before)
+	return err
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"delivery/internal/core/domain/model/courier"
 	"delivery/internal/core/ports"
+	"delivery/internal/pkg/ddd"
 	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/outbox"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 )
@@ -13,15 +15,22 @@ var _ ports.CourierRepository = &courierRepository{}
 
 type courierRepository struct {
 	tx pgx.Tx
+	// transient - события, которые публикуются после фиксации транзакции, минуя outbox
+	transient *[]ddd.DomainEvent
 }
 
-func NewCourierRepository(tx pgx.Tx) (ports.CourierRepository, error) {
+func NewCourierRepository(tx pgx.Tx, transient *[]ddd.DomainEvent) (ports.CourierRepository, error) {
 	if tx == nil {
 		return nil, errs.NewValueIsRequiredError("tx")
 	}
 
+	if transient == nil {
+		return nil, errs.NewValueIsRequiredError("transient")
+	}
+
 	return &courierRepository{
-		tx: tx,
+		tx:        tx,
+		transient: transient,
 	}, nil
 }
 
@@ -32,9 +41,20 @@ func (cr *courierRepository) Get(ctx context.Context, id uuid.UUID) (*courier.Co
 					 c.speed,
 					 c.location_x,
 					 c.location_y,
+					 c.last_moved_at,
+					 c.home_depot_x,
+					 c.home_depot_y,
+					 c.qualifications,
+					 c.location_reported_at,
+					 c.location_lat,
+					 c.location_lon,
+					 c.home_depot_lat,
+					 c.home_depot_lon,
 					 sp.id,
 					 sp.name,
+					 sp.type,
 					 sp.volume,
+					 sp.max_weight,
 					 sp.order_id
 			  from couriers c inner join storage_places sp on c.id = sp.courier_id
 			  where c.id = $1
@@ -53,8 +73,10 @@ func (cr *courierRepository) Get(ctx context.Context, id uuid.UUID) (*courier.Co
 
 	for rows.Next() {
 
-		err = rows.Scan(&cDTO.Id, &cDTO.Name, &cDTO.Speed, &cDTO.LocationX, &cDTO.LocationY,
-			&spDTO.Id, &spDTO.Name, &spDTO.Volume, &spDTO.OrderId)
+		err = rows.Scan(&cDTO.Id, &cDTO.Name, &cDTO.Speed, &cDTO.LocationX, &cDTO.LocationY, &cDTO.LastMovedAt,
+			&cDTO.HomeDepotX, &cDTO.HomeDepotY, &cDTO.Qualifications, &cDTO.LocationReportedAt, &cDTO.LocationLat,
+			&cDTO.LocationLon, &cDTO.HomeDepotLat, &cDTO.HomeDepotLon, &spDTO.Id, &spDTO.Name, &spDTO.Type,
+			&spDTO.Volume, &spDTO.MaxWeight, &spDTO.OrderId)
 
 		if err != nil {
 			return nil, err
@@ -75,22 +97,42 @@ func (cr *courierRepository) Get(ctx context.Context, id uuid.UUID) (*courier.Co
 }
 
 func (cr courierRepository) GetAllFree(ctx context.Context) ([]*courier.Courier, error) {
+	return cr.getAll(ctx, `not exists (select null
+			  				 from storage_places sp2
+			  				 where sp2.courier_id = c.id
+			  				   and sp2.order_id is not null)`)
+}
+
+func (cr *courierRepository) GetAll(ctx context.Context) ([]*courier.Courier, error) {
+	return cr.getAll(ctx, "true")
+}
+
+// getAll выбирает курьеров с местами хранения по условию condition на таблицу couriers c
+func (cr *courierRepository) getAll(ctx context.Context, condition string) ([]*courier.Courier, error) {
 
 	query := `select c.id,
 			  	     c.name,
 			  	     c.speed,
 			  	     c.location_x,
 			  	     c.location_y,
+			  	     c.last_moved_at,
+			  	     c.home_depot_x,
+			  	     c.home_depot_y,
+			  	     c.qualifications,
+			  	     c.location_reported_at,
+			  	     c.location_lat,
+			  	     c.location_lon,
+			  	     c.home_depot_lat,
+			  	     c.home_depot_lon,
 			  	     sp.id,
 			  	     sp.name,
+			  	     sp.type,
 			  	     sp.volume,
+			  	     sp.max_weight,
 			  	     sp.order_id
 			  from couriers c
 			  		 inner join storage_places sp on c.id = sp.courier_id
-			  where not exists (select null
-			  				    from storage_places sp2
-			  				    where sp2.courier_id = c.id
-			  					  and sp2.order_id is not null)
+			  where ` + condition + `
 			  order by c.id, sp.id`
 
 	rows, err := cr.tx.Query(ctx, query)
@@ -109,8 +151,10 @@ func (cr courierRepository) GetAllFree(ctx context.Context) ([]*courier.Courier,
 		cDTO := courierDTO{StoragePlaces: make([]storagePlaceDTO, 0, 10)}
 		spDTO := storagePlaceDTO{}
 
-		err = rows.Scan(&cDTO.Id, &cDTO.Name, &cDTO.Speed, &cDTO.LocationX, &cDTO.LocationY,
-			&spDTO.Id, &spDTO.Name, &spDTO.Volume, &spDTO.OrderId)
+		err = rows.Scan(&cDTO.Id, &cDTO.Name, &cDTO.Speed, &cDTO.LocationX, &cDTO.LocationY, &cDTO.LastMovedAt,
+			&cDTO.HomeDepotX, &cDTO.HomeDepotY, &cDTO.Qualifications, &cDTO.LocationReportedAt, &cDTO.LocationLat,
+			&cDTO.LocationLon, &cDTO.HomeDepotLat, &cDTO.HomeDepotLon, &spDTO.Id, &spDTO.Name, &spDTO.Type,
+			&spDTO.Volume, &spDTO.MaxWeight, &spDTO.OrderId)
 
 		if err != nil {
 			return nil, err
@@ -142,35 +186,80 @@ func (cr courierRepository) GetAllFree(ctx context.Context) ([]*courier.Courier,
 
 func (cr *courierRepository) Save(ctx context.Context, couriers ...*courier.Courier) error {
 
-	cQuery := `insert into couriers (id, name, speed, location_x, location_y)
-	 		   values ($1, $2, $3, $4, $5)
+	cQuery := `insert into couriers (id, name, speed, location_x, location_y, last_moved_at, home_depot_x, home_depot_y,
+	                                 qualifications, location_reported_at, location_lat, location_lon, home_depot_lat,
+	                                 home_depot_lon)
+	 		   values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
 			   on conflict (id)
-				  do update set name       = EXCLUDED.name,
-					    	    speed      = EXCLUDED.speed,
-							    location_x = EXCLUDED.location_x,
-							    location_y = EXCLUDED.location_y;`
-
-	spQuery := `insert into storage_places (id, name, volume, order_id, courier_id)
-				values ($1, $2, $3, $4, $5)
+				  do update set name                 = EXCLUDED.name,
+					    	    speed                = EXCLUDED.speed,
+							    location_x           = EXCLUDED.location_x,
+							    location_y           = EXCLUDED.location_y,
+							    last_moved_at        = EXCLUDED.last_moved_at,
+							    home_depot_x         = EXCLUDED.home_depot_x,
+							    home_depot_y         = EXCLUDED.home_depot_y,
+							    qualifications       = EXCLUDED.qualifications,
+							    location_reported_at = EXCLUDED.location_reported_at,
+							    location_lat         = EXCLUDED.location_lat,
+							    location_lon         = EXCLUDED.location_lon,
+							    home_depot_lat       = EXCLUDED.home_depot_lat,
+							    home_depot_lon       = EXCLUDED.home_depot_lon;`
+
+	spQuery := `insert into storage_places (id, name, volume, max_weight, order_id, courier_id, type)
+				values ($1, $2, $3, $4, $5, $6, $7)
 				on conflict (id)
 				   do update set name       = EXCLUDED.name,
+								 type       = EXCLUDED.type,
 								 volume     = EXCLUDED.volume,
+								 max_weight = EXCLUDED.max_weight,
 								 order_id   = EXCLUDED.order_id,
 								 courier_id = EXCLUDED.courier_id;`
 
+	outboxQuery := `insert into outbox(id, type, content, occurred)
+                    values ($1, $2, $3, $4)
+                    on conflict (id) do nothing;`
+
 	for _, c := range couriers {
 
-		_, err := cr.tx.Exec(ctx, cQuery, c.Id(), c.Name(), c.Speed(), c.Location().X(), c.Location().Y())
+		depotX, depotY := nullableLocation(c.HomeDepot())
+		lat, lon := nullableGeoLocation(c.Location())
+		depotLat, depotLon := nullableGeoLocation(c.HomeDepot())
+		_, err := cr.tx.Exec(ctx, cQuery, c.Id(), c.Name(), c.Speed(), c.Location().X(), c.Location().Y(),
+			c.LastMovedAt(), depotX, depotY, toStrings(c.Qualifications()), c.LocationReportedAt(), lat, lon,
+			depotLat, depotLon)
 		if err != nil {
 			return err
 		}
 
 		for _, sp := range c.StoragePlaces() {
-			_, err = cr.tx.Exec(ctx, spQuery, sp.Id(), sp.Name(), sp.TotalVolume(), sp.OrderID(), c.Id())
+			_, err = cr.tx.Exec(ctx, spQuery, sp.Id(), sp.Name(), sp.TotalVolume(), sp.MaxWeight(), sp.OrderID(),
+				c.Id(), sp.Type())
 			if err != nil {
 				return err
 			}
 		}
+
+		// save events (outbox pattern)
+		for _, event := range c.GetDomainEvents() {
+
+			if ddd.IsTransient(event) {
+				*cr.transient = append(*cr.transient, event)
+				continue
+			}
+
+			msg, err := outbox.EncodeDomainEvent(event)
+			if err != nil {
+				return err
+			}
+
+			_, err = cr.tx.Exec(ctx, outboxQuery, msg.ID, msg.Name, msg.Payload, msg.OccurredAtUtc)
+			if err != nil {
+				return err
+			}
+		}
+
+		// clear events
+		c.ClearDomainEvents()
 	}
 
 	return nil
@@ -4,35 +4,108 @@ import (
 	"delivery/internal/core/domain/kernel"
 	"delivery/internal/core/domain/model/courier"
 	"github.com/google/uuid"
+	"time"
 )
 
 type storagePlaceDTO struct {
-	Id      uuid.UUID  `db:"id"`
-	Name    string     `db:"name"`
-	Volume  int        `db:"volume"`
-	OrderId *uuid.UUID `db:"order_id"`
+	Id        uuid.UUID                `db:"id"`
+	Name      string                   `db:"name"`
+	Type      courier.StoragePlaceType `db:"type"`
+	Volume    int                      `db:"volume"`
+	MaxWeight int                      `db:"max_weight"`
+	OrderId   *uuid.UUID               `db:"order_id"`
 }
 
 func (dto *storagePlaceDTO) ToStoragePlace() *courier.StoragePlace {
-	return courier.RestoreStoragePlace(dto.Id, dto.Name, dto.Volume, dto.OrderId)
+	return courier.RestoreStoragePlace(dto.Id, dto.Name, dto.Type, dto.Volume, dto.MaxWeight, dto.OrderId)
 }
 
 type courierDTO struct {
-	Id            uuid.UUID         `db:"id"`
-	Name          string            `db:"name"`
-	Speed         int               `db:"speed"`
-	LocationX     int               `db:"location_x"`
-	LocationY     int               `db:"location_y"`
-	StoragePlaces []storagePlaceDTO `db:"-"`
+	Id             uuid.UUID         `db:"id"`
+	Name           string            `db:"name"`
+	Speed          int               `db:"speed"`
+	LocationX      int               `db:"location_x"`
+	LocationY      int               `db:"location_y"`
+	LastMovedAt    *time.Time        `db:"last_moved_at"`
+	HomeDepotX     *int              `db:"home_depot_x"`
+	HomeDepotY     *int              `db:"home_depot_y"`
+	Qualifications []string          `db:"qualifications"`
+	StoragePlaces  []storagePlaceDTO `db:"-"`
+
+	LocationReportedAt *time.Time `db:"location_reported_at"`
+	LocationLat        *float64   `db:"location_lat"`
+	LocationLon        *float64   `db:"location_lon"`
+	HomeDepotLat       *float64   `db:"home_depot_lat"`
+	HomeDepotLon       *float64   `db:"home_depot_lon"`
 }
 
 func (dto *courierDTO) ToCourier() *courier.Courier {
-	loc := kernel.RestoreLocation(dto.LocationX, dto.LocationY)
+	loc := restoreLocation(dto.LocationX, dto.LocationY, dto.LocationLat, dto.LocationLon)
 
 	var storagePlaces []*courier.StoragePlace
 	for _, spDTO := range dto.StoragePlaces {
 		storagePlaces = append(storagePlaces, spDTO.ToStoragePlace())
 	}
 
-	return courier.RestoreCourier(dto.Id, dto.Name, dto.Speed, loc, storagePlaces)
+	homeDepot := restoreNullableLocation(dto.HomeDepotX, dto.HomeDepotY, dto.HomeDepotLat, dto.HomeDepotLon)
+	return courier.RestoreCourier(dto.Id, dto.Name, dto.Speed, loc, storagePlaces, dto.LastMovedAt, homeDepot,
+		fromStrings[courier.Qualification](dto.Qualifications), dto.LocationReportedAt)
+}
+
+// toStrings преобразует значения строкового типа для записи в колонку-массив
+func toStrings[T ~string](values []T) []string {
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		result = append(result, string(v))
+	}
+
+	return result
+}
+
+// fromStrings восстанавливает значения строкового типа из колонки-массива
+func fromStrings[T ~string](values []string) []T {
+	result := make([]T, 0, len(values))
+	for _, v := range values {
+		result = append(result, T(v))
+	}
+
+	return result
+}
+
+// nullableLocation возвращает координаты для необязательной точки (nil, nil - точка не задана)
+func nullableLocation(l kernel.Location) (*int, *int) {
+	if l.IsEmpty() {
+		return nil, nil
+	}
+
+	x, y := l.X(), l.Y()
+	return &x, &y
+}
+
+// restoreNullableLocation восстанавливает необязательную точку (пустая точка - координаты не заданы)
+func restoreNullableLocation(x, y *int, lat, lon *float64) kernel.Location {
+	if x == nil || y == nil {
+		return kernel.Location{}
+	}
+
+	return restoreLocation(*x, *y, lat, lon)
+}
+
+// nullableGeoLocation возвращает точные координаты точки (nil, nil - точка задана только клеткой)
+func nullableGeoLocation(l kernel.Location) (*float64, *float64) {
+	if l.Geo().IsEmpty() {
+		return nil, nil
+	}
+
+	lat, lon := l.Geo().Lat(), l.Geo().Lon()
+	return &lat, &lon
+}
+
+// restoreLocation восстанавливает точку вместе с точными координатами, если они сохранены
+func restoreLocation(x, y int, lat, lon *float64) kernel.Location {
+	if lat == nil || lon == nil {
+		return kernel.RestoreLocation(x, y)
+	}
+
+	return kernel.RestoreGeoLocation(x, y, *lat, *lon)
 }
@@ -2,10 +2,12 @@ package postgres
 
 import (
 	"context"
+	"delivery/internal/core/domain/kernel"
 	"delivery/internal/core/domain/model/courier"
 	"delivery/internal/core/ports"
 	"github.com/google/uuid"
 	"testing"
+	"time"
 )
 
 func TestCourierRepository_Get(t *testing.T) {
@@ -98,3 +100,213 @@ func TestCourierRepository_GetAllFree(t *testing.T) {
 	}
 
 }
+
+func TestCourierRepository_GetAll(t *testing.T) {
+
+	ctx, _, uow, err := setupTest(t, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var couriers []*courier.Courier
+	err = uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		couriers, err = uowc.CourierRepository().GetAll(ctx)
+		return err
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// занятые курьеры тоже возвращаются
+	if len(couriers) != 25 {
+		t.Fatalf("expected 25 couriers, got %d", len(couriers))
+	}
+
+	_, found := find(couriers, func(c *courier.Courier) bool {
+		return c.Id().String() == "0234c21c-e521-4f35-a5e3-e0af93c77bb8"
+	})
+
+	if !found {
+		t.Fatal("expected busy courier 0234c21c-e521-4f35-a5e3-e0af93c77bb8 found")
+	}
+}
+
+func TestCourierRepository_SaveLastMovedAt(t *testing.T) {
+
+	ctx, _, uow, err := setupTest(t, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loc, _ := kernel.NewLocation(1, 1)
+	target, _ := kernel.NewLocation(5, 5)
+	c, _ := courier.NewCourier("courier", 2, loc, testIds)
+
+	movedAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	_ = c.Move(target, kernel.NewGridDistanceCalculator(), movedAt, testIds)
+
+	err = uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		return uowc.CourierRepository().Save(ctx, c)
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var saved *courier.Courier
+	err = uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		saved, err = uowc.CourierRepository().Get(ctx, c.Id())
+		return err
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// проверяем данные курьера
+	if saved == nil || saved.LastMovedAt() == nil || !saved.LastMovedAt().Equal(movedAt) {
+		t.Fatal("wrong courier data")
+	}
+}
+
+func TestCourierRepository_SaveHomeDepot(t *testing.T) {
+
+	ctx, _, uow, err := setupTest(t, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loc, _ := kernel.NewLocation(1, 1)
+	depot, _ := kernel.NewLocation(4, 7)
+	withDepot, _ := courier.NewCourier("with depot", 1, loc, testIds)
+	_ = withDepot.SetHomeDepot(depot)
+	withoutDepot, _ := courier.NewCourier("without depot", 1, loc, testIds)
+
+	var savedWithDepot, savedWithoutDepot *courier.Courier
+	err = uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		err := uowc.CourierRepository().Save(ctx, withDepot, withoutDepot)
+		if err != nil {
+			return err
+		}
+
+		savedWithDepot, err = uowc.CourierRepository().Get(ctx, withDepot.Id())
+		if err != nil {
+			return err
+		}
+
+		savedWithoutDepot, err = uowc.CourierRepository().Get(ctx, withoutDepot.Id())
+		return err
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !savedWithDepot.HomeDepot().Equals(depot) || !savedWithoutDepot.HomeDepot().IsEmpty() {
+		t.Fatal("wrong home depot")
+	}
+}
+
+func TestCourierRepository_SaveQualifications(t *testing.T) {
+
+	ctx, _, uow, err := setupTest(t, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loc, _ := kernel.NewLocation(1, 1)
+	qualified, _ := courier.NewCourier("qualified", 1, loc, testIds)
+	_ = qualified.GrantQualification(courier.QualificationAlcohol)
+	_ = qualified.GrantQualification(courier.QualificationMedicine)
+	unqualified, _ := courier.NewCourier("unqualified", 1, loc, testIds)
+
+	err = uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		return uowc.CourierRepository().Save(ctx, qualified, unqualified)
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var savedQualified, savedUnqualified *courier.Courier
+	err = uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		savedQualified, err = uowc.CourierRepository().Get(ctx, qualified.Id())
+		if err != nil {
+			return err
+		}
+
+		savedUnqualified, err = uowc.CourierRepository().Get(ctx, unqualified.Id())
+		return err
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// проверяем допуски курьеров
+	if savedQualified == nil || len(savedQualified.Qualifications()) != 2 ||
+		!savedQualified.HasQualification(courier.QualificationAlcohol) ||
+		!savedQualified.HasQualification(courier.QualificationMedicine) {
+		t.Fatal("wrong qualifications")
+	}
+
+	if savedUnqualified == nil || len(savedUnqualified.Qualifications()) != 0 {
+		t.Fatal("unexpected qualifications")
+	}
+}
+
+func TestCourierRepository_SaveGeoLocation(t *testing.T) {
+
+	ctx, _, uow, err := setupTest(t, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	origin, _ := kernel.NewGeoLocation(55.75, 37.61)
+	grid, _ := kernel.NewGeoGrid(origin, 1)
+	exact, _ := kernel.NewGeoLocation(55.7612, 37.6254)
+	geoLoc, _ := grid.ToLocation(exact)
+	cellLoc, _ := kernel.NewLocation(1, 1)
+	exactDepot, _ := kernel.NewGeoLocation(55.7705, 37.6320)
+	depot, _ := grid.ToLocation(exactDepot)
+	located, _ := courier.NewCourier("located", 15, geoLoc, testIds)
+	_ = located.SetHomeDepot(depot)
+	simulated, _ := courier.NewCourier("simulated", 1, cellLoc, testIds)
+
+	err = uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		return uowc.CourierRepository().Save(ctx, located, simulated)
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var savedLocated, savedSimulated *courier.Courier
+	err = uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		savedLocated, err = uowc.CourierRepository().Get(ctx, located.Id())
+		if err != nil {
+			return err
+		}
+
+		savedSimulated, err = uowc.CourierRepository().Get(ctx, simulated.Id())
+		return err
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// проверяем точные координаты курьеров
+	if savedLocated == nil || !savedLocated.Location().Equals(geoLoc) || !savedLocated.Location().Geo().Equals(exact) {
+		t.Fatal("wrong geo location")
+	}
+
+	if !savedLocated.HomeDepot().Equals(depot) || !savedLocated.HomeDepot().Geo().Equals(exactDepot) {
+		t.Fatal("wrong home depot geo location")
+	}
+
+	if savedSimulated == nil || !savedSimulated.Location().Equals(cellLoc) || !savedSimulated.Location().Geo().IsEmpty() {
+		t.Fatal("unexpected geo location")
+	}
+}
@@ -0,0 +1,92 @@
+package postgres
+
+import (
+	"context"
+	"delivery/internal/core/domain/model/dispatch"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+var _ ports.DispatchDecisionRepository = &dispatchDecisionRepository{}
+
+type dispatchDecisionRepository struct {
+	tx pgx.Tx
+}
+
+func NewDispatchDecisionRepository(tx pgx.Tx) (ports.DispatchDecisionRepository, error) {
+	if tx == nil {
+		return nil, errs.NewValueIsRequiredError("tx")
+	}
+
+	return &dispatchDecisionRepository{
+		tx: tx,
+	}, nil
+}
+
+func (dr *dispatchDecisionRepository) Save(ctx context.Context, decisions ...*dispatch.Decision) error {
+	query := `insert into dispatch_decisions (id, order_id, courier_id, candidates, decided_at)
+			  values ($1, $2, $3, $4, $5)
+			  on conflict (id)
+				 do update set courier_id = EXCLUDED.courier_id,
+							   candidates = EXCLUDED.candidates;`
+
+	for _, d := range decisions {
+		_, err := dr.tx.Exec(ctx, query, d.Id(), d.OrderId(), d.CourierId(),
+			toCandidateDTOs(d.Candidates()), d.DecidedAt())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (dr *dispatchDecisionRepository) GetAllByOrderId(ctx context.Context, orderId uuid.UUID) ([]*dispatch.Decision, error) {
+	query := `select id, order_id, courier_id, candidates, decided_at
+			  from dispatch_decisions
+			  where order_id = $1
+			  order by decided_at, id`
+
+	rows, err := dr.tx.Query(ctx, query, orderId)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos, err := pgx.CollectRows(rows, pgx.RowToStructByName[dispatchDecisionDTO])
+	if err != nil {
+		return nil, err
+	}
+
+	decisions := make([]*dispatch.Decision, 0, len(dtos))
+	for _, dto := range dtos {
+		decisions = append(decisions, dto.ToDecision())
+	}
+
+	return decisions, nil
+}
+
+func (dr *dispatchDecisionRepository) GetLastByOrderId(ctx context.Context, orderId uuid.UUID) (*dispatch.Decision, error) {
+	query := `select id, order_id, courier_id, candidates, decided_at
+			  from dispatch_decisions
+			  where order_id = $1
+			  order by decided_at desc, id desc
+			  limit 1`
+
+	rows, err := dr.tx.Query(ctx, query, orderId)
+	if err != nil {
+		return nil, err
+	}
+
+	dto, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[dispatchDecisionDTO])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil // not found (no error here)
+		}
+		return nil, err
+	}
+
+	return dto.ToDecision(), nil
+}
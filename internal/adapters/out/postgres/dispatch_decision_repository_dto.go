@@ -0,0 +1,52 @@
+package postgres
+
+import (
+	"delivery/internal/core/domain/model/dispatch"
+	"github.com/google/uuid"
+	"time"
+)
+
+type candidateDTO struct {
+	CourierId            uuid.UUID `json:"courier_id"`
+	CourierName          string    `json:"courier_name"`
+	Eta                  float64   `json:"eta"`
+	RejectionReason      string    `json:"rejection_reason,omitempty"`
+	MissingQualification string    `json:"missing_qualification,omitempty"`
+}
+
+func (dto *candidateDTO) ToCandidate() dispatch.Candidate {
+	return dispatch.RestoreCandidate(dto.CourierId, dto.CourierName, dto.Eta, dto.RejectionReason,
+		dto.MissingQualification)
+}
+
+type dispatchDecisionDTO struct {
+	Id         uuid.UUID      `db:"id"`
+	OrderId    uuid.UUID      `db:"order_id"`
+	CourierId  *uuid.UUID     `db:"courier_id"`
+	Candidates []candidateDTO `db:"candidates"`
+	DecidedAt  time.Time      `db:"decided_at"`
+}
+
+func (dto *dispatchDecisionDTO) ToDecision() *dispatch.Decision {
+	candidates := make([]dispatch.Candidate, 0, len(dto.Candidates))
+	for _, cDTO := range dto.Candidates {
+		candidates = append(candidates, cDTO.ToCandidate())
+	}
+
+	return dispatch.RestoreDecision(dto.Id, dto.OrderId, dto.CourierId, candidates, dto.DecidedAt)
+}
+
+func toCandidateDTOs(candidates []dispatch.Candidate) []candidateDTO {
+	dtos := make([]candidateDTO, 0, len(candidates))
+	for _, c := range candidates {
+		dtos = append(dtos, candidateDTO{
+			CourierId:            c.CourierId(),
+			CourierName:          c.CourierName(),
+			Eta:                  c.Eta(),
+			RejectionReason:      c.RejectionReason(),
+			MissingQualification: c.MissingQualification(),
+		})
+	}
+
+	return dtos
+}
@@ -0,0 +1,115 @@
+package postgres
+
+import (
+	"context"
+	"delivery/internal/core/domain/model/dispatch"
+	"delivery/internal/core/ports"
+	"errors"
+	"github.com/google/uuid"
+	"testing"
+	"time"
+)
+
+func TestDispatchDecisionRepository_SaveAndGetAllByOrderId(t *testing.T) {
+	ctx, _, uow, err := setupTest(t, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	orderId := uuid.New()
+
+	// решение без подходящего курьера
+	rejected, _ := dispatch.NewDecision(orderId, testIds, testClock)
+	c, _ := dispatch.NewCandidate(uuid.New(), "courier1", 3, "storage place not found", "")
+	_ = rejected.AddCandidate(c)
+
+	// решение с выбранным курьером
+	chosen, _ := dispatch.NewDecision(orderId, testIds, testClock)
+	c, _ = dispatch.NewCandidate(uuid.New(), "courier2", 1.5, "", "")
+	_ = chosen.AddCandidate(c)
+	_ = chosen.ChooseCourier(c.CourierId())
+
+	// решение по другому заказу
+	other, _ := dispatch.NewDecision(uuid.New(), testIds, testClock)
+
+	err = uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		return uowc.DispatchDecisionRepository().Save(ctx, rejected, chosen, other)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decisions []*dispatch.Decision
+	err = uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		decisions, err = uowc.DispatchDecisionRepository().GetAllByOrderId(ctx, orderId)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(decisions) != 2 {
+		t.Fatal("expected 2 decisions")
+	}
+
+	// проверяем данные
+	d, found := find(decisions, func(d *dispatch.Decision) bool { return d.Equals(chosen) })
+	if !found {
+		t.Fatal("expected decision with chosen courier found")
+	}
+
+	if d.CourierId() == nil || *d.CourierId() != c.CourierId() ||
+		len(d.Candidates()) != 1 ||
+		d.Candidates()[0].CourierName() != "courier2" ||
+		d.Candidates()[0].Eta() != 1.5 ||
+		!d.Candidates()[0].IsEligible() {
+		t.Fatal("wrong decision data")
+	}
+
+	d, found = find(decisions, func(d *dispatch.Decision) bool { return d.Equals(rejected) })
+	if !found {
+		t.Fatal("expected decision without courier found")
+	}
+
+	if d.CourierId() != nil ||
+		len(d.Candidates()) != 1 ||
+		d.Candidates()[0].RejectionReason() != "storage place not found" {
+		t.Fatal("wrong decision data")
+	}
+}
+
+func TestDispatchDecisionRepository_GetLastByOrderId(t *testing.T) {
+	ctx, _, uow, err := setupTest(t, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	orderId := uuid.New()
+	decidedAt := time.Now().UTC().Truncate(time.Microsecond)
+
+	first := dispatch.RestoreDecision(uuid.New(), orderId, nil, nil, decidedAt)
+	last := dispatch.RestoreDecision(uuid.New(), orderId, nil, nil, decidedAt.Add(time.Second))
+
+	var found *dispatch.Decision
+	err = uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		found, err = uowc.DispatchDecisionRepository().GetLastByOrderId(ctx, orderId)
+		if err != nil || found != nil {
+			return errors.New("expected no decision for new order")
+		}
+
+		err = uowc.DispatchDecisionRepository().Save(ctx, last, first)
+		if err != nil {
+			return err
+		}
+
+		found, err = uowc.DispatchDecisionRepository().GetLastByOrderId(ctx, orderId)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !last.Equals(found) {
+		t.Fatal("expected latest decision")
+	}
+}
@@ -30,14 +30,39 @@ func NewOrderRepository(tx pgx.Tx) (ports.OrderRepository, error) {
 
 func (or *orderRepository) Save(ctx context.Context, orders ...*order.Order) error {
 
-	query := `insert into orders (id, courier_id, location_x, location_y, volume, status)
-			  values ($1, $2, $3, $4, $5, $6)
+	query := `insert into orders (id, courier_id, location_x, location_y, volume, status, priority, created_at,
+			                    dispatch_attempts, pickup_location_x, pickup_location_y, confirmation_code,
+			                    delivery_attempts, return_location_x, return_location_y, retry_delivery, weight,
+			                    handling, restrictions, parent_id, assigned_by, location_lat, location_lon,
+			                    pickup_location_lat, pickup_location_lon, return_location_lat, return_location_lon)
+			  values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21,
+			          $22, $23, $24, $25, $26, $27)
 			  on conflict (id)
-				 do update set courier_id = EXCLUDED.courier_id,
-							   location_x = EXCLUDED.location_x,
-							   location_y = EXCLUDED.location_y,
-							   volume     = EXCLUDED.volume,
-							   status     = EXCLUDED.status;`
+				 do update set courier_id        = EXCLUDED.courier_id,
+							   location_x        = EXCLUDED.location_x,
+							   location_y        = EXCLUDED.location_y,
+							   volume            = EXCLUDED.volume,
+							   status            = EXCLUDED.status,
+							   priority          = EXCLUDED.priority,
+							   dispatch_attempts = EXCLUDED.dispatch_attempts,
+							   pickup_location_x = EXCLUDED.pickup_location_x,
+							   pickup_location_y = EXCLUDED.pickup_location_y,
+							   confirmation_code = EXCLUDED.confirmation_code,
+							   delivery_attempts = EXCLUDED.delivery_attempts,
+							   return_location_x = EXCLUDED.return_location_x,
+							   return_location_y = EXCLUDED.return_location_y,
+							   retry_delivery    = EXCLUDED.retry_delivery,
+							   weight            = EXCLUDED.weight,
+							   handling          = EXCLUDED.handling,
+							   restrictions      = EXCLUDED.restrictions,
+							   parent_id         = EXCLUDED.parent_id,
+							   assigned_by       = EXCLUDED.assigned_by,
+							   location_lat      = EXCLUDED.location_lat,
+							   location_lon      = EXCLUDED.location_lon,
+							   pickup_location_lat = EXCLUDED.pickup_location_lat,
+							   pickup_location_lon = EXCLUDED.pickup_location_lon,
+							   return_location_lat = EXCLUDED.return_location_lat,
+							   return_location_lon = EXCLUDED.return_location_lon;`
 
 	outboxQuery := `insert into outbox(id, type, content, occurred)
                     values ($1, $2, $3, $4)
@@ -46,8 +71,15 @@ func (or *orderRepository) Save(ctx context.Context, orders ...*order.Order) err
 	for _, o := range orders {
 
 		// save aggregate
+		pickupX, pickupY := nullableLocation(o.PickupLocation())
+		returnX, returnY := nullableLocation(o.ReturnLocation())
+		lat, lon := nullableGeoLocation(o.Location())
+		pickupLat, pickupLon := nullableGeoLocation(o.PickupLocation())
+		returnLat, returnLon := nullableGeoLocation(o.ReturnLocation())
 		_, err := or.tx.Exec(ctx, query, o.Id(), o.CourierId(), o.Location().X(), o.Location().Y(),
-			o.Volume(), o.Status())
+			o.Volume(), o.Status(), o.Priority(), o.CreatedAt(), o.DispatchAttempts(), pickupX, pickupY,
+			o.ConfirmationCode(), o.DeliveryAttempts(), returnX, returnY, o.RetryDelivery(), o.Weight(), o.Handling(),
+			toStrings(o.Restrictions()), o.ParentId(), o.AssignedBy(), lat, lon, pickupLat, pickupLon, returnLat, returnLon)
 
 		if err != nil {
 			return err
@@ -76,13 +108,22 @@ func (or *orderRepository) Save(ctx context.Context, orders ...*order.Order) err
 
 func (or *orderRepository) Get(ctx context.Context, id uuid.UUID) (*order.Order, error) {
 
-	query := `select id, courier_id, location_x, location_y, volume, status
+	query := `select id, courier_id, location_x, location_y, volume, status, priority, created_at,
+			         dispatch_attempts, pickup_location_x, pickup_location_y, confirmation_code, delivery_attempts,
+			         return_location_x, return_location_y, retry_delivery, weight, handling, restrictions, parent_id,
+			         assigned_by, location_lat, location_lon, pickup_location_lat, pickup_location_lon,
+			         return_location_lat, return_location_lon
 			  from orders
 			  where id = $1`
 
 	var dto = orderDTO{}
 	err := or.tx.QueryRow(ctx, query, id).
-		Scan(&dto.Id, &dto.CourierId, &dto.LocationX, &dto.LocationY, &dto.Volume, &dto.Status)
+		Scan(&dto.Id, &dto.CourierId, &dto.LocationX, &dto.LocationY, &dto.Volume, &dto.Status,
+			&dto.Priority, &dto.CreatedAt, &dto.DispatchAttempts, &dto.PickupLocationX, &dto.PickupLocationY,
+			&dto.ConfirmationCode, &dto.DeliveryAttempts, &dto.ReturnLocationX, &dto.ReturnLocationY, &dto.RetryDelivery,
+			&dto.Weight, &dto.Handling, &dto.Restrictions, &dto.ParentId, &dto.AssignedBy,
+			&dto.LocationLat, &dto.LocationLon, &dto.PickupLocationLat, &dto.PickupLocationLon,
+			&dto.ReturnLocationLat, &dto.ReturnLocationLon)
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -96,33 +137,76 @@ func (or *orderRepository) Get(ctx context.Context, id uuid.UUID) (*order.Order,
 }
 
 func (or *orderRepository) GetFirstInCreatedStatus(ctx context.Context) (*order.Order, error) {
+	orders, err := or.GetAllInCreatedStatus(ctx, 1)
+	if err != nil || len(orders) == 0 {
+		return nil, err // not found (no error here)
+	}
+
+	return orders[0], nil
+}
 
-	query := fmt.Sprintf(`select id, courier_id, location_x, location_y, volume, status
+func (or *orderRepository) GetAllInCreatedStatus(ctx context.Context, limit int) ([]*order.Order, error) {
+
+	// Заказы назначаются в порядке поступления, срочные получают фору. Обычный заказ,
+	// прождавший дольше форы, обгоняет новые срочные, поэтому без курьера он не останется
+	query := fmt.Sprintf(`select id, courier_id, location_x, location_y, volume, status, priority, created_at,
+                                 dispatch_attempts, pickup_location_x, pickup_location_y, confirmation_code, delivery_attempts,
+                                 return_location_x, return_location_y, retry_delivery, weight, handling, restrictions, parent_id,
+                                 assigned_by, location_lat, location_lon, pickup_location_lat, pickup_location_lon,
+			         return_location_lat, return_location_lon
 						    	from orders
 							    where status = '%s'
-							    limit 1`, order.StatusCreated)
+							    order by created_at - case priority
+							                              when '%s' then make_interval(secs => $1)
+							                              else interval '0'
+							                          end,
+							             created_at,
+							             id
+							    limit $2`, order.StatusCreated, order.PriorityExpress)
+
+	rows, err := or.tx.Query(ctx, query, order.PriorityExpress.HeadStart().Seconds(), limit)
+	if err != nil {
+		return nil, err
+	}
 
-	var dto = orderDTO{}
-	err := or.tx.QueryRow(ctx, query).
-		Scan(&dto.Id, &dto.CourierId, &dto.LocationX, &dto.LocationY, &dto.Volume, &dto.Status)
+	//goland:noinspection GoUnhandledErrorResult
+	defer rows.Close()
 
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, nil // not found (no error here)
-		} else {
+	orders := make([]*order.Order, 0, limit)
+	for rows.Next() {
+
+		var dto = orderDTO{}
+		err = rows.Scan(&dto.Id, &dto.CourierId, &dto.LocationX, &dto.LocationY, &dto.Volume, &dto.Status,
+			&dto.Priority, &dto.CreatedAt, &dto.DispatchAttempts, &dto.PickupLocationX, &dto.PickupLocationY,
+			&dto.ConfirmationCode, &dto.DeliveryAttempts, &dto.ReturnLocationX, &dto.ReturnLocationY, &dto.RetryDelivery,
+			&dto.Weight, &dto.Handling, &dto.Restrictions, &dto.ParentId, &dto.AssignedBy,
+			&dto.LocationLat, &dto.LocationLon, &dto.PickupLocationLat, &dto.PickupLocationLon,
+			&dto.ReturnLocationLat, &dto.ReturnLocationLon)
+		if err != nil {
 			return nil, err
 		}
+
+		orders = append(orders, dto.ToOrder())
 	}
 
-	return dto.ToOrder(), nil
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return orders, nil
 }
 
 func (or *orderRepository) GetAllInAssignedStatus(ctx context.Context) ([]*order.Order, error) {
 
-	query := fmt.Sprintf(`select id, courier_id, location_x, location_y, volume, status
+	query := fmt.Sprintf(`select id, courier_id, location_x, location_y, volume, status, priority, created_at,
+			                           dispatch_attempts, pickup_location_x, pickup_location_y, confirmation_code, delivery_attempts,
+			                           return_location_x, return_location_y, retry_delivery, weight, handling, restrictions, parent_id,
+			                           assigned_by, location_lat, location_lon, pickup_location_lat, pickup_location_lon,
+			         return_location_lat, return_location_lon
 			                    from orders
-			                    where status = '%s'
-                                order by id`, order.StatusAssigned)
+			                    where status in ('%s', '%s', '%s', '%s')
+                                order by id`, order.StatusAssigned, order.StatusAssignedToPickup, order.StatusPickedUp,
+		order.StatusDeliveryFailed)
 
 	rows, err := or.tx.Query(ctx, query)
 	if err != nil {
@@ -136,7 +220,99 @@ func (or *orderRepository) GetAllInAssignedStatus(ctx context.Context) ([]*order
 	for rows.Next() {
 
 		var dto = orderDTO{}
-		err = rows.Scan(&dto.Id, &dto.CourierId, &dto.LocationX, &dto.LocationY, &dto.Volume, &dto.Status)
+		err = rows.Scan(&dto.Id, &dto.CourierId, &dto.LocationX, &dto.LocationY, &dto.Volume, &dto.Status,
+			&dto.Priority, &dto.CreatedAt, &dto.DispatchAttempts, &dto.PickupLocationX, &dto.PickupLocationY,
+			&dto.ConfirmationCode, &dto.DeliveryAttempts, &dto.ReturnLocationX, &dto.ReturnLocationY, &dto.RetryDelivery,
+			&dto.Weight, &dto.Handling, &dto.Restrictions, &dto.ParentId, &dto.AssignedBy,
+			&dto.LocationLat, &dto.LocationLon, &dto.PickupLocationLat, &dto.PickupLocationLon,
+			&dto.ReturnLocationLat, &dto.ReturnLocationLon)
+		if err != nil {
+			return nil, err
+		}
+
+		orders = append(orders, dto.ToOrder())
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return orders, nil
+}
+
+func (or *orderRepository) GetActiveByCourier(ctx context.Context, courierId uuid.UUID) ([]*order.Order, error) {
+
+	query := fmt.Sprintf(`select id, courier_id, location_x, location_y, volume, status, priority, created_at,
+			                           dispatch_attempts, pickup_location_x, pickup_location_y, confirmation_code, delivery_attempts,
+			                           return_location_x, return_location_y, retry_delivery, weight, handling, restrictions, parent_id,
+			                           assigned_by, location_lat, location_lon, pickup_location_lat, pickup_location_lon,
+			         return_location_lat, return_location_lon
+			                    from orders
+			                    where courier_id = $1 and status in ('%s', '%s', '%s', '%s')
+                                order by id`, order.StatusAssigned, order.StatusAssignedToPickup, order.StatusPickedUp,
+		order.StatusDeliveryFailed)
+
+	rows, err := or.tx.Query(ctx, query, courierId)
+	if err != nil {
+		return nil, err
+	}
+
+	//goland:noinspection GoUnhandledErrorResult
+	defer rows.Close()
+
+	orders := make([]*order.Order, 0)
+	for rows.Next() {
+
+		var dto = orderDTO{}
+		err = rows.Scan(&dto.Id, &dto.CourierId, &dto.LocationX, &dto.LocationY, &dto.Volume, &dto.Status,
+			&dto.Priority, &dto.CreatedAt, &dto.DispatchAttempts, &dto.PickupLocationX, &dto.PickupLocationY,
+			&dto.ConfirmationCode, &dto.DeliveryAttempts, &dto.ReturnLocationX, &dto.ReturnLocationY, &dto.RetryDelivery,
+			&dto.Weight, &dto.Handling, &dto.Restrictions, &dto.ParentId, &dto.AssignedBy,
+			&dto.LocationLat, &dto.LocationLon, &dto.PickupLocationLat, &dto.PickupLocationLon,
+			&dto.ReturnLocationLat, &dto.ReturnLocationLon)
+		if err != nil {
+			return nil, err
+		}
+
+		orders = append(orders, dto.ToOrder())
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return orders, nil
+}
+
+func (or *orderRepository) GetShipments(ctx context.Context, parentId uuid.UUID) ([]*order.Order, error) {
+
+	query := `select id, courier_id, location_x, location_y, volume, status, priority, created_at,
+			         dispatch_attempts, pickup_location_x, pickup_location_y, confirmation_code, delivery_attempts,
+			         return_location_x, return_location_y, retry_delivery, weight, handling, restrictions, parent_id,
+			         assigned_by, location_lat, location_lon, pickup_location_lat, pickup_location_lon,
+			         return_location_lat, return_location_lon
+			  from orders
+			  where parent_id = $1
+			  order by id`
+
+	rows, err := or.tx.Query(ctx, query, parentId)
+	if err != nil {
+		return nil, err
+	}
+
+	//goland:noinspection GoUnhandledErrorResult
+	defer rows.Close()
+
+	orders := make([]*order.Order, 0)
+	for rows.Next() {
+
+		var dto = orderDTO{}
+		err = rows.Scan(&dto.Id, &dto.CourierId, &dto.LocationX, &dto.LocationY, &dto.Volume, &dto.Status,
+			&dto.Priority, &dto.CreatedAt, &dto.DispatchAttempts, &dto.PickupLocationX, &dto.PickupLocationY,
+			&dto.ConfirmationCode, &dto.DeliveryAttempts, &dto.ReturnLocationX, &dto.ReturnLocationY, &dto.RetryDelivery,
+			&dto.Weight, &dto.Handling, &dto.Restrictions, &dto.ParentId, &dto.AssignedBy,
+			&dto.LocationLat, &dto.LocationLon, &dto.PickupLocationLat, &dto.PickupLocationLon,
+			&dto.ReturnLocationLat, &dto.ReturnLocationLon)
 		if err != nil {
 			return nil, err
 		}
@@ -1,21 +1,50 @@
 package postgres
 
 import (
-	"delivery/internal/core/domain/kernel"
 	"delivery/internal/core/domain/model/order"
 	"github.com/google/uuid"
+	"time"
 )
 
 type orderDTO struct {
-	Id        uuid.UUID    `db:"id"`
-	CourierId *uuid.UUID   `db:"courier_id"`
-	LocationX int          `db:"location_x"`
-	LocationY int          `db:"location_y"`
-	Volume    int          `db:"volume"`
-	Status    order.Status `db:"status"`
+	Id               uuid.UUID      `db:"id"`
+	CourierId        *uuid.UUID     `db:"courier_id"`
+	PickupLocationX  *int           `db:"pickup_location_x"`
+	PickupLocationY  *int           `db:"pickup_location_y"`
+	LocationX        int            `db:"location_x"`
+	LocationY        int            `db:"location_y"`
+	Volume           int            `db:"volume"`
+	Weight           int            `db:"weight"`
+	Handling         order.Handling `db:"handling"`
+	Restrictions     []string       `db:"restrictions"`
+	Status           order.Status   `db:"status"`
+	Priority         order.Priority `db:"priority"`
+	CreatedAt        time.Time      `db:"created_at"`
+	DispatchAttempts int            `db:"dispatch_attempts"`
+	ConfirmationCode string         `db:"confirmation_code"`
+	DeliveryAttempts int            `db:"delivery_attempts"`
+	ReturnLocationX  *int           `db:"return_location_x"`
+	ReturnLocationY  *int           `db:"return_location_y"`
+	RetryDelivery    bool           `db:"retry_delivery"`
+	ParentId         *uuid.UUID     `db:"parent_id"`
+	AssignedBy       string         `db:"assigned_by"`
+	LocationLat      *float64       `db:"location_lat"`
+	LocationLon      *float64       `db:"location_lon"`
+
+	PickupLocationLat *float64 `db:"pickup_location_lat"`
+	PickupLocationLon *float64 `db:"pickup_location_lon"`
+	ReturnLocationLat *float64 `db:"return_location_lat"`
+	ReturnLocationLon *float64 `db:"return_location_lon"`
 }
 
 func (dto *orderDTO) ToOrder() *order.Order {
-	loc := kernel.RestoreLocation(dto.LocationX, dto.LocationY)
-	return order.RestoreOrder(dto.Id, dto.CourierId, loc, dto.Volume, dto.Status)
+	loc := restoreLocation(dto.LocationX, dto.LocationY, dto.LocationLat, dto.LocationLon)
+	pickup := restoreNullableLocation(dto.PickupLocationX, dto.PickupLocationY, dto.PickupLocationLat,
+		dto.PickupLocationLon)
+	returnTo := restoreNullableLocation(dto.ReturnLocationX, dto.ReturnLocationY, dto.ReturnLocationLat,
+		dto.ReturnLocationLon)
+	return order.RestoreOrder(dto.Id, dto.CourierId, pickup, loc, dto.Volume, dto.Weight, dto.Handling,
+		fromStrings[order.Restriction](dto.Restrictions), dto.Status, dto.Priority, dto.CreatedAt,
+		dto.DispatchAttempts, dto.ConfirmationCode, dto.DeliveryAttempts,
+		returnTo, dto.RetryDelivery, dto.ParentId, dto.AssignedBy)
 }
@@ -2,10 +2,13 @@ package postgres
 
 import (
 	"context"
+	"delivery/internal/core/domain/kernel"
 	"delivery/internal/core/domain/model/order"
 	"delivery/internal/core/ports"
 	"github.com/google/uuid"
+	"slices"
 	"testing"
+	"time"
 )
 
 func TestOrderRepository_Get(t *testing.T) {
@@ -109,3 +112,374 @@ func TestOrderRepository_GetAllInAssignedStatus(t *testing.T) {
 	}
 
 }
+
+func TestOrderRepository_GetActiveByCourier(t *testing.T) {
+
+	ctx, _, uow, err := setupTest(t, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	courierId := uuid.MustParse("dfaf5777-1ae4-4688-a23e-d7e2cb94619a")
+
+	var active []*order.Order
+	err = uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		active, err = uowc.OrderRepository().GetActiveByCourier(ctx, courierId)
+		return err
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// проверяем данные: у курьера два заказа в работе
+	if len(active) != 2 ||
+		active[0].Id() != uuid.MustParse("b5261984-e50b-465e-bd65-4b2c578ad130") ||
+		active[1].Id() != uuid.MustParse("eb2f9997-24cb-486e-ad52-19f86f73eace") {
+		t.Fatal("wrong active orders")
+	}
+}
+
+func TestOrderRepository_SaveDispatchAttempts(t *testing.T) {
+
+	ctx, _, uow, err := setupTest(t, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loc, _ := kernel.NewLocation(1, 1)
+	o, _ := order.NewOrder(uuid.New(), loc, 5, 0, order.PriorityStandard, testIds, testClock)
+	_ = o.FailDispatch(2, "no matching courier", testIds, testClock)
+	_ = o.FailDispatch(2, "no matching courier", testIds, testClock)
+
+	err = uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		return uowc.OrderRepository().Save(ctx, o)
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var saved *order.Order
+	err = uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		saved, err = uowc.OrderRepository().Get(ctx, o.Id())
+		return err
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// проверяем данные заказа
+	if saved == nil || saved.DispatchAttempts() != 2 || saved.Status() != order.StatusUndeliverable {
+		t.Fatal("wrong order data")
+	}
+}
+
+func TestOrderRepository_SavePickupLocation(t *testing.T) {
+
+	ctx, _, uow, err := setupTest(t, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pickup, _ := kernel.NewLocation(2, 2)
+	dropoff, _ := kernel.NewLocation(8, 8)
+	o, _ := order.NewPickupOrder(uuid.New(), pickup, dropoff, 5, 0, order.PriorityStandard, testIds, testClock)
+	_ = o.AssignCourier(uuid.New(), testIds, testClock)
+
+	var assigned []*order.Order
+	err = uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		err := uowc.OrderRepository().Save(ctx, o)
+		if err != nil {
+			return err
+		}
+
+		assigned, err = uowc.OrderRepository().GetAllInAssignedStatus(ctx)
+		return err
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// проверяем данные заказа
+	if len(assigned) != 1 || assigned[0].Status() != order.StatusAssignedToPickup ||
+		!assigned[0].PickupLocation().Equals(pickup) || !assigned[0].Location().Equals(dropoff) {
+		t.Fatal("wrong order data")
+	}
+}
+
+func TestOrderRepository_SaveConfirmationCode(t *testing.T) {
+
+	ctx, _, uow, err := setupTest(t, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loc, _ := kernel.NewLocation(1, 1)
+	o, _ := order.NewOrder(uuid.New(), loc, 5, 0, order.PriorityStandard, testIds, testClock)
+	_ = o.RequireConfirmation(testRnd)
+	_ = o.AssignCourier(uuid.New(), testIds, testClock)
+	_, _ = o.ConfirmDelivery("wrong", 3, loc, testIds, testClock)
+
+	err = uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		return uowc.OrderRepository().Save(ctx, o)
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var saved *order.Order
+	err = uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		saved, err = uowc.OrderRepository().Get(ctx, o.Id())
+		return err
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// проверяем данные заказа
+	if saved == nil || saved.ConfirmationCode() != o.ConfirmationCode() || saved.DeliveryAttempts() != 1 {
+		t.Fatal("wrong order data")
+	}
+}
+
+func TestOrderRepository_SaveRestrictions(t *testing.T) {
+
+	ctx, _, uow, err := setupTest(t, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loc, _ := kernel.NewLocation(1, 1)
+	restricted, _ := order.NewOrder(uuid.New(), loc, 5, 0, order.PriorityStandard, testIds, testClock)
+	_ = restricted.AddRestriction(order.RestrictionAlcohol)
+	_ = restricted.AddRestriction(order.RestrictionMedicine)
+	unrestricted, _ := order.NewOrder(uuid.New(), loc, 5, 0, order.PriorityStandard, testIds, testClock)
+
+	err = uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		return uowc.OrderRepository().Save(ctx, restricted, unrestricted)
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var savedRestricted, savedUnrestricted *order.Order
+	err = uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		savedRestricted, err = uowc.OrderRepository().Get(ctx, restricted.Id())
+		if err != nil {
+			return err
+		}
+
+		savedUnrestricted, err = uowc.OrderRepository().Get(ctx, unrestricted.Id())
+		return err
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// проверяем ограничения заказов
+	if savedRestricted == nil || !slices.Equal(savedRestricted.Restrictions(),
+		[]order.Restriction{order.RestrictionAlcohol, order.RestrictionMedicine}) {
+		t.Fatal("wrong restrictions")
+	}
+
+	if savedUnrestricted == nil || len(savedUnrestricted.Restrictions()) != 0 {
+		t.Fatal("unexpected restrictions")
+	}
+}
+
+func TestOrderRepository_SaveDeliveryFailed(t *testing.T) {
+
+	ctx, _, uow, err := setupTest(t, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loc, _ := kernel.NewLocation(1, 1)
+	depot, _ := kernel.NewLocation(9, 9)
+	o, _ := order.NewOrder(uuid.New(), loc, 5, 0, order.PriorityStandard, testIds, testClock)
+	_ = o.AssignCourier(uuid.New(), testIds, testClock)
+	_ = o.FailDelivery("customer not home", depot, true, testIds, testClock)
+
+	var assigned []*order.Order
+	err = uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		err := uowc.OrderRepository().Save(ctx, o)
+		if err != nil {
+			return err
+		}
+
+		assigned, err = uowc.OrderRepository().GetAllInAssignedStatus(ctx)
+		return err
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// проверяем данные заказа: курьер везет товар на склад
+	if len(assigned) != 1 || assigned[0].Status() != order.StatusDeliveryFailed ||
+		!assigned[0].ReturnLocation().Equals(depot) || !assigned[0].RetryDelivery() {
+		t.Fatal("wrong order data")
+	}
+}
+
+func TestOrderRepository_GetFirstInCreatedStatus_Priority(t *testing.T) {
+
+	ctx, db, uow, err := setupTest(t, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loc, _ := kernel.NewLocation(1, 1)
+	oldStandard, _ := order.NewOrder(uuid.New(), loc, 5, 0, order.PriorityStandard, testIds, testClock)
+	newStandard, _ := order.NewOrder(uuid.New(), loc, 5, 0, order.PriorityStandard, testIds, testClock)
+	express, _ := order.NewOrder(uuid.New(), loc, 5, 0, order.PriorityExpress, testIds, testClock)
+
+	err = uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		return uowc.OrderRepository().Save(ctx, newStandard, express, oldStandard)
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	setCreatedAt := func(o *order.Order, createdAt time.Time) {
+		_, err := db.Exec(ctx, "update orders set created_at = $1 where id = $2", createdAt, o.Id())
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	getFirst := func() *order.Order {
+		var o *order.Order
+		err := uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+			o, err = uowc.OrderRepository().GetFirstInCreatedStatus(ctx)
+			return err
+		})
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return o
+	}
+
+	now := time.Now().UTC()
+
+	// срочный заказ обгоняет обычные, прождавшие меньше его форы
+	setCreatedAt(oldStandard, now.Add(-time.Minute))
+	setCreatedAt(newStandard, now.Add(-time.Second))
+	setCreatedAt(express, now)
+
+	if o := getFirst(); o == nil || !o.Equals(express) {
+		t.Fatal("expected express order first")
+	}
+
+	// обычный заказ, прождавший дольше форы, назначается раньше срочного
+	setCreatedAt(oldStandard, now.Add(-order.PriorityExpress.HeadStart()-time.Minute))
+
+	if o := getFirst(); o == nil || !o.Equals(oldStandard) || o.Priority() != order.PriorityStandard {
+		t.Fatal("expected old standard order first")
+	}
+}
+
+func TestOrderRepository_GetShipments(t *testing.T) {
+
+	ctx, _, uow, err := setupTest(t, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loc, _ := kernel.NewLocation(1, 1)
+	o, _ := order.NewOrder(uuid.New(), loc, 6, 0, order.PriorityStandard, testIds, testClock)
+	item, _ := order.NewItem(3, 0, order.HandlingStandard)
+	shipments, _ := o.Split([][]order.Item{{item}, {item}}, testIds, testClock)
+
+	var parent *order.Order
+	var found []*order.Order
+	err = uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		err := uowc.OrderRepository().Save(ctx, append([]*order.Order{o}, shipments...)...)
+		if err != nil {
+			return err
+		}
+
+		parent, err = uowc.OrderRepository().Get(ctx, o.Id())
+		if err != nil {
+			return err
+		}
+
+		found, err = uowc.OrderRepository().GetShipments(ctx, o.Id())
+		return err
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// проверяем данные: заказ разделен, отправления ссылаются на него
+	if parent == nil || parent.Status() != order.StatusSplit || parent.IsShipment() {
+		t.Fatal("wrong split order data")
+	}
+
+	if len(found) != 2 || found[0].ParentId() == nil || *found[0].ParentId() != o.Id() || found[0].Volume() != 3 {
+		t.Fatal("wrong shipments data")
+	}
+}
+
+func TestOrderRepository_SaveGeoLocation(t *testing.T) {
+
+	ctx, _, uow, err := setupTest(t, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	origin, _ := kernel.NewGeoLocation(55.75, 37.61)
+	grid, _ := kernel.NewGeoGrid(origin, 1)
+	exact, _ := kernel.NewGeoLocation(55.7612, 37.6254)
+	loc, _ := grid.ToLocation(exact)
+	exactShop, _ := kernel.NewGeoLocation(55.7531, 37.6187)
+	shop, _ := grid.ToLocation(exactShop)
+	exactDepot, _ := kernel.NewGeoLocation(55.7705, 37.6320)
+	depot, _ := grid.ToLocation(exactDepot)
+	o, _ := order.NewPickupOrder(uuid.New(), shop, loc, 5, 0, order.PriorityStandard, testIds, testClock)
+	_ = o.AssignCourier(uuid.New(), testIds, testClock)
+	_ = o.PickUp(testIds, testClock)
+	_ = o.FailDelivery("customer not home", depot, true, testIds, testClock)
+
+	err = uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		return uowc.OrderRepository().Save(ctx, o)
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var saved *order.Order
+	err = uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		saved, err = uowc.OrderRepository().Get(ctx, o.Id())
+		return err
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// проверяем точные координаты адреса, магазина и склада
+	if saved == nil || !saved.Location().Equals(loc) || !saved.Location().Geo().Equals(exact) {
+		t.Fatal("wrong geo location")
+	}
+
+	if !saved.PickupLocation().Equals(shop) || !saved.PickupLocation().Geo().Equals(exactShop) {
+		t.Fatal("wrong pickup geo location")
+	}
+
+	if !saved.ReturnLocation().Equals(depot) || !saved.ReturnLocation().Geo().Equals(exactDepot) {
+		t.Fatal("wrong return geo location")
+	}
+}
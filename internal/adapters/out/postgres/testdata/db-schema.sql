@@ -1,12 +1,21 @@
 create table couriers
 (
-    id         uuid
+    id            uuid
         constraint couriers_pk
             primary key,
-    name       varchar(255) not null,
-    speed      int          not null,
-    location_x int          not null,
-    location_y int          not null
+    name          varchar(255)             not null,
+    speed         int                      not null,
+    location_x    int                      not null,
+    location_y    int                      not null,
+    last_moved_at TIMESTAMP with time zone null,
+    home_depot_x   int                      null,
+    home_depot_y   int                      null,
+    qualifications varchar(32)[]            not null default '{}',
+    location_reported_at TIMESTAMP with time zone null,
+    location_lat         double precision         null,
+    location_lon         double precision         null,
+    home_depot_lat       double precision         null,
+    home_depot_lon       double precision         null
 );
 
 create table orders
@@ -17,8 +26,29 @@ create table orders
     courier_id uuid        null,
     location_x integer     not null,
     location_y integer     not null,
-    volume     integer     not null,
-    status     varchar(32) not null
+    volume            integer     not null,
+    status            varchar(32)              not null,
+    priority          varchar(32)              not null default 'standard',
+    created_at        TIMESTAMP with time zone not null default now(),
+    dispatch_attempts integer                  not null default 0,
+    pickup_location_x integer                  null,
+    pickup_location_y integer                  null,
+    confirmation_code varchar(16)              not null default '',
+    delivery_attempts integer                  not null default 0,
+    return_location_x integer                  null,
+    return_location_y integer                  null,
+    retry_delivery    boolean                  not null default false,
+    weight            integer                  not null default 0,
+    handling          varchar(32)              not null default 'standard',
+    restrictions      varchar(32)[]            not null default '{}',
+    parent_id         uuid                     null,
+    assigned_by       varchar(255)             not null default '',
+    location_lat      double precision         null,
+    location_lon      double precision         null,
+    pickup_location_lat double precision       null,
+    pickup_location_lon double precision       null,
+    return_location_lat double precision       null,
+    return_location_lon double precision       null
 );
 
 create table storage_places
@@ -28,6 +58,19 @@ create table storage_places
             primary key,
     name       varchar(255) not null,
     volume     integer      not null,
+    max_weight integer      not null default 0,
+    type       varchar(32)  not null default 'standard',
     order_id   uuid         null,
     courier_id uuid         null
 );
+
+create table dispatch_decisions
+(
+    id         uuid                     not null
+        constraint dispatch_decisions_pk
+            primary key,
+    order_id   uuid                     not null,
+    courier_id uuid                     null,
+    candidates jsonb                    not null,
+    decided_at TIMESTAMP with time zone not null
+);
@@ -7,6 +7,7 @@ import (
 	"delivery/internal/pkg/errs"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"log"
 	"sync"
 )
 
@@ -14,15 +15,26 @@ var _ ports.UnitOfWork = &unitOfWork{}
 var _ ports.UnitOfWorkComponents = &unitOfWorkComponents{}
 
 type unitOfWork struct {
-	db *pgxpool.Pool
+	db      *pgxpool.Pool
+	mediatr ddd.Mediatr
 }
 
 type txKeyType struct{}
 
 var txKey = txKeyType{}
 
+type transientKeyType struct{}
+
+var transientKey = transientKeyType{}
+
+// transientEvents - события транзакции, которые публикуются после ее фиксации, минуя outbox
+type transientEvents struct {
+	events []ddd.DomainEvent
+}
+
 type unitOfWorkComponents struct {
-	tx pgx.Tx
+	tx        pgx.Tx
+	transient *transientEvents
 }
 
 func NewUnitOfWork(db *pgxpool.Pool, mediatr ddd.Mediatr) (ports.UnitOfWork, error) {
@@ -35,7 +47,8 @@ func NewUnitOfWork(db *pgxpool.Pool, mediatr ddd.Mediatr) (ports.UnitOfWork, err
 	}
 
 	uow := &unitOfWork{
-		db: db,
+		db:      db,
+		mediatr: mediatr,
 	}
 
 	return uow, nil
@@ -44,35 +57,61 @@ func NewUnitOfWork(db *pgxpool.Pool, mediatr ddd.Mediatr) (ports.UnitOfWork, err
 func (u *unitOfWork) Do(ctx context.Context, fn ports.UnitOfWorkDoFunc) error {
 
 	tx := u.getCurrentTx(ctx)
+	nested := tx != nil
 
 	var err error
-	if tx == nil { // create new transaction
+	var transient *transientEvents
+	if !nested { // create new transaction
 		tx, err = u.db.Begin(ctx)
 		if err != nil {
 			return err
 		}
+
+		transient = &transientEvents{}
 	} else { // create nested transaction (savepoint)
 		tx, err = tx.Begin(ctx)
 		if err != nil {
 			return err
 		}
+
+		transient = ctx.Value(transientKey).(*transientEvents)
 	}
 
 	ctx = context.WithValue(ctx, txKey, tx)
+	ctx = context.WithValue(ctx, transientKey, transient)
 
+	// события отмененной работы не публикуются
+	savepoint := len(transient.events)
 	defer func() {
 		if err != nil {
 			_ = tx.Rollback(ctx)
+			transient.events = transient.events[:savepoint]
 		}
 	}()
 
-	err = fn(ctx, &unitOfWorkComponents{tx: tx})
+	err = fn(ctx, &unitOfWorkComponents{tx: tx, transient: transient})
 	if err != nil {
 		return err
 	}
 
 	err = tx.Commit(ctx)
-	return err
+	if err != nil || nested {
+		return err
+	}
+
+	u.publish(ctx, transient.events)
+	return nil
+}
+
+// publish передает подписчикам события, которые не сохраняются в outbox. Изменения уже зафиксированы,
+// поэтому ошибка подписчика только записывается в лог
+func (u *unitOfWork) publish(ctx context.Context, events []ddd.DomainEvent) {
+	for _, event := range events {
+		err := u.mediatr.Publish(ctx, event)
+		if err != nil {
+			log.Printf("cannot publish %s: %v", event.GetName(), err)
+		}
+	}
 }
 
 func (u *unitOfWork) getCurrentTx(ctx context.Context) pgx.Tx {
@@ -95,7 +134,15 @@ func (uowc *unitOfWorkComponents) OrderRepository() ports.OrderRepository {
 func (uowc *unitOfWorkComponents) CourierRepository() ports.CourierRepository {
 	return sync.OnceValue(
 		func() ports.CourierRepository {
-			repo, _ := NewCourierRepository(uowc.tx)
+			repo, _ := NewCourierRepository(uowc.tx, &uowc.transient.events)
+			return repo
+		})()
+}
+
+func (uowc *unitOfWorkComponents) DispatchDecisionRepository() ports.DispatchDecisionRepository {
+	return sync.OnceValue(
+		func() ports.DispatchDecisionRepository {
+			repo, _ := NewDispatchDecisionRepository(uowc.tx)
 			return repo
 		})()
 }
@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"delivery/internal/core/domain/kernel"
 	"delivery/internal/core/domain/model/courier"
 	"delivery/internal/core/domain/services"
 	"delivery/internal/core/ports"
@@ -20,11 +21,11 @@ func TestUnitOfWork_Do_WithCommit(t *testing.T) {
 	// создаем курьеров и заказы
 	couriers := createCouriers(25)
 	orders := createOrders(100)
-	dispatcher := services.NewOrderDispatcher()
+	dispatcher, _ := services.NewOrderDispatcher(kernel.NewGridDistanceCalculator(), testIds, testClock)
 
 	// случайным образом назначаем заказы курьерам (примерно 66% из них)
 	for range len(couriers) - len(couriers)/3 {
-		_, _ = dispatcher.Dispatch(getRandomUnassignedOrder(orders), couriers)
+		_, _, _ = dispatcher.Dispatch(getRandomUnassignedOrder(orders), couriers)
 	}
 
 	// сохраняем курьеров и заказы в БД
@@ -23,6 +23,10 @@ import (
 	"time"
 )
 
+var testIds = kernel.IDGeneratorFunc(uuid.New)
+var testClock = kernel.ClockFunc(func() time.Time { return time.Now().UTC() })
+var testRnd = rand.New(rand.NewSource(time.Now().UnixNano()))
+
 func setupTest(t *testing.T, seedTestData bool) (context.Context, *pgxpool.Pool, ports.UnitOfWork, error) {
 	ctx := context.Background()
 
@@ -115,6 +119,7 @@ func connectDb(ctx context.Context, dsn string) (*pgxpool.Pool, error) {
 
 func createOrders(count int) []*order.Order {
 
+	area, _ := kernel.NewServiceArea(10, 10)
 	orders := make([]*order.Order, count)
 	for i := range count {
 		var volume int
@@ -123,7 +128,7 @@ func createOrders(count int) []*order.Order {
 		} else {
 			volume = rand.Intn(20) + 10
 		}
-		orders[i], _ = order.NewOrder(uuid.New(), kernel.NewRandomLocation(), volume)
+		orders[i], _ = order.NewOrder(uuid.New(), area.RandomLocation(testRnd), volume, 0, order.PriorityStandard, testIds, testClock)
 	}
 
 	sortById(orders)
@@ -133,11 +138,12 @@ func createOrders(count int) []*order.Order {
 
 func createCouriers(count int) []*courier.Courier {
 
+	area, _ := kernel.NewServiceArea(10, 10)
 	couriers := make([]*courier.Courier, count)
 	for i := range count {
-		couriers[i], _ = courier.NewCourier(fmt.Sprintf("courier%d", i), rand.Intn(5)+1, kernel.NewRandomLocation())
+		couriers[i], _ = courier.NewCourier(fmt.Sprintf("courier%d", i), rand.Intn(5)+1, area.RandomLocation(testRnd), testIds)
 		if rand.Intn(100) > 50 {
-			_ = couriers[i].AddStoragePlace("trunk", 200)
+			_ = couriers[i].AddStoragePlace("trunk", courier.StoragePlaceStandard, 200, 0, testIds)
 		}
 	}
 
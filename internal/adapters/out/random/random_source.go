@@ -0,0 +1,29 @@
+package random
+
+import (
+	"delivery/internal/core/ports"
+	"math/rand"
+	"sync"
+)
+
+var _ ports.RandomSource = &randomSource{}
+
+// randomSource - потокобезопасная обертка над rand.Rand
+type randomSource struct {
+	mu  sync.Mutex
+	rnd *rand.Rand
+}
+
+// NewRandomSource возвращает источник, который для одного seed выдает одну и ту же последовательность
+func NewRandomSource(seed int64) ports.RandomSource {
+	return &randomSource{
+		rnd: rand.New(rand.NewSource(seed)),
+	}
+}
+
+func (r *randomSource) Intn(n int) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.rnd.Intn(n)
+}
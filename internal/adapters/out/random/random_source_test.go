@@ -0,0 +1,41 @@
+package random
+
+import (
+	"testing"
+)
+
+func TestRandomSource_Intn(t *testing.T) {
+	r1 := NewRandomSource(42)
+	r2 := NewRandomSource(42)
+
+	for range 100 {
+		n := r1.Intn(10)
+
+		if n != r2.Intn(10) {
+			t.Fatal("expected same sequence for same seed")
+		}
+
+		if n < 0 || n >= 10 {
+			t.Fatalf("%d out of range", n)
+		}
+	}
+}
+
+func TestSecureRandomSource_Intn(t *testing.T) {
+	r := NewSecureRandomSource()
+
+	seen := map[int]bool{}
+	for range 1000 {
+		n := r.Intn(10)
+
+		if n < 0 || n >= 10 {
+			t.Fatalf("%d out of range", n)
+		}
+
+		seen[n] = true
+	}
+
+	if len(seen) != 10 {
+		t.Fatal("expected all values in range to appear")
+	}
+}
@@ -0,0 +1,32 @@
+package random
+
+import (
+	"crypto/rand"
+	"delivery/internal/core/ports"
+	"math/big"
+)
+
+var _ ports.RandomSource = &secureRandomSource{}
+
+// secureRandomSource выдает непредсказуемые числа из crypto/rand. Используется для секретов,
+// например кодов подтверждения доставки, которые нельзя угадать по seed или времени запуска
+type secureRandomSource struct {
+}
+
+func NewSecureRandomSource() ports.RandomSource {
+	return &secureRandomSource{}
+}
+
+func (r *secureRandomSource) Intn(n int) int {
+	if n <= 0 {
+		panic("invalid argument to Intn")
+	}
+
+	v, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		// crypto/rand.Reader не возвращает ошибок на поддерживаемых платформах
+		panic(err)
+	}
+
+	return int(v.Int64())
+}
@@ -0,0 +1,103 @@
+package tracking
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+	"encoding/base64"
+	"encoding/binary"
+	"github.com/google/uuid"
+	"strings"
+	"time"
+)
+
+// scope отделяет подпись токенов отслеживания от других подписей тем же ключом:
+// токен, выпущенный для другой цели, не пройдет проверку
+const scope = "order-tracking:v1"
+
+// MinSecretSize - ключ короче размера подписи HMAC-SHA256 ослабляет ее и легко подбирается
+const MinSecretSize = 32
+
+var encoding = base64.RawURLEncoding
+
+var _ ports.TrackingTokens = &hmacTokens{}
+
+// hmacTokens - токен без хранения на сервере: заказ и срок действия, подписанные HMAC-SHA256.
+// Формат: base64url(id заказа + срок в секундах Unix).base64url(подпись)
+type hmacTokens struct {
+	secret []byte
+	ttl    time.Duration
+}
+
+func NewHmacTokens(secret []byte, ttl time.Duration) (ports.TrackingTokens, error) {
+	if len(secret) == 0 {
+		return nil, errs.NewValueIsRequiredError("secret")
+	}
+
+	if len(secret) < MinSecretSize {
+		return nil, errs.NewValueIsInvalidError("secret")
+	}
+
+	if ttl <= 0 {
+		return nil, errs.NewValueIsInvalidError("ttl")
+	}
+
+	return &hmacTokens{
+		secret: bytes.Clone(secret),
+		ttl:    ttl,
+	}, nil
+}
+
+func (t *hmacTokens) Issue(orderId uuid.UUID, issuedAt time.Time) (string, error) {
+	if orderId == uuid.Nil {
+		return "", errs.NewValueIsRequiredError("orderId")
+	}
+
+	if issuedAt.IsZero() {
+		return "", errs.NewValueIsRequiredError("issuedAt")
+	}
+
+	payload := make([]byte, 0, len(orderId)+8)
+	payload = append(payload, orderId[:]...)
+	payload = binary.BigEndian.AppendUint64(payload, uint64(issuedAt.Add(t.ttl).Unix()))
+
+	return encoding.EncodeToString(payload) + "." + encoding.EncodeToString(t.sign(payload)), nil
+}
+
+func (t *hmacTokens) Verify(token string, now time.Time) (uuid.UUID, error) {
+	encodedPayload, encodedSignature, ok := strings.Cut(token, ".")
+	if !ok {
+		return uuid.Nil, ports.ErrTrackingTokenInvalid
+	}
+
+	payload, err := encoding.DecodeString(encodedPayload)
+	if err != nil || len(payload) != len(uuid.UUID{})+8 {
+		return uuid.Nil, ports.ErrTrackingTokenInvalid
+	}
+
+	signature, err := encoding.DecodeString(encodedSignature)
+	if err != nil || !hmac.Equal(signature, t.sign(payload)) {
+		return uuid.Nil, ports.ErrTrackingTokenInvalid
+	}
+
+	orderId, err := uuid.FromBytes(payload[:len(uuid.UUID{})])
+	if err != nil || orderId == uuid.Nil {
+		return uuid.Nil, ports.ErrTrackingTokenInvalid
+	}
+
+	expiresAt := time.Unix(int64(binary.BigEndian.Uint64(payload[len(uuid.UUID{}):])), 0)
+	if !now.Before(expiresAt) {
+		return uuid.Nil, ports.ErrTrackingTokenExpired
+	}
+
+	return orderId, nil
+}
+
+func (t *hmacTokens) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, t.secret)
+	mac.Write([]byte(scope))
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
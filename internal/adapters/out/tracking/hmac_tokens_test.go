@@ -0,0 +1,66 @@
+package tracking
+
+import (
+	"delivery/internal/core/ports"
+	"errors"
+	"github.com/google/uuid"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testSecret = []byte("0123456789abcdef0123456789abcdef")
+
+func TestNewHmacTokens_RejectsShortSecret(t *testing.T) {
+
+	_, err := NewHmacTokens([]byte("change-me"), time.Hour)
+	if err == nil {
+		t.Fatal("expected error for short secret")
+	}
+}
+
+func TestHmacTokens_IssueAndVerify(t *testing.T) {
+
+	tokens, err := NewHmacTokens(testSecret, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	orderId := uuid.New()
+	now := time.Now()
+	token, err := tokens.Issue(orderId, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	verified, err := tokens.Verify(token, now.Add(time.Minute))
+	if err != nil || verified != orderId {
+		t.Fatalf("expected order %s, got %s, %v", orderId, verified, err)
+	}
+
+	_, err = tokens.Verify(token, now.Add(2*time.Hour))
+	if !errors.Is(err, ports.ErrTrackingTokenExpired) {
+		t.Fatalf("expected expired token, got %v", err)
+	}
+}
+
+func TestHmacTokens_RejectsForgedTokens(t *testing.T) {
+
+	tokens, _ := NewHmacTokens(testSecret, time.Hour)
+	otherTokens, _ := NewHmacTokens([]byte("fedcba9876543210fedcba9876543210"), time.Hour)
+
+	now := time.Now()
+	token, _ := tokens.Issue(uuid.New(), now)
+	otherToken, _ := otherTokens.Issue(uuid.New(), now)
+
+	// подпись от другого токена к чужому заказу не подходит
+	payload, _, _ := strings.Cut(otherToken, ".")
+	_, signature, _ := strings.Cut(token, ".")
+
+	for _, forged := range []string{"", "garbage", otherToken, payload + "." + signature} {
+		_, err := tokens.Verify(forged, now)
+		if !errors.Is(err, ports.ErrTrackingTokenInvalid) {
+			t.Fatalf("expected invalid token for %q, got %v", forged, err)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package eventhandlers
+
+import (
+	"context"
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/ddd"
+	"delivery/internal/pkg/errs"
+)
+
+var _ ddd.EventHandler = &liveUpdatesHandler{}
+
+type liveUpdatesHandler struct {
+	publisher ports.LiveUpdatesPublisher
+}
+
+func NewLiveUpdatesHandler(publisher ports.LiveUpdatesPublisher) (ddd.EventHandler, error) {
+	if publisher == nil {
+		return nil, errs.NewValueIsRequiredError("publisher")
+	}
+
+	return &liveUpdatesHandler{
+		publisher: publisher,
+	}, nil
+}
+
+// Handle передает подписчикам перемещения курьеров и изменения заказов. Остальные события пропускаются
+func (eh *liveUpdatesHandler) Handle(ctx context.Context, domainEvent ddd.DomainEvent) error {
+	switch event := domainEvent.(type) {
+	case *courier.MovedDomainEvent:
+		location, err := kernel.NewLocation(event.LocationX, event.LocationY)
+		if err != nil {
+			return err
+		}
+
+		return eh.publisher.Publish(ctx, ports.LiveUpdate{
+			Type:       ports.LiveUpdateCourierMoved,
+			CourierId:  event.CourierId,
+			Location:   location,
+			OccurredAt: event.OccurredAt,
+		})
+
+	case *order.UpdatedDomainEvent:
+		location, err := kernel.NewLocation(event.LocationX, event.LocationY)
+		if err != nil {
+			return err
+		}
+
+		return eh.publisher.Publish(ctx, ports.LiveUpdate{
+			Type:       ports.LiveUpdateOrderUpdated,
+			CourierId:  event.CourierId,
+			OrderId:    event.OrderId,
+			Status:     event.Status,
+			Location:   location,
+			OccurredAt: event.OccurredAt,
+		})
+	}
+
+	return nil
+}
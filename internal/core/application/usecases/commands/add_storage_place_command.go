@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"delivery/internal/core/domain/model/courier"
 	"delivery/internal/pkg/errs"
 	"errors"
 	"github.com/google/uuid"
@@ -10,11 +11,15 @@ import (
 type AddStoragePlaceCommand struct {
 	courierID   uuid.UUID
 	name        string
+	placeType   courier.StoragePlaceType
 	totalVolume int
+	maxWeight   int
 	isValid     bool
 }
 
-func NewAddStoragePlaceCommand(courierID uuid.UUID, name string, totalVolume int) (AddStoragePlaceCommand, error) {
+// NewAddStoragePlaceCommand создает команду. Пустой тип означает обычное место хранения
+func NewAddStoragePlaceCommand(courierID uuid.UUID, name string, placeType string, totalVolume int,
+	maxWeight int) (AddStoragePlaceCommand, error) {
 
 	if courierID == uuid.Nil {
 		return AddStoragePlaceCommand{}, errs.NewValueIsRequiredError("courierID")
@@ -28,10 +33,26 @@ func NewAddStoragePlaceCommand(courierID uuid.UUID, name string, totalVolume int
 		return AddStoragePlaceCommand{}, errors.New("totalVolume must be greater than 0")
 	}
 
+	if maxWeight < 0 {
+		return AddStoragePlaceCommand{}, errors.New("maxWeight must not be negative")
+	}
+
+	storagePlaceType := courier.StoragePlaceStandard
+	if placeType != "" {
+		t, err := courier.StoragePlaceTypeFromString(placeType)
+		if err != nil {
+			return AddStoragePlaceCommand{}, errs.NewValueIsInvalidErrorWithCause("placeType", err)
+		}
+
+		storagePlaceType = t
+	}
+
 	return AddStoragePlaceCommand{
 		courierID:   courierID,
 		name:        name,
+		placeType:   storagePlaceType,
 		totalVolume: totalVolume,
+		maxWeight:   maxWeight,
 		isValid:     true,
 	}, nil
 }
@@ -44,10 +65,18 @@ func (a AddStoragePlaceCommand) Name() string {
 	return a.name
 }
 
+func (a AddStoragePlaceCommand) PlaceType() courier.StoragePlaceType {
+	return a.placeType
+}
+
 func (a AddStoragePlaceCommand) TotalVolume() int {
 	return a.totalVolume
 }
 
+func (a AddStoragePlaceCommand) MaxWeight() int {
+	return a.maxWeight
+}
+
 func (a AddStoragePlaceCommand) IsValid() bool {
 	return a.isValid
 }
@@ -14,15 +14,21 @@ var _ AddStoragePlaceCommandHandler = &addStoragePlaceCommandHandler{}
 
 type addStoragePlaceCommandHandler struct {
 	uow ports.UnitOfWork
+	ids ports.IDGenerator
 }
 
-func NewAddStoragePlaceCommandHandler(uow ports.UnitOfWork) (AddStoragePlaceCommandHandler, error) {
+func NewAddStoragePlaceCommandHandler(uow ports.UnitOfWork, ids ports.IDGenerator) (AddStoragePlaceCommandHandler, error) {
 	if uow == nil {
 		return nil, errs.NewValueIsRequiredError("uow")
 	}
 
+	if ids == nil {
+		return nil, errs.NewValueIsRequiredError("ids")
+	}
+
 	return &addStoragePlaceCommandHandler{
 		uow: uow,
+		ids: ids,
 	}, nil
 }
 
@@ -39,7 +45,7 @@ func (c *addStoragePlaceCommandHandler) Handle(ctx context.Context, cmd AddStora
 			return err
 		}
 
-		err = cour.AddStoragePlace(cmd.name, cmd.totalVolume)
+		err = cour.AddStoragePlace(cmd.name, cmd.placeType, cmd.totalVolume, cmd.maxWeight, c.ids)
 		if err != nil {
 			return err
 		}
@@ -2,11 +2,17 @@ package commands
 
 import (
 	"context"
+	"delivery/internal/core/domain/model/dispatch"
+	"delivery/internal/core/domain/model/order"
 	"delivery/internal/core/domain/services"
 	"delivery/internal/core/ports"
 	"delivery/internal/pkg/errs"
+	"errors"
 )
 
+// dispatchQueueSize - сколько заказов из начала очереди просматривается за один вызов
+const dispatchQueueSize = 100
+
 type AssignOrderCommandHandler interface {
 	Handle(context.Context) error
 }
@@ -14,11 +20,15 @@ type AssignOrderCommandHandler interface {
 var _ AssignOrderCommandHandler = &assignOrderCommandHandler{}
 
 type assignOrderCommandHandler struct {
-	uow ports.UnitOfWork
-	d   services.OrderDispatcher
+	uow                 ports.UnitOfWork
+	d                   services.OrderDispatcher
+	maxDispatchAttempts int
+	ids                 ports.IDGenerator
+	clock               ports.Clock
 }
 
-func NewAssignOrderCommandHandler(uow ports.UnitOfWork, d services.OrderDispatcher) (AssignOrderCommandHandler, error) {
+func NewAssignOrderCommandHandler(uow ports.UnitOfWork, d services.OrderDispatcher, maxDispatchAttempts int,
+	ids ports.IDGenerator, clock ports.Clock) (AssignOrderCommandHandler, error) {
 	if uow == nil {
 		return nil, errs.NewValueIsRequiredError("uow")
 	}
@@ -27,15 +37,35 @@ func NewAssignOrderCommandHandler(uow ports.UnitOfWork, d services.OrderDispatch
 		return nil, errs.NewValueIsRequiredError("d")
 	}
 
+	if maxDispatchAttempts <= 0 {
+		return nil, errs.NewValueIsInvalidError("maxDispatchAttempts")
+	}
+
+	if ids == nil {
+		return nil, errs.NewValueIsRequiredError("ids")
+	}
+
+	if clock == nil {
+		return nil, errs.NewValueIsRequiredError("clock")
+	}
+
 	return &assignOrderCommandHandler{
-		uow: uow,
-		d:   d,
+		uow:                 uow,
+		d:                   d,
+		maxDispatchAttempts: maxDispatchAttempts,
+		ids:                 ids,
+		clock:               clock,
 	}, nil
 }
 
+// Handle назначает курьера первому в очереди заказу, который может взять кто-то из свободных курьеров.
+// Заказ, подходящие курьеры которого заняты, ждет, не задерживая следующие за ним
 func (c *assignOrderCommandHandler) Handle(ctx context.Context) error {
 
-	return c.uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+	// Ошибку диспетчеризации возвращаем после коммита, чтобы не потерять запись о решении
+	var dispatchErr error
+
+	err := c.uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
 
 		couriers, err := uowc.CourierRepository().GetAllFree(ctx)
 		if err != nil {
@@ -46,25 +76,107 @@ func (c *assignOrderCommandHandler) Handle(ctx context.Context) error {
 			return nil // No free courier available - no error here
 		}
 
-		ord, err := uowc.OrderRepository().GetFirstInCreatedStatus(ctx)
+		orders, err := uowc.OrderRepository().GetAllInCreatedStatus(ctx, dispatchQueueSize)
 		if err != nil {
 			return err
 		}
 
-		if ord == nil {
-			return nil // No new orders available - no error here
+		for _, ord := range orders {
+			cour, decision, err := c.d.Dispatch(ord, couriers)
+			if decision != nil {
+				saveErr := c.saveDecision(ctx, uowc, decision)
+				if saveErr != nil {
+					return saveErr
+				}
+			}
+
+			if err == nil {
+				dispatchErr = nil
+
+				err = uowc.CourierRepository().Save(ctx, cour)
+				if err != nil {
+					return err
+				}
+
+				return uowc.OrderRepository().Save(ctx, ord)
+			}
+
+			if dispatchErr == nil {
+				dispatchErr = err
+			}
+
+			// Заказ, который никто не может взять, после нескольких попыток становится недоставляемым,
+			// иначе он будет бесконечно выбираться на диспетчеризацию
+			if !errors.Is(err, services.ErrNoMatchingCourier) {
+				continue
+			}
+
+			// Пока подходящий курьер просто занят, заказ ждет его, и попытка не засчитывается
+			waiting, checkErr := c.hasSuitableCourier(ctx, uowc, ord)
+			if checkErr != nil {
+				return checkErr
+			}
+
+			if waiting {
+				continue
+			}
+
+			err = ord.FailDispatch(c.maxDispatchAttempts, err.Error(), c.ids, c.clock)
+			if err != nil {
+				return err
+			}
+
+			err = uowc.OrderRepository().Save(ctx, ord)
+			if err != nil {
+				return err
+			}
+
+			err = finishSplitOrder(ctx, uowc, ord, c.ids, c.clock)
+			if err != nil {
+				return err
+			}
 		}
 
-		cour, err := c.d.Dispatch(ord, couriers)
-		if err != nil {
-			return err
-		}
+		return nil
+	})
 
-		err = uowc.CourierRepository().Save(ctx, cour)
-		if err != nil {
-			return err
+	if err != nil {
+		return err
+	}
+
+	return dispatchErr
+}
+
+// hasSuitableCourier проверяет, есть ли среди всех курьеров, включая занятых, тот, кто в принципе может взять заказ
+func (c *assignOrderCommandHandler) hasSuitableCourier(ctx context.Context, uowc ports.UnitOfWorkComponents,
+	ord *order.Order) (bool, error) {
+	couriers, err := uowc.CourierRepository().GetAll(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	for _, cour := range couriers {
+		ok, err := cour.IsSuitableFor(ord)
+		if err != nil || ok {
+			return ok, err
 		}
+	}
 
-		return uowc.OrderRepository().Save(ctx, ord)
-	})
+	return false, nil
+}
+
+// saveDecision записывает решение, если оно отличается от предыдущего по заказу: пока заказ ждет
+// курьера, решение принимается на каждом такте и иначе журнал рос бы без ограничений
+func (c *assignOrderCommandHandler) saveDecision(ctx context.Context, uowc ports.UnitOfWorkComponents,
+	decision *dispatch.Decision) error {
+	last, err := uowc.DispatchDecisionRepository().GetLastByOrderId(ctx, decision.OrderId())
+	if err != nil {
+		return err
+	}
+
+	if decision.Repeats(last) {
+		return nil
+	}
+
+	return uowc.DispatchDecisionRepository().Save(ctx, decision)
 }
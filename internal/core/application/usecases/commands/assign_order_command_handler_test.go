@@ -0,0 +1,91 @@
+package commands_test
+
+import (
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/services"
+	"errors"
+	"github.com/google/uuid"
+	"testing"
+	"time"
+)
+
+func TestAssignOrderCommandHandler_RejectsOrderNoCourierCanTake(t *testing.T) {
+
+	ctx, uow, clk := setupTest(t)
+
+	dispatcher, _ := services.NewOrderDispatcher(kernel.NewGridDistanceCalculator(), testIds, clk)
+	handler, err := commands.NewAssignOrderCommandHandler(uow, dispatcher, 2, testIds, clk)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// в сумку курьера объемом 10 заказ объемом 15 не помещается
+	c, _ := courier.NewCourier("courier", 1, newLocation(1, 1), testIds)
+	o, _ := order.NewOrder(uuid.New(), newLocation(5, 5), 15, 0, order.PriorityStandard, testIds, clk)
+	saveCouriers(t, uow, c)
+	saveOrders(t, uow, o)
+
+	// первая неудачная попытка засчитывается, заказ остается в очереди
+	err = handler.Handle(ctx)
+	if !errors.Is(err, services.ErrNoMatchingCourier) {
+		t.Fatalf("expected ErrNoMatchingCourier, got %v", err)
+	}
+
+	saved := getOrder(t, uow, o.Id())
+	if saved.Status() != order.StatusCreated || saved.DispatchAttempts() != 1 {
+		t.Fatalf("expected created order with 1 attempt, got %s with %d", saved.Status(), saved.DispatchAttempts())
+	}
+
+	// после последней попытки заказ признается недоставляемым
+	_ = handler.Handle(ctx)
+
+	saved = getOrder(t, uow, o.Id())
+	if saved.Status() != order.StatusUndeliverable {
+		t.Fatalf("expected undeliverable order, got %s", saved.Status())
+	}
+}
+
+func TestAssignOrderCommandHandler_SkipsOrderWaitingForBusyCourier(t *testing.T) {
+
+	ctx, uow, clk := setupTest(t)
+
+	dispatcher, _ := services.NewOrderDispatcher(kernel.NewGridDistanceCalculator(), testIds, clk)
+	handler, err := commands.NewAssignOrderCommandHandler(uow, dispatcher, 2, testIds, clk)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// большой заказ может взять только курьер с багажником, но он занят
+	trunk, _ := courier.NewCourier("trunk", 1, newLocation(1, 1), testIds)
+	_ = trunk.AddStoragePlace("Trunk", courier.StoragePlaceStandard, 30, 0, testIds)
+	busyWith, _ := order.NewOrder(uuid.New(), newLocation(2, 2), 5, 0, order.PriorityStandard, testIds, clk)
+	_ = trunk.TakeOrder(busyWith)
+	_ = busyWith.AssignCourier(trunk.Id(), testIds, clk)
+	bag, _ := courier.NewCourier("bag", 1, newLocation(1, 1), testIds)
+
+	large, _ := order.NewOrder(uuid.New(), newLocation(5, 5), 20, 0, order.PriorityStandard, testIds, clk)
+	clk.Advance(time.Minute)
+	small, _ := order.NewOrder(uuid.New(), newLocation(5, 5), 5, 0, order.PriorityStandard, testIds, clk)
+
+	saveCouriers(t, uow, trunk, bag)
+	saveOrders(t, uow, busyWith, large, small)
+
+	// большой заказ ждет курьера с багажником и не задерживает следующий за ним
+	err = handler.Handle(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	savedLarge := getOrder(t, uow, large.Id())
+	if savedLarge.Status() != order.StatusCreated || savedLarge.DispatchAttempts() != 0 {
+		t.Fatal("expected large order waiting without attempts")
+	}
+
+	savedSmall := getOrder(t, uow, small.Id())
+	if savedSmall.Status() != order.StatusAssigned || *savedSmall.CourierId() != bag.Id() {
+		t.Fatal("expected small order assigned to the free courier")
+	}
+}
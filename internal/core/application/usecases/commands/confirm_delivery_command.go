@@ -0,0 +1,43 @@
+package commands
+
+import (
+	"delivery/internal/pkg/errs"
+	"github.com/google/uuid"
+	"strings"
+)
+
+type ConfirmDeliveryCommand struct {
+	orderID uuid.UUID
+	code    string
+	isValid bool
+}
+
+// NewConfirmDeliveryCommand создает команду. code - код, который получатель сообщил курьеру
+func NewConfirmDeliveryCommand(orderID uuid.UUID, code string) (ConfirmDeliveryCommand, error) {
+
+	if orderID == uuid.Nil {
+		return ConfirmDeliveryCommand{}, errs.NewValueIsRequiredError("orderID")
+	}
+
+	if strings.TrimSpace(code) == "" {
+		return ConfirmDeliveryCommand{}, errs.NewValueIsRequiredError("code")
+	}
+
+	return ConfirmDeliveryCommand{
+		orderID: orderID,
+		code:    strings.TrimSpace(code),
+		isValid: true,
+	}, nil
+}
+
+func (c ConfirmDeliveryCommand) OrderID() uuid.UUID {
+	return c.orderID
+}
+
+func (c ConfirmDeliveryCommand) Code() string {
+	return c.code
+}
+
+func (c ConfirmDeliveryCommand) IsValid() bool {
+	return c.isValid
+}
@@ -0,0 +1,124 @@
+package commands
+
+import (
+	"context"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+	"errors"
+)
+
+var (
+	ErrInvalidConfirmationCode = errors.New("invalid confirmation code")
+	ErrCourierNotArrived       = errors.New("courier has not arrived at the order location")
+)
+
+type ConfirmDeliveryCommandHandler interface {
+	Handle(context.Context, ConfirmDeliveryCommand) error
+}
+
+var _ ConfirmDeliveryCommandHandler = &confirmDeliveryCommandHandler{}
+
+type confirmDeliveryCommandHandler struct {
+	uow                 ports.UnitOfWork
+	maxDeliveryAttempts int
+	ids                 ports.IDGenerator
+	clock               ports.Clock
+}
+
+func NewConfirmDeliveryCommandHandler(uow ports.UnitOfWork, maxDeliveryAttempts int, ids ports.IDGenerator,
+	clock ports.Clock) (ConfirmDeliveryCommandHandler, error) {
+	if uow == nil {
+		return nil, errs.NewValueIsRequiredError("uow")
+	}
+
+	if maxDeliveryAttempts <= 0 {
+		return nil, errs.NewValueIsInvalidError("maxDeliveryAttempts")
+	}
+
+	if ids == nil {
+		return nil, errs.NewValueIsRequiredError("ids")
+	}
+
+	if clock == nil {
+		return nil, errs.NewValueIsRequiredError("clock")
+	}
+
+	return &confirmDeliveryCommandHandler{
+		uow:                 uow,
+		maxDeliveryAttempts: maxDeliveryAttempts,
+		ids:                 ids,
+		clock:               clock,
+	}, nil
+}
+
+func (c *confirmDeliveryCommandHandler) Handle(ctx context.Context, cmd ConfirmDeliveryCommand) error {
+
+	if !cmd.isValid {
+		return errs.NewValueIsInvalidError("cmd")
+	}
+
+	// Неверный код возвращаем после коммита, чтобы не потерять учет неудачной попытки
+	confirmed := false
+
+	err := c.uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+
+		ord, err := uowc.OrderRepository().Get(ctx, cmd.orderID)
+		if err != nil {
+			return err
+		}
+
+		if ord == nil || ord.CourierId() == nil {
+			return errs.NewObjectNotFoundError("orderID", cmd.orderID)
+		}
+
+		cour, err := uowc.CourierRepository().Get(ctx, *ord.CourierId())
+		if err != nil {
+			return err
+		}
+
+		if cour == nil {
+			return errs.NewObjectNotFoundError("courierID", *ord.CourierId())
+		}
+
+		if !cour.Location().Equals(ord.Location()) {
+			return ErrCourierNotArrived
+		}
+
+		confirmed, err = ord.ConfirmDelivery(cmd.code, c.maxDeliveryAttempts, cour.ReturnLocation(ord), c.ids, c.clock)
+		if err != nil {
+			return err
+		}
+
+		// Недоставленный товар остается у курьера, пока тот не привезет его на склад
+		if !confirmed {
+			return uowc.OrderRepository().Save(ctx, ord)
+		}
+
+		err = cour.CompleteOrder(ord)
+		if err != nil {
+			return err
+		}
+
+		err = uowc.CourierRepository().Save(ctx, cour)
+		if err != nil {
+			return err
+		}
+
+		err = uowc.OrderRepository().Save(ctx, ord)
+		if err != nil {
+			return err
+		}
+
+		return finishSplitOrder(ctx, uowc, ord, c.ids, c.clock)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if !confirmed {
+		return ErrInvalidConfirmationCode
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package commands_test
+
+import (
+	"delivery/internal/adapters/out/random"
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/services"
+	"errors"
+	"github.com/google/uuid"
+	"testing"
+)
+
+// wrongCode возвращает код той же длины, не совпадающий с верным
+func wrongCode(code string) string {
+	if code[0] == '0' {
+		return "1" + code[1:]
+	}
+
+	return "0" + code[1:]
+}
+
+func TestConfirmDeliveryCommandHandler_Handle(t *testing.T) {
+
+	ctx, uow, clk := setupTest(t)
+
+	handler, err := commands.NewConfirmDeliveryCommandHandler(uow, 2, testIds, clk)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	move, err := commands.NewMoveCouriersCommandHandler(uow, kernel.NewGridDistanceCalculator(), clk, testIds,
+		services.NewStayPolicy())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, _ := courier.NewCourier("courier", 1, newLocation(5, 5), testIds)
+	o, _ := order.NewOrder(uuid.New(), newLocation(5, 5), 5, 0, order.PriorityStandard, testIds, clk)
+	_ = o.RequireConfirmation(random.NewRandomSource(1))
+	_ = c.TakeOrder(o)
+	_ = o.AssignCourier(c.Id(), testIds, clk)
+	saveCouriers(t, uow, c)
+	saveOrders(t, uow, o)
+
+	// прибытие курьера не завершает заказ с кодом подтверждения
+	err = move.Handle(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if getOrder(t, uow, o.Id()).Status() != order.StatusAssigned {
+		t.Fatal("expected order awaiting confirmation")
+	}
+
+	// неверный код учитывается как неудачная попытка
+	cmd, _ := commands.NewConfirmDeliveryCommand(o.Id(), wrongCode(o.ConfirmationCode()))
+	err = handler.Handle(ctx, cmd)
+	if !errors.Is(err, commands.ErrInvalidConfirmationCode) {
+		t.Fatalf("expected ErrInvalidConfirmationCode, got %v", err)
+	}
+
+	saved := getOrder(t, uow, o.Id())
+	if saved.Status() != order.StatusAssigned || saved.DeliveryAttempts() != 1 {
+		t.Fatal("expected failed attempt recorded")
+	}
+
+	// верный код завершает заказ и освобождает курьера
+	cmd, _ = commands.NewConfirmDeliveryCommand(o.Id(), o.ConfirmationCode())
+	err = handler.Handle(ctx, cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if getOrder(t, uow, o.Id()).Status() != order.StatusCompleted {
+		t.Fatal("expected order completed")
+	}
+
+	if getCourier(t, uow, c.Id()).StoragePlaces()[0].IsOccupied() {
+		t.Fatal("expected courier released")
+	}
+}
+
+func TestConfirmDeliveryCommandHandler_FailsDeliveryAfterMaxAttempts(t *testing.T) {
+
+	ctx, uow, clk := setupTest(t)
+
+	handler, err := commands.NewConfirmDeliveryCommandHandler(uow, 2, testIds, clk)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, _ := courier.NewCourier("courier", 1, newLocation(5, 5), testIds)
+	o, _ := order.NewOrder(uuid.New(), newLocation(5, 5), 5, 0, order.PriorityStandard, testIds, clk)
+	_ = o.RequireConfirmation(random.NewRandomSource(1))
+	_ = c.TakeOrder(o)
+	_ = o.AssignCourier(c.Id(), testIds, clk)
+	saveCouriers(t, uow, c)
+	saveOrders(t, uow, o)
+
+	cmd, _ := commands.NewConfirmDeliveryCommand(o.Id(), wrongCode(o.ConfirmationCode()))
+	for range 2 {
+		err = handler.Handle(ctx, cmd)
+		if !errors.Is(err, commands.ErrInvalidConfirmationCode) {
+			t.Fatalf("expected ErrInvalidConfirmationCode, got %v", err)
+		}
+	}
+
+	// товар остается у курьера, пока тот не сдаст его
+	saved := getOrder(t, uow, o.Id())
+	if saved.Status() != order.StatusDeliveryFailed || !saved.ReturnLocation().Equals(c.Location()) {
+		t.Fatal("expected delivery failed after max attempts")
+	}
+
+	if !getCourier(t, uow, c.Id()).StoragePlaces()[0].IsOccupied() {
+		t.Fatal("expected goods kept by courier")
+	}
+}
@@ -0,0 +1,36 @@
+package commands
+
+import (
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+)
+
+// arrive обрабатывает прибытие курьера в текущую точку заказа
+func arrive(cour *courier.Courier, assignedOrder *order.Order, ids ports.IDGenerator, clock ports.Clock) error {
+	switch {
+	// Дойдя до магазина или склада, курьер забирает товар и дальше везет его получателю
+	case assignedOrder.Status() == order.StatusAssignedToPickup:
+		return assignedOrder.PickUp(ids, clock)
+
+	// Недоставленный товар сдается на склад, место в багажнике освобождается
+	case assignedOrder.Status() == order.StatusDeliveryFailed:
+		err := cour.CompleteOrder(assignedOrder)
+		if err != nil {
+			return err
+		}
+
+		return assignedOrder.Return(ids, clock)
+
+	// Заказ с кодом подтверждения завершает ConfirmDeliveryCommand, а не прибытие курьера
+	case assignedOrder.IsOutForDelivery() && !assignedOrder.RequiresConfirmation():
+		err := cour.CompleteOrder(assignedOrder)
+		if err != nil {
+			return err
+		}
+
+		return assignedOrder.Complete(ids, clock)
+	}
+
+	return nil
+}
@@ -1,18 +1,24 @@
 package commands
 
 import (
+	"delivery/internal/core/domain/kernel"
 	"delivery/internal/pkg/errs"
 	"errors"
 	"strings"
 )
 
 type CreateCourierCommand struct {
-	name    string
-	speed   int
-	isValid bool
+	name          string
+	speed         int
+	startLocation kernel.Location
+	homeDepot     kernel.Location
+	isValid       bool
 }
 
-func NewCreateCourierCommand(name string, speed int) (CreateCourierCommand, error) {
+// NewCreateCourierCommand создает команду. startLocation и homeDepot необязательны (пустые точки):
+// без точки старта курьер начинает со склада, а без склада - со случайной точки зоны
+func NewCreateCourierCommand(name string, speed int, startLocation kernel.Location,
+	homeDepot kernel.Location) (CreateCourierCommand, error) {
 
 	if strings.TrimSpace(name) == "" {
 		return CreateCourierCommand{}, errs.NewValueIsRequiredError("name")
@@ -23,9 +29,11 @@ func NewCreateCourierCommand(name string, speed int) (CreateCourierCommand, erro
 	}
 
 	return CreateCourierCommand{
-		name:    name,
-		speed:   speed,
-		isValid: true,
+		name:          name,
+		speed:         speed,
+		startLocation: startLocation,
+		homeDepot:     homeDepot,
+		isValid:       true,
 	}, nil
 }
 
@@ -37,6 +45,14 @@ func (c CreateCourierCommand) Speed() int {
 	return c.speed
 }
 
+func (c CreateCourierCommand) StartLocation() kernel.Location {
+	return c.startLocation
+}
+
+func (c CreateCourierCommand) HomeDepot() kernel.Location {
+	return c.homeDepot
+}
+
 func (c CreateCourierCommand) IsValid() bool {
 	return c.isValid
 }
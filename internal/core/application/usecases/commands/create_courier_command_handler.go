@@ -15,16 +15,35 @@ type CreateCourierCommandHandler interface {
 var _ CreateCourierCommandHandler = &createCourierCommandHandler{}
 
 type createCourierCommandHandler struct {
-	uow ports.UnitOfWork
+	uow  ports.UnitOfWork
+	area kernel.ServiceArea
+	ids  ports.IDGenerator
+	rnd  ports.RandomSource
 }
 
-func NewCreateCourierCommandHandler(uow ports.UnitOfWork) (CreateCourierCommandHandler, error) {
+func NewCreateCourierCommandHandler(uow ports.UnitOfWork, area kernel.ServiceArea, ids ports.IDGenerator,
+	rnd ports.RandomSource) (CreateCourierCommandHandler, error) {
 	if uow == nil {
 		return nil, errs.NewValueIsRequiredError("uow")
 	}
 
+	if area.IsEmpty() {
+		return nil, errs.NewValueIsRequiredError("area")
+	}
+
+	if ids == nil {
+		return nil, errs.NewValueIsRequiredError("ids")
+	}
+
+	if rnd == nil {
+		return nil, errs.NewValueIsRequiredError("rnd")
+	}
+
 	return &createCourierCommandHandler{
-		uow: uow,
+		uow:  uow,
+		area: area,
+		ids:  ids,
+		rnd:  rnd,
 	}, nil
 }
 
@@ -34,11 +53,37 @@ func (c *createCourierCommandHandler) Handle(ctx context.Context, cmd CreateCour
 		return errs.NewValueIsInvalidError("cmd")
 	}
 
-	cour, err := courier.NewCourier(cmd.name, cmd.speed, kernel.NewRandomLocation())
+	start := cmd.startLocation
+	if start.IsEmpty() {
+		start = cmd.homeDepot
+	}
+
+	if start.IsEmpty() {
+		start = c.area.RandomLocation(c.rnd)
+	}
+
+	err := c.area.Validate(start)
+	if err != nil {
+		return err
+	}
+
+	cour, err := courier.NewCourier(cmd.name, cmd.speed, start, c.ids)
 	if err != nil {
 		return err
 	}
 
+	if !cmd.homeDepot.IsEmpty() {
+		err = c.area.Validate(cmd.homeDepot)
+		if err != nil {
+			return err
+		}
+
+		err = cour.SetHomeDepot(cmd.homeDepot)
+		if err != nil {
+			return err
+		}
+	}
+
 	return c.uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
 		return uowc.CourierRepository().Save(ctx, cour)
 	})
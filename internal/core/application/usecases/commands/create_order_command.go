@@ -1,20 +1,39 @@
 package commands
 
 import (
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/order"
 	"delivery/internal/pkg/errs"
 	"errors"
 	"github.com/google/uuid"
+	"slices"
 	"strings"
 )
 
 type CreateOrderCommand struct {
-	orderID uuid.UUID
-	street  string
-	volume  int
-	isValid bool
+	orderID      uuid.UUID
+	street       string
+	pickup       kernel.Location
+	volume       int
+	weight       int
+	priority     order.Priority
+	handling     order.Handling
+	restrictions []order.Restriction
+	items        []order.Item
+	isValid      bool
+
+	// mixedHandling - товары нельзя везти вместе, например горячее и замороженное
+	mixedHandling bool
 }
 
-func NewCreateOrderCommand(orderID uuid.UUID, street string, volume int) (CreateOrderCommand, error) {
+// NewCreateOrderCommand создает команду. Пустые приоритет и требования к перевозке означают обычный заказ.
+// handlings - требования к перевозке товаров заказа, несовместимые требования делают заказ смешанным.
+// pickup - магазин или склад, где курьер забирает товар; пустая точка - товар уже у курьера.
+// weight - вес в граммах, 0 - вес неизвестен и при назначении не учитывается.
+// restrictions - товары, которые может вручить только курьер с допуском.
+// items - единицы товара, по ним слишком большой заказ делится на отправления; пустой список - заказ не делится
+func NewCreateOrderCommand(orderID uuid.UUID, street string, pickup kernel.Location, volume int, weight int,
+	priority string, handlings []string, restrictions []string, items []order.Item) (CreateOrderCommand, error) {
 
 	if orderID == uuid.Nil {
 		return CreateOrderCommand{}, errs.NewValueIsRequiredError("orderID")
@@ -28,11 +47,71 @@ func NewCreateOrderCommand(orderID uuid.UUID, street string, volume int) (Create
 		return CreateOrderCommand{}, errors.New("volume must be greater than 0")
 	}
 
+	if weight < 0 {
+		return CreateOrderCommand{}, errors.New("weight must not be negative")
+	}
+
+	orderPriority := order.PriorityStandard
+	if priority != "" {
+		p, err := order.PriorityFromString(priority)
+		if err != nil {
+			return CreateOrderCommand{}, errs.NewValueIsInvalidErrorWithCause("priority", err)
+		}
+
+		orderPriority = p
+	}
+
+	itemHandlings := make([]order.Handling, 0, len(handlings))
+	for _, handling := range handlings {
+		if handling == "" {
+			continue
+		}
+
+		h, err := order.HandlingFromString(handling)
+		if err != nil {
+			return CreateOrderCommand{}, errs.NewValueIsInvalidErrorWithCause("handling", err)
+		}
+
+		itemHandlings = append(itemHandlings, h)
+	}
+
+	// Смешанный заказ едет отправлениями по условиям их товаров, сам он требований не имеет
+	orderHandling, err := order.CombineHandling(itemHandlings...)
+	mixedHandling := err != nil
+	if mixedHandling {
+		orderHandling = order.HandlingStandard
+	}
+
+	orderRestrictions := make([]order.Restriction, 0, len(restrictions))
+	for _, restriction := range restrictions {
+		r, err := order.RestrictionFromString(restriction)
+		if err != nil {
+			return CreateOrderCommand{}, errs.NewValueIsInvalidErrorWithCause("restrictions", err)
+		}
+
+		if !slices.Contains(orderRestrictions, r) {
+			orderRestrictions = append(orderRestrictions, r)
+		}
+	}
+
+	for _, item := range items {
+		if item.IsEmpty() {
+			return CreateOrderCommand{}, errs.NewValueIsInvalidError("items")
+		}
+	}
+
 	return CreateOrderCommand{
-		orderID: orderID,
-		street:  street,
-		volume:  volume,
-		isValid: true}, nil
+		orderID:       orderID,
+		street:        street,
+		pickup:        pickup,
+		volume:        volume,
+		weight:        weight,
+		priority:      orderPriority,
+		handling:      orderHandling,
+		mixedHandling: mixedHandling,
+		restrictions:  orderRestrictions,
+		items:         slices.Clone(items),
+		isValid:       true}, nil
 }
 
 func (c CreateOrderCommand) OrderID() uuid.UUID {
@@ -43,10 +122,38 @@ func (c CreateOrderCommand) Street() string {
 	return c.street
 }
 
+func (c CreateOrderCommand) Pickup() kernel.Location {
+	return c.pickup
+}
+
 func (c CreateOrderCommand) Volume() int {
 	return c.volume
 }
 
+func (c CreateOrderCommand) Weight() int {
+	return c.weight
+}
+
+func (c CreateOrderCommand) Priority() order.Priority {
+	return c.priority
+}
+
+func (c CreateOrderCommand) Handling() order.Handling {
+	return c.handling
+}
+
+func (c CreateOrderCommand) HasMixedHandling() bool {
+	return c.mixedHandling
+}
+
+func (c CreateOrderCommand) Restrictions() []order.Restriction {
+	return c.restrictions
+}
+
+func (c CreateOrderCommand) Items() []order.Item {
+	return c.items
+}
+
 func (c CreateOrderCommand) IsValid() bool {
 	return c.isValid
 }
@@ -2,10 +2,12 @@ package commands
 
 import (
 	"context"
+	"delivery/internal/core/domain/kernel"
 	"delivery/internal/core/domain/model/order"
 	"delivery/internal/core/ports"
 	"delivery/internal/pkg/errs"
 	"errors"
+	"fmt"
 )
 
 type CreateOrderCommandHandler interface {
@@ -15,11 +17,28 @@ type CreateOrderCommandHandler interface {
 var _ CreateOrderCommandHandler = &createOrderCommandHandler{}
 
 type createOrderCommandHandler struct {
-	uow ports.UnitOfWork
-	geo ports.GeoClient
+	uow  ports.UnitOfWork
+	geo  ports.GeoClient
+	area kernel.ServiceArea
+	// grid - в режиме geo переводит точные координаты адреса в клетку. Пустая - расстояния считаются по клеткам
+	grid  kernel.GeoGrid
+	ids   ports.IDGenerator
+	clock ports.Clock
+	// secrets - криптостойкий источник кодов подтверждения: генератор с seed для них предсказуем
+	secrets ports.RandomSource
+	// tokens - токены ссылок, по которым получатель отслеживает заказ
+	tokens ports.TrackingTokens
+	// requireConfirmation - заказ завершается только по коду, который получатель сообщает курьеру
+	requireConfirmation bool
+	// maxShipmentVolume, maxShipmentWeight - ограничения одного отправления. Заказ, который в них не помещается,
+	// делится на отправления. maxShipmentVolume = 0 - заказы не делятся, maxShipmentWeight = 0 - вес не ограничен
+	maxShipmentVolume int
+	maxShipmentWeight int
 }
 
-func NewCreateOrderCommandHandler(uow ports.UnitOfWork, geo ports.GeoClient) (CreateOrderCommandHandler, error) {
+func NewCreateOrderCommandHandler(uow ports.UnitOfWork, geo ports.GeoClient, area kernel.ServiceArea, grid kernel.GeoGrid,
+	ids ports.IDGenerator, clock ports.Clock, secrets ports.RandomSource, tokens ports.TrackingTokens,
+	requireConfirmation bool, maxShipmentVolume int, maxShipmentWeight int) (CreateOrderCommandHandler, error) {
 	if uow == nil {
 		return nil, errs.NewValueIsRequiredError("uow")
 	}
@@ -28,9 +47,47 @@ func NewCreateOrderCommandHandler(uow ports.UnitOfWork, geo ports.GeoClient) (Cr
 		return nil, errs.NewValueIsRequiredError("geo")
 	}
 
+	if area.IsEmpty() {
+		return nil, errs.NewValueIsRequiredError("area")
+	}
+
+	if ids == nil {
+		return nil, errs.NewValueIsRequiredError("ids")
+	}
+
+	if clock == nil {
+		return nil, errs.NewValueIsRequiredError("clock")
+	}
+
+	if secrets == nil {
+		return nil, errs.NewValueIsRequiredError("secrets")
+	}
+
+	if tokens == nil {
+		return nil, errs.NewValueIsRequiredError("tokens")
+	}
+
+	if maxShipmentVolume < 0 {
+		return nil, errs.NewValueIsInvalidError("maxShipmentVolume")
+	}
+
+	if maxShipmentWeight < 0 {
+		return nil, errs.NewValueIsInvalidError("maxShipmentWeight")
+	}
+
 	return &createOrderCommandHandler{
-		uow: uow,
-		geo: geo,
+		uow:   uow,
+		geo:   geo,
+		area:  area,
+		grid:  grid,
+		ids:   ids,
+		clock: clock,
+
+		secrets:             secrets,
+		tokens:              tokens,
+		requireConfirmation: requireConfirmation,
+		maxShipmentVolume:   maxShipmentVolume,
+		maxShipmentWeight:   maxShipmentWeight,
 	}, nil
 }
 
@@ -60,19 +117,124 @@ func (c *createOrderCommandHandler) Handle(ctx context.Context, cmd CreateOrderC
 	}
 
 	// Получаем координаты из geo сервиса
-	loc, err := c.geo.GetGeolocation(ctx, cmd.street)
+	loc, geo, err := c.geo.GetGeolocation(ctx, cmd.street)
 	if err != nil {
 		return err
 	}
 
+	// В режиме geo заказ хранит точные координаты адреса, клетка по ним определяется сеткой.
+	// Geo сервис отдает только клетку, тогда точкой заказа остается она
+	if !c.grid.IsEmpty() && !geo.IsEmpty() {
+		loc, err = c.grid.ToLocation(geo)
+		if err != nil {
+			return fmt.Errorf("order %s: %w", cmd.orderID, err)
+		}
+	}
+
+	// Заказы за пределами зоны обслуживания не принимаем
+	err = c.area.Validate(loc)
+	if err != nil {
+		return fmt.Errorf("order %s: %w", cmd.orderID, err)
+	}
+
+	if !cmd.pickup.IsEmpty() {
+		err = c.area.Validate(cmd.pickup)
+		if err != nil {
+			return fmt.Errorf("order %s pickup: %w", cmd.orderID, err)
+		}
+	}
+
 	// Сохраним заказ в хранилище
 	return c.uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
 
-		ord, err := order.NewOrder(cmd.orderID, loc, cmd.volume)
+		var ord *order.Order
+		if cmd.pickup.IsEmpty() {
+			ord, err = order.NewOrder(cmd.orderID, loc, cmd.volume, cmd.weight, cmd.priority, c.ids, c.clock)
+		} else {
+			ord, err = order.NewPickupOrder(cmd.orderID, cmd.pickup, loc, cmd.volume, cmd.weight, cmd.priority, c.ids, c.clock)
+		}
+		if err != nil {
+			return err
+		}
+
+		err = ord.RequireHandling(cmd.handling)
 		if err != nil {
 			return err
 		}
 
-		return uowc.OrderRepository().Save(ctx, ord)
+		for _, restriction := range cmd.restrictions {
+			err = ord.AddRestriction(restriction)
+			if err != nil {
+				return err
+			}
+		}
+
+		if c.requireConfirmation {
+			err = ord.RequireConfirmation(c.secrets)
+			if err != nil {
+				return err
+			}
+		}
+
+		token, err := c.tokens.Issue(ord.Id(), c.clock.Now())
+		if err != nil {
+			return err
+		}
+
+		err = ord.AttachTrackingToken(token)
+		if err != nil {
+			return err
+		}
+
+		// Отправления наследуют код подтверждения, поэтому заказ делится последним
+		shipments, err := c.split(ord, cmd)
+		if err != nil {
+			return err
+		}
+
+		// Смешанный заказ без списка товаров разделить нельзя, а целиком его не возьмет ни один курьер
+		if cmd.mixedHandling && shipments == nil {
+			err = ord.Reject("incompatible handling requirements", c.ids, c.clock)
+			if err != nil {
+				return err
+			}
+		}
+
+		return uowc.OrderRepository().Save(ctx, append([]*order.Order{ord}, shipments...)...)
 	})
 }
+
+// split делит заказ, который не помещается в одно отправление или товары которого нельзя везти вместе.
+// Без списка товаров границы отправлений неизвестны, такой заказ не делится
+func (c *createOrderCommandHandler) split(ord *order.Order, cmd CreateOrderCommand) ([]*order.Order, error) {
+	if len(cmd.items) == 0 {
+		return nil, nil
+	}
+
+	// Без ограничений на отправление смешанный заказ делится только по условиям перевозки
+	maxVolume := c.maxShipmentVolume
+	if maxVolume == 0 {
+		if !cmd.mixedHandling {
+			return nil, nil
+		}
+
+		maxVolume = ord.Volume()
+	}
+
+	fitsVolume := ord.Volume() <= maxVolume
+	fitsWeight := c.maxShipmentWeight == 0 || ord.Weight() <= c.maxShipmentWeight
+	if fitsVolume && fitsWeight && !cmd.mixedHandling {
+		return nil, nil
+	}
+
+	groups, err := order.PackItems(cmd.items, maxVolume, c.maxShipmentWeight)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(groups) < 2 {
+		return nil, nil
+	}
+
+	return ord.Split(groups, c.ids, c.clock)
+}
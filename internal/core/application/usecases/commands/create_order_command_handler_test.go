@@ -0,0 +1,185 @@
+package commands_test
+
+import (
+	"bytes"
+	"context"
+	"delivery/internal/adapters/out/random"
+	"delivery/internal/adapters/out/tracking"
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/services"
+	"delivery/internal/core/ports"
+	"github.com/google/uuid"
+	"testing"
+	"time"
+)
+
+// fakeGeoClient находит любой адрес в одной клетке
+type fakeGeoClient struct {
+	location kernel.Location
+}
+
+func (g *fakeGeoClient) GetGeolocation(_ context.Context, _ string) (kernel.Location, kernel.GeoLocation, error) {
+	return g.location, kernel.GeoLocation{}, nil
+}
+
+func (g *fakeGeoClient) Close() error {
+	return nil
+}
+
+func newCreateOrderHandler(t *testing.T, uow ports.UnitOfWork, clk ports.Clock, maxShipmentVolume int) commands.CreateOrderCommandHandler {
+	area, _ := kernel.NewServiceArea(10, 10)
+	tokens, err := tracking.NewHmacTokens(bytes.Repeat([]byte("s"), tracking.MinSecretSize), time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	handler, err := commands.NewCreateOrderCommandHandler(uow, &fakeGeoClient{location: newLocation(5, 5)}, area,
+		kernel.GeoGrid{}, testIds, clk, random.NewSecureRandomSource(), tokens, false, maxShipmentVolume, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return handler
+}
+
+func mustNewItem(t *testing.T, volume int, handling order.Handling) order.Item {
+	item, err := order.NewItem(volume, 0, handling)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return item
+}
+
+func TestCreateOrderCommandHandler_RejectsMixedOrderWithoutItems(t *testing.T) {
+
+	ctx, uow, clk := setupTest(t)
+	handler := newCreateOrderHandler(t, uow, clk, 0)
+
+	cmd, err := commands.NewCreateOrderCommand(uuid.New(), "street", kernel.Location{}, 5, 0, "",
+		[]string{"hot", "frozen"}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = handler.Handle(ctx, cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// ни один курьер не возьмет горячее и замороженное вместе, поэтому заказ сразу отклоняется
+	saved := getOrder(t, uow, cmd.OrderID())
+	if saved.Status() != order.StatusUndeliverable {
+		t.Fatalf("expected undeliverable, got %s", saved.Status())
+	}
+}
+
+func TestCreateOrderCommandHandler_SplitOrderFinishesWithShipments(t *testing.T) {
+
+	tests := []struct {
+		name           string
+		failLast       bool
+		expectedStatus order.Status
+	}{
+		{
+			name:           "all delivered",
+			failLast:       false,
+			expectedStatus: order.StatusCompleted,
+		},
+		{
+			name:           "one returned",
+			failLast:       true,
+			expectedStatus: order.StatusReturned,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			ctx, uow, clk := setupTest(t)
+			handler := newCreateOrderHandler(t, uow, clk, 10)
+
+			move, err := commands.NewMoveCouriersCommandHandler(uow, kernel.NewGridDistanceCalculator(), clk, testIds,
+				services.NewStayPolicy())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			fail, err := commands.NewFailDeliveryCommandHandler(uow, testIds, clk)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			items := []order.Item{
+				mustNewItem(t, 8, order.HandlingStandard),
+				mustNewItem(t, 8, order.HandlingStandard),
+			}
+			cmd, err := commands.NewCreateOrderCommand(uuid.New(), "street", kernel.Location{}, 16, 0, "", nil, nil, items)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = handler.Handle(ctx, cmd)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if getOrder(t, uow, cmd.OrderID()).Status() != order.StatusSplit {
+				t.Fatal("expected order split")
+			}
+
+			var shipments []*order.Order
+			err = uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+				shipments, err = uowc.OrderRepository().GetShipments(ctx, cmd.OrderID())
+				return err
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(shipments) != 2 {
+				t.Fatalf("expected 2 shipments, got %d", len(shipments))
+			}
+
+			// каждое отправление везет свой курьер, оба уже у получателя
+			couriers := make([]*courier.Courier, 0, len(shipments))
+			for _, shipment := range shipments {
+				c, _ := courier.NewCourier("courier", 1, newLocation(5, 5), testIds)
+				_ = c.TakeOrder(shipment)
+				_ = shipment.AssignCourier(c.Id(), testIds, clk)
+				couriers = append(couriers, c)
+			}
+			saveCouriers(t, uow, couriers...)
+			saveOrders(t, uow, shipments...)
+
+			last := shipments[len(shipments)-1]
+			if test.failLast {
+				failCmd, _ := commands.NewFailDeliveryCommand(last.Id(), "customer not home", false)
+				err = fail.Handle(ctx, failCmd)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			// за один такт закрываются все отправления, последнее должно увидеть остальные уже сохраненными
+			err = move.Handle(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, shipment := range shipments {
+				if !getOrder(t, uow, shipment.Id()).Status().IsFinished() {
+					t.Fatal("expected shipment finished")
+				}
+			}
+
+			saved := getOrder(t, uow, cmd.OrderID())
+			if saved.Status() != test.expectedStatus {
+				t.Fatalf("expected %s, got %s", test.expectedStatus, saved.Status())
+			}
+		})
+	}
+}
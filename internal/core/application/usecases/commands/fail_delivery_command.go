@@ -0,0 +1,49 @@
+package commands
+
+import (
+	"delivery/internal/pkg/errs"
+	"github.com/google/uuid"
+	"strings"
+)
+
+type FailDeliveryCommand struct {
+	orderID uuid.UUID
+	reason  string
+	retry   bool
+	isValid bool
+}
+
+// NewFailDeliveryCommand создает команду. С retry заказ после возврата на склад снова ждет курьера
+func NewFailDeliveryCommand(orderID uuid.UUID, reason string, retry bool) (FailDeliveryCommand, error) {
+
+	if orderID == uuid.Nil {
+		return FailDeliveryCommand{}, errs.NewValueIsRequiredError("orderID")
+	}
+
+	if strings.TrimSpace(reason) == "" {
+		return FailDeliveryCommand{}, errs.NewValueIsRequiredError("reason")
+	}
+
+	return FailDeliveryCommand{
+		orderID: orderID,
+		reason:  reason,
+		retry:   retry,
+		isValid: true,
+	}, nil
+}
+
+func (c FailDeliveryCommand) OrderID() uuid.UUID {
+	return c.orderID
+}
+
+func (c FailDeliveryCommand) Reason() string {
+	return c.reason
+}
+
+func (c FailDeliveryCommand) Retry() bool {
+	return c.retry
+}
+
+func (c FailDeliveryCommand) IsValid() bool {
+	return c.isValid
+}
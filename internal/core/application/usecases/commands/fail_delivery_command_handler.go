@@ -0,0 +1,76 @@
+package commands
+
+import (
+	"context"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+)
+
+type FailDeliveryCommandHandler interface {
+	Handle(context.Context, FailDeliveryCommand) error
+}
+
+var _ FailDeliveryCommandHandler = &failDeliveryCommandHandler{}
+
+type failDeliveryCommandHandler struct {
+	uow   ports.UnitOfWork
+	ids   ports.IDGenerator
+	clock ports.Clock
+}
+
+func NewFailDeliveryCommandHandler(uow ports.UnitOfWork, ids ports.IDGenerator,
+	clock ports.Clock) (FailDeliveryCommandHandler, error) {
+	if uow == nil {
+		return nil, errs.NewValueIsRequiredError("uow")
+	}
+
+	if ids == nil {
+		return nil, errs.NewValueIsRequiredError("ids")
+	}
+
+	if clock == nil {
+		return nil, errs.NewValueIsRequiredError("clock")
+	}
+
+	return &failDeliveryCommandHandler{
+		uow:   uow,
+		ids:   ids,
+		clock: clock,
+	}, nil
+}
+
+func (c *failDeliveryCommandHandler) Handle(ctx context.Context, cmd FailDeliveryCommand) error {
+
+	if !cmd.isValid {
+		return errs.NewValueIsInvalidError("cmd")
+	}
+
+	return c.uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+
+		ord, err := uowc.OrderRepository().Get(ctx, cmd.orderID)
+		if err != nil {
+			return err
+		}
+
+		if ord == nil || ord.CourierId() == nil {
+			return errs.NewObjectNotFoundError("orderID", cmd.orderID)
+		}
+
+		cour, err := uowc.CourierRepository().Get(ctx, *ord.CourierId())
+		if err != nil {
+			return err
+		}
+
+		if cour == nil {
+			return errs.NewObjectNotFoundError("courierID", *ord.CourierId())
+		}
+
+		// Товар остается у курьера, место в багажнике освободится, когда MoveCouriers довезет его до склада
+		err = ord.FailDelivery(cmd.reason, cour.ReturnLocation(ord), cmd.retry, c.ids, c.clock)
+		if err != nil {
+			return err
+		}
+
+		return uowc.OrderRepository().Save(ctx, ord)
+	})
+}
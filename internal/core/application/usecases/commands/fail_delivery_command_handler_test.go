@@ -0,0 +1,97 @@
+package commands_test
+
+import (
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/services"
+	"github.com/google/uuid"
+	"testing"
+	"time"
+)
+
+func TestFailDeliveryCommandHandler_Handle(t *testing.T) {
+
+	tests := []struct {
+		name           string
+		retry          bool
+		expectedStatus order.Status
+	}{
+		{
+			name:           "retry",
+			retry:          true,
+			expectedStatus: order.StatusCreated,
+		},
+		{
+			name:           "return to depot",
+			retry:          false,
+			expectedStatus: order.StatusReturned,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			ctx, uow, clk := setupTest(t)
+
+			handler, err := commands.NewFailDeliveryCommandHandler(uow, testIds, clk)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			move, err := commands.NewMoveCouriersCommandHandler(uow, kernel.NewGridDistanceCalculator(), clk, testIds,
+				services.NewStayPolicy())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			depot := newLocation(8, 8)
+			c, _ := courier.NewCourier("courier", 10, newLocation(5, 5), testIds)
+			_ = c.SetHomeDepot(depot)
+			o, _ := order.NewOrder(uuid.New(), newLocation(5, 6), 5, 0, order.PriorityStandard, testIds, clk)
+			_ = c.TakeOrder(o)
+			_ = o.AssignCourier(c.Id(), testIds, clk)
+			saveCouriers(t, uow, c)
+			saveOrders(t, uow, o)
+
+			cmd, _ := commands.NewFailDeliveryCommand(o.Id(), "customer not home", test.retry)
+			err = handler.Handle(ctx, cmd)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// курьер везет товар на свой склад
+			saved := getOrder(t, uow, o.Id())
+			if saved.Status() != order.StatusDeliveryFailed || !saved.ReturnLocation().Equals(depot) {
+				t.Fatal("expected goods returned to depot")
+			}
+
+			if !getCourier(t, uow, c.Id()).StoragePlaces()[0].IsOccupied() {
+				t.Fatal("expected goods kept by courier until return")
+			}
+
+			// первый такт запоминает время старта, за второй курьер доезжает до склада
+			_ = move.Handle(ctx)
+			clk.Advance(time.Second)
+			err = move.Handle(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			saved = getOrder(t, uow, o.Id())
+			if saved.Status() != test.expectedStatus {
+				t.Fatalf("expected %s, got %s", test.expectedStatus, saved.Status())
+			}
+
+			// заказ с повторной доставкой снова ждет курьера и забирается со склада
+			if test.retry && (saved.CourierId() != nil || !saved.PickupLocation().Equals(depot)) {
+				t.Fatal("expected order picked up from depot on retry")
+			}
+
+			if getCourier(t, uow, c.Id()).StoragePlaces()[0].IsOccupied() {
+				t.Fatal("expected storage released on return")
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package commands
+
+import (
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/pkg/errs"
+	"github.com/google/uuid"
+)
+
+type GrantQualificationCommand struct {
+	courierID     uuid.UUID
+	qualification courier.Qualification
+	isValid       bool
+}
+
+// NewGrantQualificationCommand создает команду выдачи курьеру допуска
+func NewGrantQualificationCommand(courierID uuid.UUID, qualification string) (GrantQualificationCommand, error) {
+
+	if courierID == uuid.Nil {
+		return GrantQualificationCommand{}, errs.NewValueIsRequiredError("courierID")
+	}
+
+	if qualification == "" {
+		return GrantQualificationCommand{}, errs.NewValueIsRequiredError("qualification")
+	}
+
+	q, err := courier.QualificationFromString(qualification)
+	if err != nil {
+		return GrantQualificationCommand{}, errs.NewValueIsInvalidErrorWithCause("qualification", err)
+	}
+
+	return GrantQualificationCommand{
+		courierID:     courierID,
+		qualification: q,
+		isValid:       true,
+	}, nil
+}
+
+func (c GrantQualificationCommand) CourierID() uuid.UUID {
+	return c.courierID
+}
+
+func (c GrantQualificationCommand) Qualification() courier.Qualification {
+	return c.qualification
+}
+
+func (c GrantQualificationCommand) IsValid() bool {
+	return c.isValid
+}
@@ -0,0 +1,51 @@
+package commands
+
+import (
+	"context"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+)
+
+type GrantQualificationCommandHandler interface {
+	Handle(context.Context, GrantQualificationCommand) error
+}
+
+var _ GrantQualificationCommandHandler = &grantQualificationCommandHandler{}
+
+type grantQualificationCommandHandler struct {
+	uow ports.UnitOfWork
+}
+
+func NewGrantQualificationCommandHandler(uow ports.UnitOfWork) (GrantQualificationCommandHandler, error) {
+	if uow == nil {
+		return nil, errs.NewValueIsRequiredError("uow")
+	}
+
+	return &grantQualificationCommandHandler{uow: uow}, nil
+}
+
+func (c *grantQualificationCommandHandler) Handle(ctx context.Context, cmd GrantQualificationCommand) error {
+
+	if !cmd.isValid {
+		return errs.NewValueIsInvalidError("cmd")
+	}
+
+	return c.uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+
+		cour, err := uowc.CourierRepository().Get(ctx, cmd.courierID)
+		if err != nil {
+			return err
+		}
+
+		if cour == nil {
+			return errs.NewObjectNotFoundError("courierID", cmd.courierID)
+		}
+
+		err = cour.GrantQualification(cmd.qualification)
+		if err != nil {
+			return err
+		}
+
+		return uowc.CourierRepository().Save(ctx, cour)
+	})
+}
@@ -0,0 +1,53 @@
+package commands
+
+import (
+	"delivery/internal/pkg/errs"
+	"github.com/google/uuid"
+	"strings"
+)
+
+type ManualAssignOrderCommand struct {
+	orderID   uuid.UUID
+	courierID uuid.UUID
+	operator  string
+	isValid   bool
+}
+
+// NewManualAssignOrderCommand создает команду. operator - оператор, который назначает курьера вместо диспетчера
+func NewManualAssignOrderCommand(orderID uuid.UUID, courierID uuid.UUID, operator string) (ManualAssignOrderCommand, error) {
+
+	if orderID == uuid.Nil {
+		return ManualAssignOrderCommand{}, errs.NewValueIsRequiredError("orderID")
+	}
+
+	if courierID == uuid.Nil {
+		return ManualAssignOrderCommand{}, errs.NewValueIsRequiredError("courierID")
+	}
+
+	if strings.TrimSpace(operator) == "" {
+		return ManualAssignOrderCommand{}, errs.NewValueIsRequiredError("operator")
+	}
+
+	return ManualAssignOrderCommand{
+		orderID:   orderID,
+		courierID: courierID,
+		operator:  operator,
+		isValid:   true,
+	}, nil
+}
+
+func (c ManualAssignOrderCommand) OrderID() uuid.UUID {
+	return c.orderID
+}
+
+func (c ManualAssignOrderCommand) CourierID() uuid.UUID {
+	return c.courierID
+}
+
+func (c ManualAssignOrderCommand) Operator() string {
+	return c.operator
+}
+
+func (c ManualAssignOrderCommand) IsValid() bool {
+	return c.isValid
+}
@@ -0,0 +1,90 @@
+package commands
+
+import (
+	"context"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+	"errors"
+	"fmt"
+)
+
+// ErrCourierCannotTakeOrder - выбранный оператором курьер не может взять заказ (нет места, допуска и т.п.)
+var ErrCourierCannotTakeOrder = errors.New("courier can not take order")
+
+type ManualAssignOrderCommandHandler interface {
+	Handle(context.Context, ManualAssignOrderCommand) error
+}
+
+var _ ManualAssignOrderCommandHandler = &manualAssignOrderCommandHandler{}
+
+type manualAssignOrderCommandHandler struct {
+	uow   ports.UnitOfWork
+	ids   ports.IDGenerator
+	clock ports.Clock
+}
+
+func NewManualAssignOrderCommandHandler(uow ports.UnitOfWork, ids ports.IDGenerator,
+	clock ports.Clock) (ManualAssignOrderCommandHandler, error) {
+	if uow == nil {
+		return nil, errs.NewValueIsRequiredError("uow")
+	}
+
+	if ids == nil {
+		return nil, errs.NewValueIsRequiredError("ids")
+	}
+
+	if clock == nil {
+		return nil, errs.NewValueIsRequiredError("clock")
+	}
+
+	return &manualAssignOrderCommandHandler{
+		uow:   uow,
+		ids:   ids,
+		clock: clock,
+	}, nil
+}
+
+func (c *manualAssignOrderCommandHandler) Handle(ctx context.Context, cmd ManualAssignOrderCommand) error {
+
+	if !cmd.isValid {
+		return errs.NewValueIsInvalidError("cmd")
+	}
+
+	return c.uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+
+		ord, err := uowc.OrderRepository().Get(ctx, cmd.orderID)
+		if err != nil {
+			return err
+		}
+
+		if ord == nil {
+			return errs.NewObjectNotFoundError("orderID", cmd.orderID)
+		}
+
+		cour, err := uowc.CourierRepository().Get(ctx, cmd.courierID)
+		if err != nil {
+			return err
+		}
+
+		if cour == nil {
+			return errs.NewObjectNotFoundError("courierID", cmd.courierID)
+		}
+
+		err = cour.TakeOrder(ord)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrCourierCannotTakeOrder, err)
+		}
+
+		err = ord.Assign(cour.Id(), cmd.operator, c.ids, c.clock)
+		if err != nil {
+			return err
+		}
+
+		err = uowc.CourierRepository().Save(ctx, cour)
+		if err != nil {
+			return err
+		}
+
+		return uowc.OrderRepository().Save(ctx, ord)
+	})
+}
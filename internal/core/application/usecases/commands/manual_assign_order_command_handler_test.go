@@ -0,0 +1,82 @@
+package commands_test
+
+import (
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+	"errors"
+	"github.com/google/uuid"
+	"testing"
+)
+
+func TestManualAssignOrderCommandHandler_RejectsCourierWhoCannotTakeOrder(t *testing.T) {
+
+	ctx, uow, clk := setupTest(t)
+
+	handler, err := commands.NewManualAssignOrderCommandHandler(uow, testIds, clk)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// у занятого курьера единственная сумка уже занята
+	busy, _ := courier.NewCourier("busy", 1, newLocation(1, 1), testIds)
+	busyWith, _ := order.NewOrder(uuid.New(), newLocation(2, 2), 5, 0, order.PriorityStandard, testIds, clk)
+	_ = busy.TakeOrder(busyWith)
+	_ = busyWith.AssignCourier(busy.Id(), testIds, clk)
+
+	// у свободного курьера нет допуска к алкоголю
+	unqualified, _ := courier.NewCourier("unqualified", 1, newLocation(1, 1), testIds)
+
+	o, _ := order.NewOrder(uuid.New(), newLocation(5, 5), 5, 0, order.PriorityStandard, testIds, clk)
+	_ = o.AddRestriction(order.RestrictionAlcohol)
+
+	saveCouriers(t, uow, busy, unqualified)
+	saveOrders(t, uow, busyWith, o)
+
+	for _, c := range []*courier.Courier{busy, unqualified} {
+		cmd, _ := commands.NewManualAssignOrderCommand(o.Id(), c.Id(), "operator")
+		err = handler.Handle(ctx, cmd)
+		if !errors.Is(err, commands.ErrCourierCannotTakeOrder) {
+			t.Fatalf("%s: expected ErrCourierCannotTakeOrder, got %v", c.Name(), err)
+		}
+	}
+
+	saved := getOrder(t, uow, o.Id())
+	if saved.Status() != order.StatusCreated || saved.CourierId() != nil || saved.AssignedBy() != "" {
+		t.Fatal("expected order not assigned")
+	}
+
+	if getCourier(t, uow, unqualified.Id()).StoragePlaces()[0].IsOccupied() {
+		t.Fatal("expected courier storage untouched")
+	}
+}
+
+func TestManualAssignOrderCommandHandler_AssignsOrder(t *testing.T) {
+
+	ctx, uow, clk := setupTest(t)
+
+	handler, err := commands.NewManualAssignOrderCommandHandler(uow, testIds, clk)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, _ := courier.NewCourier("courier", 1, newLocation(1, 1), testIds)
+	o, _ := order.NewOrder(uuid.New(), newLocation(5, 5), 5, 0, order.PriorityStandard, testIds, clk)
+	saveCouriers(t, uow, c)
+	saveOrders(t, uow, o)
+
+	cmd, _ := commands.NewManualAssignOrderCommand(o.Id(), c.Id(), "operator")
+	err = handler.Handle(ctx, cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	saved := getOrder(t, uow, o.Id())
+	if saved.Status() != order.StatusAssigned || *saved.CourierId() != c.Id() || saved.AssignedBy() != "operator" {
+		t.Fatal("expected order assigned by operator")
+	}
+
+	if !getCourier(t, uow, c.Id()).StoragePlaces()[0].IsOccupied() {
+		t.Fatal("expected order stored by courier")
+	}
+}
@@ -2,8 +2,13 @@ package commands
 
 import (
 	"context"
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/services"
 	"delivery/internal/core/ports"
 	"delivery/internal/pkg/errs"
+	"github.com/google/uuid"
+	"time"
 )
 
 type MoveCouriersCommandHandler interface {
@@ -13,21 +18,49 @@ type MoveCouriersCommandHandler interface {
 var _ MoveCouriersCommandHandler = &moveCouriersCommandHandler{}
 
 type moveCouriersCommandHandler struct {
-	uow ports.UnitOfWork
+	uow   ports.UnitOfWork
+	calc  kernel.DistanceCalculator
+	clock ports.Clock
+	ids   ports.IDGenerator
+	idle  services.IdleCourierPolicy
 }
 
-func NewMoveCouriersCommandHandler(uow ports.UnitOfWork) (MoveCouriersCommandHandler, error) {
+func NewMoveCouriersCommandHandler(uow ports.UnitOfWork, calc kernel.DistanceCalculator, clock ports.Clock,
+	ids ports.IDGenerator, idle services.IdleCourierPolicy) (MoveCouriersCommandHandler, error) {
 	if uow == nil {
 		return nil, errs.NewValueIsRequiredError("uow")
 	}
 
+	if calc == nil {
+		return nil, errs.NewValueIsRequiredError("calc")
+	}
+
+	if clock == nil {
+		return nil, errs.NewValueIsRequiredError("clock")
+	}
+
+	if ids == nil {
+		return nil, errs.NewValueIsRequiredError("ids")
+	}
+
+	if idle == nil {
+		return nil, errs.NewValueIsRequiredError("idle")
+	}
+
 	return &moveCouriersCommandHandler{
-		uow: uow,
+		uow:   uow,
+		calc:  calc,
+		clock: clock,
+		ids:   ids,
+		idle:  idle,
 	}, nil
 }
 
 func (c *moveCouriersCommandHandler) Handle(ctx context.Context) error {
 
+	// Все курьеры перемещаются на одно и то же время, сколько бы ни длилась обработка
+	now := c.clock.Now()
+
 	return c.uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
 
 		assignedOrders, err := uowc.OrderRepository().GetAllInAssignedStatus(ctx)
@@ -35,8 +68,9 @@ func (c *moveCouriersCommandHandler) Handle(ctx context.Context) error {
 			return err
 		}
 
-		if assignedOrders == nil || len(assignedOrders) == 0 {
-			return nil // no assigned orders - no error here
+		waiting, err := c.couriersAwaitingConfirmation(ctx, uowc, assignedOrders)
+		if err != nil {
+			return err
 		}
 
 		for _, assignedOrder := range assignedOrders {
@@ -46,18 +80,28 @@ func (c *moveCouriersCommandHandler) Handle(ctx context.Context) error {
 				return err
 			}
 
-			err = cour.Move(assignedOrder.Location())
-			if err != nil {
-				return err
+			// Курьер ждет код подтверждения у получателя и не едет по другим заказам
+			if waiting[cour.Id()] {
+				cour.Wait(now)
+
+				err = uowc.CourierRepository().Save(ctx, cour)
+				if err != nil {
+					return err
+				}
+
+				continue
 			}
 
-			if assignedOrder.Location().Equals(cour.Location()) {
-				err = cour.CompleteOrder(assignedOrder)
+			// Курьер мог получить заказ, уже стоя в точке забора или доставки
+			if !assignedOrder.CurrentTarget().Equals(cour.Location()) {
+				err = cour.Move(assignedOrder.CurrentTarget(), c.calc, now, c.ids)
 				if err != nil {
 					return err
 				}
+			}
 
-				err := assignedOrder.Complete()
+			if assignedOrder.CurrentTarget().Equals(cour.Location()) {
+				err = arrive(cour, assignedOrder, c.ids, c.clock)
 				if err != nil {
 					return err
 				}
@@ -72,8 +116,65 @@ func (c *moveCouriersCommandHandler) Handle(ctx context.Context) error {
 			if err != nil {
 				return err
 			}
+
+			err = finishSplitOrder(ctx, uowc, assignedOrder, c.ids, c.clock)
+			if err != nil {
+				return err
+			}
 		}
 
-		return nil
+		return c.moveIdleCouriers(ctx, uowc, now)
 	})
 }
+
+// couriersAwaitingConfirmation возвращает курьеров, которые стоят у получателя заказа с кодом подтверждения
+func (c *moveCouriersCommandHandler) couriersAwaitingConfirmation(ctx context.Context, uowc ports.UnitOfWorkComponents,
+	assignedOrders []*order.Order) (map[uuid.UUID]bool, error) {
+
+	waiting := map[uuid.UUID]bool{}
+	for _, assignedOrder := range assignedOrders {
+		if !assignedOrder.RequiresConfirmation() || !assignedOrder.IsOutForDelivery() {
+			continue
+		}
+
+		cour, err := uowc.CourierRepository().Get(ctx, *assignedOrder.CourierId())
+		if err != nil {
+			return nil, err
+		}
+
+		if assignedOrder.Location().Equals(cour.Location()) {
+			waiting[cour.Id()] = true
+		}
+	}
+
+	return waiting, nil
+}
+
+// moveIdleCouriers ведет свободных курьеров к точке, заданной политикой (склад или точка ожидания)
+func (c *moveCouriersCommandHandler) moveIdleCouriers(ctx context.Context, uowc ports.UnitOfWorkComponents,
+	now time.Time) error {
+
+	freeCouriers, err := uowc.CourierRepository().GetAllFree(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, cour := range freeCouriers {
+		destination, ok := c.idle.Destination(cour)
+		if !ok || destination.Equals(cour.Location()) {
+			continue
+		}
+
+		err = cour.Move(destination, c.calc, now, c.ids)
+		if err != nil {
+			return err
+		}
+
+		err = uowc.CourierRepository().Save(ctx, cour)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
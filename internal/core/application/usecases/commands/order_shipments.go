@@ -0,0 +1,38 @@
+package commands
+
+import (
+	"context"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+)
+
+// finishSplitOrder закрывает разделенный заказ, когда закрыто последнее из его отправлений.
+// Отправление должно быть уже сохранено, иначе репозиторий вернет его в прежнем статусе
+func finishSplitOrder(ctx context.Context, uowc ports.UnitOfWorkComponents, shipment *order.Order,
+	ids ports.IDGenerator, clock ports.Clock) error {
+	if !shipment.IsShipment() || !shipment.Status().IsFinished() {
+		return nil
+	}
+
+	parent, err := uowc.OrderRepository().Get(ctx, *shipment.ParentId())
+	if err != nil {
+		return err
+	}
+
+	if parent == nil {
+		return errs.NewObjectNotFoundError("parentID", *shipment.ParentId())
+	}
+
+	shipments, err := uowc.OrderRepository().GetShipments(ctx, parent.Id())
+	if err != nil {
+		return err
+	}
+
+	finished, err := parent.FinishShipments(shipments, ids, clock)
+	if err != nil || !finished {
+		return err
+	}
+
+	return uowc.OrderRepository().Save(ctx, parent)
+}
@@ -0,0 +1,53 @@
+package commands
+
+import (
+	"delivery/internal/pkg/errs"
+	"github.com/google/uuid"
+	"strings"
+)
+
+type ReassignOrderCommand struct {
+	orderID   uuid.UUID
+	courierID uuid.UUID
+	operator  string
+	isValid   bool
+}
+
+// NewReassignOrderCommand создает команду. courierID - курьер, которому оператор передает заказ
+func NewReassignOrderCommand(orderID uuid.UUID, courierID uuid.UUID, operator string) (ReassignOrderCommand, error) {
+
+	if orderID == uuid.Nil {
+		return ReassignOrderCommand{}, errs.NewValueIsRequiredError("orderID")
+	}
+
+	if courierID == uuid.Nil {
+		return ReassignOrderCommand{}, errs.NewValueIsRequiredError("courierID")
+	}
+
+	if strings.TrimSpace(operator) == "" {
+		return ReassignOrderCommand{}, errs.NewValueIsRequiredError("operator")
+	}
+
+	return ReassignOrderCommand{
+		orderID:   orderID,
+		courierID: courierID,
+		operator:  operator,
+		isValid:   true,
+	}, nil
+}
+
+func (c ReassignOrderCommand) OrderID() uuid.UUID {
+	return c.orderID
+}
+
+func (c ReassignOrderCommand) CourierID() uuid.UUID {
+	return c.courierID
+}
+
+func (c ReassignOrderCommand) Operator() string {
+	return c.operator
+}
+
+func (c ReassignOrderCommand) IsValid() bool {
+	return c.isValid
+}
@@ -0,0 +1,106 @@
+package commands
+
+import (
+	"context"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+	"fmt"
+)
+
+type ReassignOrderCommandHandler interface {
+	Handle(context.Context, ReassignOrderCommand) error
+}
+
+var _ ReassignOrderCommandHandler = &reassignOrderCommandHandler{}
+
+type reassignOrderCommandHandler struct {
+	uow   ports.UnitOfWork
+	ids   ports.IDGenerator
+	clock ports.Clock
+}
+
+func NewReassignOrderCommandHandler(uow ports.UnitOfWork, ids ports.IDGenerator,
+	clock ports.Clock) (ReassignOrderCommandHandler, error) {
+	if uow == nil {
+		return nil, errs.NewValueIsRequiredError("uow")
+	}
+
+	if ids == nil {
+		return nil, errs.NewValueIsRequiredError("ids")
+	}
+
+	if clock == nil {
+		return nil, errs.NewValueIsRequiredError("clock")
+	}
+
+	return &reassignOrderCommandHandler{
+		uow:   uow,
+		ids:   ids,
+		clock: clock,
+	}, nil
+}
+
+func (c *reassignOrderCommandHandler) Handle(ctx context.Context, cmd ReassignOrderCommand) error {
+
+	if !cmd.isValid {
+		return errs.NewValueIsInvalidError("cmd")
+	}
+
+	// Оба курьера и заказ сохраняются в одной транзакции: заказ не может остаться без курьера или у двух сразу
+	return c.uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+
+		ord, err := uowc.OrderRepository().Get(ctx, cmd.orderID)
+		if err != nil {
+			return err
+		}
+
+		if ord == nil {
+			return errs.NewObjectNotFoundError("orderID", cmd.orderID)
+		}
+
+		if ord.CourierId() == nil {
+			return order.ErrNotReassignable
+		}
+
+		from, err := uowc.CourierRepository().Get(ctx, *ord.CourierId())
+		if err != nil {
+			return err
+		}
+
+		if from == nil {
+			return errs.NewObjectNotFoundError("courierID", *ord.CourierId())
+		}
+
+		to, err := uowc.CourierRepository().Get(ctx, cmd.courierID)
+		if err != nil {
+			return err
+		}
+
+		if to == nil {
+			return errs.NewObjectNotFoundError("courierID", cmd.courierID)
+		}
+
+		err = ord.Reassign(to.Id(), cmd.operator, c.ids, c.clock)
+		if err != nil {
+			return err
+		}
+
+		err = from.ReleaseOrder(ord)
+		if err != nil {
+			return err
+		}
+
+		err = to.TakeOrder(ord)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrCourierCannotTakeOrder, err)
+		}
+
+		err = uowc.CourierRepository().Save(ctx, from, to)
+		if err != nil {
+			return err
+		}
+
+		return uowc.OrderRepository().Save(ctx, ord)
+	})
+}
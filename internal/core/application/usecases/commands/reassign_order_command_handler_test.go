@@ -0,0 +1,65 @@
+package commands_test
+
+import (
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+	"errors"
+	"github.com/google/uuid"
+	"testing"
+)
+
+func TestReassignOrderCommandHandler_Handle(t *testing.T) {
+
+	ctx, uow, clk := setupTest(t)
+
+	handler, err := commands.NewReassignOrderCommandHandler(uow, testIds, clk)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	from, _ := courier.NewCourier("from", 1, newLocation(1, 1), testIds)
+	to, _ := courier.NewCourier("to", 1, newLocation(1, 1), testIds)
+	o, _ := order.NewPickupOrder(uuid.New(), newLocation(3, 3), newLocation(5, 5), 5, 0, order.PriorityStandard,
+		testIds, clk)
+	_ = from.TakeOrder(o)
+	_ = o.AssignCourier(from.Id(), testIds, clk)
+	saveCouriers(t, uow, from, to)
+	saveOrders(t, uow, o)
+
+	// до забора заказ передается другому курьеру вместе с местом хранения
+	cmd, _ := commands.NewReassignOrderCommand(o.Id(), to.Id(), "operator")
+	err = handler.Handle(ctx, cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	saved := getOrder(t, uow, o.Id())
+	if saved.Status() != order.StatusAssignedToPickup || *saved.CourierId() != to.Id() {
+		t.Fatal("expected order reassigned")
+	}
+
+	if getCourier(t, uow, from.Id()).StoragePlaces()[0].IsOccupied() ||
+		!getCourier(t, uow, to.Id()).StoragePlaces()[0].IsOccupied() {
+		t.Fatal("expected storage moved to the new courier")
+	}
+
+	// забранный заказ передать нельзя
+	_ = saved.PickUp(testIds, clk)
+	saveOrders(t, uow, saved)
+
+	cmd, _ = commands.NewReassignOrderCommand(o.Id(), from.Id(), "operator")
+	err = handler.Handle(ctx, cmd)
+	if !errors.Is(err, order.ErrNotReassignable) {
+		t.Fatalf("expected ErrNotReassignable, got %v", err)
+	}
+
+	saved = getOrder(t, uow, o.Id())
+	if saved.Status() != order.StatusPickedUp || *saved.CourierId() != to.Id() {
+		t.Fatal("expected picked up order kept by its courier")
+	}
+
+	if getCourier(t, uow, from.Id()).StoragePlaces()[0].IsOccupied() {
+		t.Fatal("expected other courier storage untouched")
+	}
+}
@@ -0,0 +1,52 @@
+package commands
+
+import (
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/pkg/errs"
+	"github.com/google/uuid"
+	"time"
+)
+
+type ReportCourierLocationCommand struct {
+	courierID  uuid.UUID
+	location   kernel.GeoLocation
+	reportedAt time.Time
+	isValid    bool
+}
+
+// NewReportCourierLocationCommand создает команду. reportedAt - время отметки на телефоне курьера,
+// пустое - время получения отметки
+func NewReportCourierLocationCommand(courierID uuid.UUID, location kernel.GeoLocation,
+	reportedAt time.Time) (ReportCourierLocationCommand, error) {
+
+	if courierID == uuid.Nil {
+		return ReportCourierLocationCommand{}, errs.NewValueIsRequiredError("courierID")
+	}
+
+	if location.IsEmpty() {
+		return ReportCourierLocationCommand{}, errs.NewValueIsRequiredError("location")
+	}
+
+	return ReportCourierLocationCommand{
+		courierID:  courierID,
+		location:   location,
+		reportedAt: reportedAt,
+		isValid:    true,
+	}, nil
+}
+
+func (c ReportCourierLocationCommand) CourierID() uuid.UUID {
+	return c.courierID
+}
+
+func (c ReportCourierLocationCommand) Location() kernel.GeoLocation {
+	return c.location
+}
+
+func (c ReportCourierLocationCommand) ReportedAt() time.Time {
+	return c.reportedAt
+}
+
+func (c ReportCourierLocationCommand) IsValid() bool {
+	return c.isValid
+}
@@ -0,0 +1,157 @@
+package commands
+
+import (
+	"context"
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+	"errors"
+	"time"
+)
+
+// ErrSimulatedMovement - курьеры перемещаются симуляцией, отметки GPS не принимаются
+var ErrSimulatedMovement = errors.New("courier movement is simulated")
+
+// ErrLocationFromFuture - время отметки позже текущего больше, чем на допустимое расхождение часов.
+// Такая отметка сделала бы все следующие отметки курьера устаревшими
+var ErrLocationFromFuture = errors.New("location report time is in the future")
+
+// maxClockSkew - насколько часы телефона курьера могут спешить относительно часов сервиса
+const maxClockSkew = time.Minute
+
+type ReportCourierLocationCommandHandler interface {
+	Handle(context.Context, ReportCourierLocationCommand) error
+}
+
+var _ ReportCourierLocationCommandHandler = &reportCourierLocationCommandHandler{}
+
+type reportCourierLocationCommandHandler struct {
+	uow  ports.UnitOfWork
+	grid kernel.GeoGrid
+	area kernel.ServiceArea
+	// gpsMovement - курьеры перемещаются по отметкам GPS, а не симуляцией
+	gpsMovement bool
+	ids         ports.IDGenerator
+	clock       ports.Clock
+}
+
+func NewReportCourierLocationCommandHandler(uow ports.UnitOfWork, grid kernel.GeoGrid, area kernel.ServiceArea,
+	gpsMovement bool, ids ports.IDGenerator, clock ports.Clock) (ReportCourierLocationCommandHandler, error) {
+	if uow == nil {
+		return nil, errs.NewValueIsRequiredError("uow")
+	}
+
+	if gpsMovement && grid.IsEmpty() {
+		return nil, errs.NewValueIsRequiredError("grid")
+	}
+
+	if area.IsEmpty() {
+		return nil, errs.NewValueIsRequiredError("area")
+	}
+
+	if ids == nil {
+		return nil, errs.NewValueIsRequiredError("ids")
+	}
+
+	if clock == nil {
+		return nil, errs.NewValueIsRequiredError("clock")
+	}
+
+	return &reportCourierLocationCommandHandler{
+		uow:         uow,
+		grid:        grid,
+		area:        area,
+		gpsMovement: gpsMovement,
+		ids:         ids,
+		clock:       clock,
+	}, nil
+}
+
+// Handle перемещает курьера в точку отметки GPS и запоминает ее точные координаты. Если курьер оказался в клетке
+// забора или доставки своего заказа, прибытие обрабатывается так же, как при симуляции. Отметку не новее последней принятой
+// команда отклоняет с courier.ErrStaleLocation, отметку из будущего - с ErrLocationFromFuture
+func (c *reportCourierLocationCommandHandler) Handle(ctx context.Context, cmd ReportCourierLocationCommand) error {
+
+	if !cmd.isValid {
+		return errs.NewValueIsInvalidError("cmd")
+	}
+
+	if !c.gpsMovement {
+		return ErrSimulatedMovement
+	}
+
+	location, err := c.grid.ToLocation(cmd.location)
+	if err != nil {
+		return err
+	}
+
+	err = c.area.Validate(location)
+	if err != nil {
+		return err
+	}
+
+	now := c.clock.Now()
+	reportedAt := cmd.reportedAt
+	if reportedAt.IsZero() {
+		reportedAt = now
+	}
+
+	if reportedAt.After(now.Add(maxClockSkew)) {
+		return ErrLocationFromFuture
+	}
+
+	return c.uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+
+		cour, err := uowc.CourierRepository().Get(ctx, cmd.courierID)
+		if err != nil {
+			return err
+		}
+
+		if cour == nil {
+			return errs.NewObjectNotFoundError("courierID", cmd.courierID)
+		}
+
+		err = cour.ReportLocation(location, reportedAt, c.ids)
+		if err != nil {
+			return err
+		}
+
+		err = uowc.CourierRepository().Save(ctx, cour)
+		if err != nil {
+			return err
+		}
+
+		assignedOrders, err := uowc.OrderRepository().GetActiveByCourier(ctx, cour.Id())
+		if err != nil {
+			return err
+		}
+
+		for _, assignedOrder := range assignedOrders {
+			if !assignedOrder.CurrentTarget().Equals(cour.Location()) {
+				continue
+			}
+
+			err = arrive(cour, assignedOrder, c.ids, c.clock)
+			if err != nil {
+				return err
+			}
+
+			err = uowc.CourierRepository().Save(ctx, cour)
+			if err != nil {
+				return err
+			}
+
+			err = uowc.OrderRepository().Save(ctx, assignedOrder)
+			if err != nil {
+				return err
+			}
+
+			err = finishSplitOrder(ctx, uowc, assignedOrder, c.ids, c.clock)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,92 @@
+package commands_test
+
+import (
+	"context"
+	"delivery/internal/adapters/out/clock"
+	"delivery/internal/adapters/out/memory"
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/ddd"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestReportCourierLocationCommandHandler_RejectsFutureReports(t *testing.T) {
+
+	ctx := context.Background()
+	uow, err := memory.NewUnitOfWork(memory.NewStorage(), ddd.NewMediatr())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	clk := clock.NewFakeClock(now)
+	origin, _ := kernel.NewGeoLocation(55.75, 37.61)
+	grid, _ := kernel.NewGeoGrid(origin, 1)
+	area, _ := kernel.NewServiceArea(10, 10)
+
+	start, _ := kernel.NewLocation(1, 1)
+	c, _ := courier.NewCourier("courier", 1, start, testIds)
+	err = uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		return uowc.CourierRepository().Save(ctx, c)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	handler, err := commands.NewReportCourierLocationCommandHandler(uow, grid, area, true, testIds, clk)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report := func(x, y int, reportedAt time.Time) error {
+		cell, _ := kernel.NewLocation(x, y)
+		center, _ := grid.ToGeoLocation(cell)
+		location, _ := kernel.NewGeoLocation(center.Lat()+0.001, center.Lon())
+		cmd, err := commands.NewReportCourierLocationCommand(c.Id(), location, reportedAt)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return handler.Handle(ctx, cmd)
+	}
+
+	location := func() kernel.Location {
+		var saved *courier.Courier
+		err := uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+			var err error
+			saved, err = uowc.CourierRepository().Get(ctx, c.Id())
+			return err
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return saved.Location()
+	}
+
+	// отметка из далекого будущего отклоняется и не блокирует следующие отметки
+	err = report(5, 5, now.Add(24*time.Hour))
+	if !errors.Is(err, commands.ErrLocationFromFuture) {
+		t.Fatalf("expected ErrLocationFromFuture, got %v", err)
+	}
+
+	if !location().Equals(start) {
+		t.Fatal("expected courier not moved")
+	}
+
+	// небольшое расхождение часов телефона допустимо
+	err = report(2, 2, now.Add(30*time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cell, _ := kernel.NewLocation(2, 2)
+	center, _ := grid.ToGeoLocation(cell)
+	if !location().Equals(cell) || location().Geo().Lat() != center.Lat()+0.001 {
+		t.Fatal("expected courier moved to the exact report location")
+	}
+}
@@ -0,0 +1,48 @@
+package commands
+
+import (
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/pkg/errs"
+	"github.com/google/uuid"
+)
+
+type RevokeQualificationCommand struct {
+	courierID     uuid.UUID
+	qualification courier.Qualification
+	isValid       bool
+}
+
+// NewRevokeQualificationCommand создает команду отзыва допуска у курьера
+func NewRevokeQualificationCommand(courierID uuid.UUID, qualification string) (RevokeQualificationCommand, error) {
+
+	if courierID == uuid.Nil {
+		return RevokeQualificationCommand{}, errs.NewValueIsRequiredError("courierID")
+	}
+
+	if qualification == "" {
+		return RevokeQualificationCommand{}, errs.NewValueIsRequiredError("qualification")
+	}
+
+	q, err := courier.QualificationFromString(qualification)
+	if err != nil {
+		return RevokeQualificationCommand{}, errs.NewValueIsInvalidErrorWithCause("qualification", err)
+	}
+
+	return RevokeQualificationCommand{
+		courierID:     courierID,
+		qualification: q,
+		isValid:       true,
+	}, nil
+}
+
+func (c RevokeQualificationCommand) CourierID() uuid.UUID {
+	return c.courierID
+}
+
+func (c RevokeQualificationCommand) Qualification() courier.Qualification {
+	return c.qualification
+}
+
+func (c RevokeQualificationCommand) IsValid() bool {
+	return c.isValid
+}
@@ -0,0 +1,51 @@
+package commands
+
+import (
+	"context"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+)
+
+type RevokeQualificationCommandHandler interface {
+	Handle(context.Context, RevokeQualificationCommand) error
+}
+
+var _ RevokeQualificationCommandHandler = &revokeQualificationCommandHandler{}
+
+type revokeQualificationCommandHandler struct {
+	uow ports.UnitOfWork
+}
+
+func NewRevokeQualificationCommandHandler(uow ports.UnitOfWork) (RevokeQualificationCommandHandler, error) {
+	if uow == nil {
+		return nil, errs.NewValueIsRequiredError("uow")
+	}
+
+	return &revokeQualificationCommandHandler{uow: uow}, nil
+}
+
+func (c *revokeQualificationCommandHandler) Handle(ctx context.Context, cmd RevokeQualificationCommand) error {
+
+	if !cmd.isValid {
+		return errs.NewValueIsInvalidError("cmd")
+	}
+
+	return c.uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+
+		cour, err := uowc.CourierRepository().Get(ctx, cmd.courierID)
+		if err != nil {
+			return err
+		}
+
+		if cour == nil {
+			return errs.NewObjectNotFoundError("courierID", cmd.courierID)
+		}
+
+		err = cour.RevokeQualification(cmd.qualification)
+		if err != nil {
+			return err
+		}
+
+		return uowc.CourierRepository().Save(ctx, cour)
+	})
+}
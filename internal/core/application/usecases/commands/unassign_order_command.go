@@ -0,0 +1,43 @@
+package commands
+
+import (
+	"delivery/internal/pkg/errs"
+	"github.com/google/uuid"
+	"strings"
+)
+
+type UnassignOrderCommand struct {
+	orderID  uuid.UUID
+	operator string
+	isValid  bool
+}
+
+// NewUnassignOrderCommand создает команду. operator - оператор, который снимает заказ с курьера
+func NewUnassignOrderCommand(orderID uuid.UUID, operator string) (UnassignOrderCommand, error) {
+
+	if orderID == uuid.Nil {
+		return UnassignOrderCommand{}, errs.NewValueIsRequiredError("orderID")
+	}
+
+	if strings.TrimSpace(operator) == "" {
+		return UnassignOrderCommand{}, errs.NewValueIsRequiredError("operator")
+	}
+
+	return UnassignOrderCommand{
+		orderID:  orderID,
+		operator: operator,
+		isValid:  true,
+	}, nil
+}
+
+func (c UnassignOrderCommand) OrderID() uuid.UUID {
+	return c.orderID
+}
+
+func (c UnassignOrderCommand) Operator() string {
+	return c.operator
+}
+
+func (c UnassignOrderCommand) IsValid() bool {
+	return c.isValid
+}
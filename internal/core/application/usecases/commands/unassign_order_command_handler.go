@@ -0,0 +1,91 @@
+package commands
+
+import (
+	"context"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+)
+
+type UnassignOrderCommandHandler interface {
+	Handle(context.Context, UnassignOrderCommand) error
+}
+
+var _ UnassignOrderCommandHandler = &unassignOrderCommandHandler{}
+
+type unassignOrderCommandHandler struct {
+	uow   ports.UnitOfWork
+	ids   ports.IDGenerator
+	clock ports.Clock
+}
+
+func NewUnassignOrderCommandHandler(uow ports.UnitOfWork, ids ports.IDGenerator,
+	clock ports.Clock) (UnassignOrderCommandHandler, error) {
+	if uow == nil {
+		return nil, errs.NewValueIsRequiredError("uow")
+	}
+
+	if ids == nil {
+		return nil, errs.NewValueIsRequiredError("ids")
+	}
+
+	if clock == nil {
+		return nil, errs.NewValueIsRequiredError("clock")
+	}
+
+	return &unassignOrderCommandHandler{
+		uow:   uow,
+		ids:   ids,
+		clock: clock,
+	}, nil
+}
+
+func (c *unassignOrderCommandHandler) Handle(ctx context.Context, cmd UnassignOrderCommand) error {
+
+	if !cmd.isValid {
+		return errs.NewValueIsInvalidError("cmd")
+	}
+
+	return c.uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+
+		ord, err := uowc.OrderRepository().Get(ctx, cmd.orderID)
+		if err != nil {
+			return err
+		}
+
+		if ord == nil {
+			return errs.NewObjectNotFoundError("orderID", cmd.orderID)
+		}
+
+		if ord.CourierId() == nil {
+			return order.ErrNotReassignable
+		}
+
+		cour, err := uowc.CourierRepository().Get(ctx, *ord.CourierId())
+		if err != nil {
+			return err
+		}
+
+		if cour == nil {
+			return errs.NewObjectNotFoundError("courierID", *ord.CourierId())
+		}
+
+		err = ord.Unassign(cmd.operator, c.ids, c.clock)
+		if err != nil {
+			return err
+		}
+
+		// Освобождаем место уже по снятому заказу, чтобы курьер снова считался свободным
+		err = cour.ReleaseOrder(ord)
+		if err != nil {
+			return err
+		}
+
+		err = uowc.CourierRepository().Save(ctx, cour)
+		if err != nil {
+			return err
+		}
+
+		return uowc.OrderRepository().Save(ctx, ord)
+	})
+}
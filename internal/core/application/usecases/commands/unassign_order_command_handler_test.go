@@ -0,0 +1,53 @@
+package commands_test
+
+import (
+	"context"
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"github.com/google/uuid"
+	"testing"
+)
+
+func TestUnassignOrderCommandHandler_ReleasesCourier(t *testing.T) {
+
+	ctx, uow, clk := setupTest(t)
+
+	handler, err := commands.NewUnassignOrderCommandHandler(uow, testIds, clk)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, _ := courier.NewCourier("courier", 1, newLocation(1, 1), testIds)
+	o, _ := order.NewOrder(uuid.New(), newLocation(5, 5), 5, 0, order.PriorityStandard, testIds, clk)
+	_ = c.TakeOrder(o)
+	_ = o.Assign(c.Id(), "operator", testIds, clk)
+	saveCouriers(t, uow, c)
+	saveOrders(t, uow, o)
+
+	cmd, _ := commands.NewUnassignOrderCommand(o.Id(), "operator")
+	err = handler.Handle(ctx, cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	saved := getOrder(t, uow, o.Id())
+	if saved.Status() != order.StatusCreated || saved.CourierId() != nil || saved.AssignedBy() != "" {
+		t.Fatal("expected order back in the queue")
+	}
+
+	// курьер снова свободен и может взять новый заказ
+	var free []*courier.Courier
+	err = uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		free, err = uowc.CourierRepository().GetAllFree(ctx)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(free) != 1 || !free[0].Equals(c) {
+		t.Fatal("expected courier released")
+	}
+}
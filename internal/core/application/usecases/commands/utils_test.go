@@ -0,0 +1,77 @@
+package commands_test
+
+import (
+	"context"
+	"delivery/internal/adapters/out/clock"
+	"delivery/internal/adapters/out/memory"
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/ddd"
+	"github.com/google/uuid"
+	"testing"
+	"time"
+)
+
+var testIds = kernel.IDGeneratorFunc(uuid.New)
+
+func setupTest(t *testing.T) (context.Context, ports.UnitOfWork, *clock.FakeClock) {
+	uow, err := memory.NewUnitOfWork(memory.NewStorage(), ddd.NewMediatr())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return context.Background(), uow, clock.NewFakeClock(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+}
+
+func saveCouriers(t *testing.T, uow ports.UnitOfWork, couriers ...*courier.Courier) {
+	err := uow.Do(context.Background(), func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		return uowc.CourierRepository().Save(ctx, couriers...)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func saveOrders(t *testing.T, uow ports.UnitOfWork, orders ...*order.Order) {
+	err := uow.Do(context.Background(), func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		return uowc.OrderRepository().Save(ctx, orders...)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func getCourier(t *testing.T, uow ports.UnitOfWork, id uuid.UUID) *courier.Courier {
+	var c *courier.Courier
+	err := uow.Do(context.Background(), func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		var err error
+		c, err = uowc.CourierRepository().Get(ctx, id)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return c
+}
+
+func getOrder(t *testing.T, uow ports.UnitOfWork, id uuid.UUID) *order.Order {
+	var o *order.Order
+	err := uow.Do(context.Background(), func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+		var err error
+		o, err = uowc.OrderRepository().Get(ctx, id)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return o
+}
+
+func newLocation(x, y int) kernel.Location {
+	l, _ := kernel.NewLocation(x, y)
+	return l
+}
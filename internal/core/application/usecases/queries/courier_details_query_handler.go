@@ -0,0 +1,85 @@
+package queries
+
+import (
+	"context"
+	"delivery/internal/pkg/errs"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type StoragePlaceResponse struct {
+	StoragePlaceID uuid.UUID  `db:"id"`
+	Name           string     `db:"name"`
+	Type           string     `db:"type"`
+	TotalVolume    int        `db:"volume"`
+	MaxWeight      int        `db:"max_weight"`
+	OrderID        *uuid.UUID `db:"order_id"`
+}
+
+type CourierDetailsResponse struct {
+	CourierID      uuid.UUID `db:"id"`
+	Name           string    `db:"name"`
+	Speed          int       `db:"speed"`
+	LocationX      int       `db:"location_x"`
+	LocationY      int       `db:"location_y"`
+	Qualifications []string  `db:"qualifications"`
+	StoragePlaces  []StoragePlaceResponse
+}
+
+type CourierDetailsQueryHandler interface {
+	// Handle возвращает nil, если курьер не найден
+	Handle(ctx context.Context, courierID uuid.UUID) (*CourierDetailsResponse, error)
+}
+
+var _ CourierDetailsQueryHandler = &courierDetailsQueryHandler{}
+
+type courierDetailsQueryHandler struct {
+	db *pgxpool.Pool
+}
+
+func NewCourierDetailsQueryHandler(db *pgxpool.Pool) (CourierDetailsQueryHandler, error) {
+	if db == nil {
+		return nil, errs.NewValueIsRequiredError("db")
+	}
+
+	return &courierDetailsQueryHandler{db: db}, nil
+}
+
+func (cq *courierDetailsQueryHandler) Handle(ctx context.Context, courierID uuid.UUID) (*CourierDetailsResponse, error) {
+
+	if courierID == uuid.Nil {
+		return nil, errs.NewValueIsRequiredError("courierID")
+	}
+
+	response := CourierDetailsResponse{}
+	err := cq.db.QueryRow(ctx,
+		"select id, name, speed, location_x, location_y, qualifications from couriers where id = $1", courierID).
+		Scan(&response.CourierID, &response.Name, &response.Speed, &response.LocationX, &response.LocationY,
+			&response.Qualifications)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil // not found (no error here)
+		}
+		return nil, err
+	}
+
+	rows, err := cq.db.Query(ctx,
+		`select id, name, type, volume, max_weight, order_id
+			   from storage_places
+			   where courier_id = $1
+			   order by id`, courierID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	response.StoragePlaces, err = pgx.CollectRows(rows, pgx.RowToStructByName[StoragePlaceResponse])
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,65 @@
+package queries
+
+import (
+	"context"
+	"delivery/internal/pkg/errs"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"time"
+)
+
+type DispatchCandidate struct {
+	CourierID            uuid.UUID `json:"courier_id"`
+	CourierName          string    `json:"courier_name"`
+	Eta                  float64   `json:"eta"`
+	RejectionReason      string    `json:"rejection_reason,omitempty"`
+	MissingQualification string    `json:"missing_qualification,omitempty"`
+}
+
+type DispatchDecision struct {
+	DecisionID uuid.UUID           `db:"id"`
+	OrderID    uuid.UUID           `db:"order_id"`
+	CourierID  *uuid.UUID          `db:"courier_id"`
+	Candidates []DispatchCandidate `db:"candidates"`
+	DecidedAt  time.Time           `db:"decided_at"`
+}
+
+type DispatchDecisionsResponse []*DispatchDecision
+
+type DispatchDecisionsQueryHandler interface {
+	Handle(ctx context.Context, orderID uuid.UUID) (DispatchDecisionsResponse, error)
+}
+
+var _ DispatchDecisionsQueryHandler = &dispatchDecisionsQueryHandler{}
+
+type dispatchDecisionsQueryHandler struct {
+	db *pgxpool.Pool
+}
+
+func NewDispatchDecisionsQueryHandler(db *pgxpool.Pool) (DispatchDecisionsQueryHandler, error) {
+	if db == nil {
+		return nil, errs.NewValueIsRequiredError("db")
+	}
+
+	return &dispatchDecisionsQueryHandler{db: db}, nil
+}
+
+func (dq *dispatchDecisionsQueryHandler) Handle(ctx context.Context, orderID uuid.UUID) (DispatchDecisionsResponse, error) {
+
+	if orderID == uuid.Nil {
+		return nil, errs.NewValueIsRequiredError("orderID")
+	}
+
+	rows, err := dq.db.Query(ctx,
+		`select id, order_id, courier_id, candidates, decided_at
+			   from dispatch_decisions
+			   where order_id = $1
+			   order by decided_at, id`, orderID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[DispatchDecision])
+}
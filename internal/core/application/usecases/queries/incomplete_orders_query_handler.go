@@ -41,7 +41,8 @@ func (cq *incompleteOrdersQueryHandler) Handle(ctx context.Context) (IncompleteO
 	rows, err := cq.db.Query(ctx,
 		fmt.Sprintf(`select id, location_x, location_y 
 							from orders 
-							where status != '%s'`, order.StatusCompleted))
+							where status not in ('%s', '%s', '%s')`, order.StatusCompleted, order.StatusUndeliverable,
+			order.StatusReturned))
 
 	if err != nil {
 		return nil, err
@@ -0,0 +1,72 @@
+package queries
+
+import (
+	"context"
+	"delivery/internal/pkg/errs"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"time"
+)
+
+type OrderDetailsResponse struct {
+	OrderID   uuid.UUID  `db:"id"`
+	CourierID *uuid.UUID `db:"courier_id"`
+	Status    string     `db:"status"`
+	Priority  string     `db:"priority"`
+	LocationX int        `db:"location_x"`
+	LocationY int        `db:"location_y"`
+	// PickupLocationX, PickupLocationY - точка забора, nil - товар уже у курьера
+	PickupLocationX *int      `db:"pickup_location_x"`
+	PickupLocationY *int      `db:"pickup_location_y"`
+	CreatedAt       time.Time `db:"created_at"`
+	// ParentID - заказ, из которого выделено отправление, nil - обычный заказ
+	ParentID *uuid.UUID `db:"parent_id"`
+}
+
+type OrderDetailsQueryHandler interface {
+	// Handle возвращает nil, если заказ не найден
+	Handle(ctx context.Context, orderID uuid.UUID) (*OrderDetailsResponse, error)
+}
+
+var _ OrderDetailsQueryHandler = &orderDetailsQueryHandler{}
+
+type orderDetailsQueryHandler struct {
+	db *pgxpool.Pool
+}
+
+func NewOrderDetailsQueryHandler(db *pgxpool.Pool) (OrderDetailsQueryHandler, error) {
+	if db == nil {
+		return nil, errs.NewValueIsRequiredError("db")
+	}
+
+	return &orderDetailsQueryHandler{db: db}, nil
+}
+
+func (oq *orderDetailsQueryHandler) Handle(ctx context.Context, orderID uuid.UUID) (*OrderDetailsResponse, error) {
+
+	if orderID == uuid.Nil {
+		return nil, errs.NewValueIsRequiredError("orderID")
+	}
+
+	rows, err := oq.db.Query(ctx,
+		`select id, courier_id, status, priority, location_x, location_y, pickup_location_x, pickup_location_y,
+			    created_at, parent_id
+			   from orders
+			   where id = $1`, orderID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[OrderDetailsResponse])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil // not found (no error here)
+		}
+		return nil, err
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,107 @@
+package queries
+
+import (
+	"context"
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+	"errors"
+	"github.com/google/uuid"
+)
+
+// ErrOrderNotOnTheWay - курьер не везет заказ получателю: заказ не назначен, уже завершен или не доставлен
+var ErrOrderNotOnTheWay = errors.New("order is not on the way")
+
+type OrderEtaResponse struct {
+	OrderID   uuid.UUID
+	CourierID uuid.UUID
+	// Eta - сколько секунд осталось до прибытия курьера к получателю
+	Eta float64
+}
+
+type OrderEtaQueryHandler interface {
+	// Handle возвращает nil, если заказ не найден
+	Handle(ctx context.Context, orderID uuid.UUID) (*OrderEtaResponse, error)
+}
+
+var _ OrderEtaQueryHandler = &orderEtaQueryHandler{}
+
+type orderEtaQueryHandler struct {
+	uow  ports.UnitOfWork
+	calc kernel.DistanceCalculator
+}
+
+func NewOrderEtaQueryHandler(uow ports.UnitOfWork, calc kernel.DistanceCalculator) (OrderEtaQueryHandler, error) {
+	if uow == nil {
+		return nil, errs.NewValueIsRequiredError("uow")
+	}
+
+	if calc == nil {
+		return nil, errs.NewValueIsRequiredError("calc")
+	}
+
+	return &orderEtaQueryHandler{
+		uow:  uow,
+		calc: calc,
+	}, nil
+}
+
+func (eq *orderEtaQueryHandler) Handle(ctx context.Context, orderID uuid.UUID) (*OrderEtaResponse, error) {
+
+	if orderID == uuid.Nil {
+		return nil, errs.NewValueIsRequiredError("orderID")
+	}
+
+	var response *OrderEtaResponse
+	err := eq.uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+
+		ord, err := uowc.OrderRepository().Get(ctx, orderID)
+		if err != nil || ord == nil {
+			return err
+		}
+
+		if !ord.IsOutForDelivery() && ord.Status() != order.StatusAssignedToPickup {
+			return ErrOrderNotOnTheWay
+		}
+
+		cour, err := uowc.CourierRepository().Get(ctx, *ord.CourierId())
+		if err != nil {
+			return err
+		}
+
+		if cour == nil {
+			return errs.NewObjectNotFoundError("courierID", *ord.CourierId())
+		}
+
+		eta, err := estimateArrival(ord, cour, eq.calc)
+		if err != nil {
+			return err
+		}
+
+		response = &OrderEtaResponse{
+			OrderID:   ord.Id(),
+			CourierID: cour.Id(),
+			Eta:       eta,
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// estimateArrival оценивает оставшееся время по текущему положению курьера: если товар еще не забран,
+// в оценку входит путь через точку забора
+func estimateArrival(ord *order.Order, cour *courier.Courier, calc kernel.DistanceCalculator) (float64, error) {
+	if ord.Status() == order.StatusAssignedToPickup {
+		return cour.CalculateDeliveryTime(ord, calc)
+	}
+
+	return cour.CalculateTimeToLocation(ord.Location(), calc)
+}
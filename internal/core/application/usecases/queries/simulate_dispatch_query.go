@@ -0,0 +1,53 @@
+package queries
+
+import (
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/pkg/errs"
+	"errors"
+)
+
+type SimulateDispatchQuery struct {
+	location kernel.Location
+	volume   int
+	weight   int
+	isValid  bool
+}
+
+func NewSimulateDispatchQuery(x int, y int, volume int, weight int) (SimulateDispatchQuery, error) {
+
+	location, err := kernel.NewLocation(x, y)
+	if err != nil {
+		return SimulateDispatchQuery{}, errs.NewValueIsInvalidErrorWithCause("location", err)
+	}
+
+	if volume <= 0 {
+		return SimulateDispatchQuery{}, errors.New("volume must be greater than 0")
+	}
+
+	if weight < 0 {
+		return SimulateDispatchQuery{}, errors.New("weight must not be negative")
+	}
+
+	return SimulateDispatchQuery{
+		location: location,
+		volume:   volume,
+		weight:   weight,
+		isValid:  true,
+	}, nil
+}
+
+func (q SimulateDispatchQuery) Location() kernel.Location {
+	return q.location
+}
+
+func (q SimulateDispatchQuery) Volume() int {
+	return q.volume
+}
+
+func (q SimulateDispatchQuery) Weight() int {
+	return q.weight
+}
+
+func (q SimulateDispatchQuery) IsValid() bool {
+	return q.isValid
+}
@@ -0,0 +1,127 @@
+package queries
+
+import (
+	"context"
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/services"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+	"errors"
+	"github.com/google/uuid"
+)
+
+// errDryRun откатывает транзакцию, в которой выполнялась пробная диспетчеризация
+var errDryRun = errors.New("dry run")
+
+type SimulatedCourier struct {
+	CourierID uuid.UUID
+	Name      string
+	Eta       float64
+}
+
+type SimulateDispatchResponse struct {
+	Courier      *SimulatedCourier   // nil, если подходящего курьера нет
+	Alternatives []*SimulatedCourier // остальные подходящие курьеры по возрастанию времени доставки
+}
+
+type SimulateDispatchQueryHandler interface {
+	Handle(context.Context, SimulateDispatchQuery) (SimulateDispatchResponse, error)
+}
+
+var _ SimulateDispatchQueryHandler = &simulateDispatchQueryHandler{}
+
+type simulateDispatchQueryHandler struct {
+	uow   ports.UnitOfWork
+	d     services.OrderDispatcher
+	area  kernel.ServiceArea
+	ids   ports.IDGenerator
+	clock ports.Clock
+}
+
+func NewSimulateDispatchQueryHandler(uow ports.UnitOfWork, d services.OrderDispatcher, area kernel.ServiceArea,
+	ids ports.IDGenerator, clock ports.Clock) (SimulateDispatchQueryHandler, error) {
+	if uow == nil {
+		return nil, errs.NewValueIsRequiredError("uow")
+	}
+
+	if d == nil {
+		return nil, errs.NewValueIsRequiredError("d")
+	}
+
+	if area.IsEmpty() {
+		return nil, errs.NewValueIsRequiredError("area")
+	}
+
+	if ids == nil {
+		return nil, errs.NewValueIsRequiredError("ids")
+	}
+
+	if clock == nil {
+		return nil, errs.NewValueIsRequiredError("clock")
+	}
+
+	return &simulateDispatchQueryHandler{
+		uow:   uow,
+		d:     d,
+		area:  area,
+		ids:   ids,
+		clock: clock,
+	}, nil
+}
+
+func (sq *simulateDispatchQueryHandler) Handle(ctx context.Context, q SimulateDispatchQuery) (SimulateDispatchResponse, error) {
+
+	if !q.isValid {
+		return SimulateDispatchResponse{}, errs.NewValueIsInvalidError("q")
+	}
+
+	err := sq.area.Validate(q.location)
+	if err != nil {
+		return SimulateDispatchResponse{}, err
+	}
+
+	response := SimulateDispatchResponse{Alternatives: []*SimulatedCourier{}}
+
+	// Диспетчер меняет состояние курьеров и заказа, поэтому работаем в транзакции,
+	// которую всегда откатываем - ничего не должно сохраниться
+	err = sq.uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+
+		couriers, err := uowc.CourierRepository().GetAllFree(ctx)
+		if err != nil {
+			return err
+		}
+
+		ord, err := order.NewOrder(sq.ids.NewId(), q.location, q.volume, q.weight, order.PriorityStandard, sq.ids, sq.clock)
+		if err != nil {
+			return err
+		}
+
+		_, decision, err := sq.d.Dispatch(ord, couriers)
+		if err != nil && !errors.Is(err, services.ErrNoMatchingCourier) {
+			return err
+		}
+
+		for _, candidate := range decision.RankedCandidates() {
+			simulated := &SimulatedCourier{
+				CourierID: candidate.CourierId(),
+				Name:      candidate.CourierName(),
+				Eta:       candidate.Eta(),
+			}
+
+			if decision.CourierId() != nil && *decision.CourierId() == candidate.CourierId() {
+				response.Courier = simulated
+			} else {
+				response.Alternatives = append(response.Alternatives, simulated)
+			}
+		}
+
+		return errDryRun
+	})
+
+	if !errors.Is(err, errDryRun) {
+		return SimulateDispatchResponse{}, err
+	}
+
+	return response, nil
+}
@@ -0,0 +1,186 @@
+package queries
+
+import (
+	"context"
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+)
+
+// trackingLocationPrecision - сторона квадрата сетки, до которого округляется положение курьера для получателя
+const trackingLocationPrecision = 3
+
+// TrackOrderResponse - то, что видит получатель по ссылке отслеживания. Курьер в ответе не называется
+type TrackOrderResponse struct {
+	Status string
+	// Eta - сколько секунд осталось до прибытия курьера, nil - курьер к получателю не едет.
+	// У разделенного заказа - до прибытия последнего отправления, nil - не все отправления в пути
+	Eta *float64
+	// CourierLocation - центр квадрата сетки, в котором находится курьер, nil - курьер к получателю не едет.
+	// У разделенного заказа всегда nil: отправления везут разные курьеры
+	CourierLocation *kernel.Location
+	// Shipments - отправления разделенного заказа, пустой для обычного заказа
+	Shipments []TrackShipmentResponse
+}
+
+// TrackShipmentResponse - состояние одного отправления разделенного заказа
+type TrackShipmentResponse struct {
+	Status          string
+	Eta             *float64
+	CourierLocation *kernel.Location
+}
+
+type TrackOrderQueryHandler interface {
+	// Handle возвращает ports.ErrTrackingTokenInvalid, ports.ErrTrackingTokenExpired для негодного токена
+	// и nil, если заказ токена не найден
+	Handle(ctx context.Context, token string) (*TrackOrderResponse, error)
+}
+
+var _ TrackOrderQueryHandler = &trackOrderQueryHandler{}
+
+type trackOrderQueryHandler struct {
+	uow    ports.UnitOfWork
+	calc   kernel.DistanceCalculator
+	area   kernel.ServiceArea
+	tokens ports.TrackingTokens
+	clock  ports.Clock
+}
+
+func NewTrackOrderQueryHandler(uow ports.UnitOfWork, calc kernel.DistanceCalculator, area kernel.ServiceArea,
+	tokens ports.TrackingTokens, clock ports.Clock) (TrackOrderQueryHandler, error) {
+	if uow == nil {
+		return nil, errs.NewValueIsRequiredError("uow")
+	}
+
+	if calc == nil {
+		return nil, errs.NewValueIsRequiredError("calc")
+	}
+
+	if area.IsEmpty() {
+		return nil, errs.NewValueIsRequiredError("area")
+	}
+
+	if tokens == nil {
+		return nil, errs.NewValueIsRequiredError("tokens")
+	}
+
+	if clock == nil {
+		return nil, errs.NewValueIsRequiredError("clock")
+	}
+
+	return &trackOrderQueryHandler{
+		uow:    uow,
+		calc:   calc,
+		area:   area,
+		tokens: tokens,
+		clock:  clock,
+	}, nil
+}
+
+func (tq *trackOrderQueryHandler) Handle(ctx context.Context, token string) (*TrackOrderResponse, error) {
+
+	orderID, err := tq.tokens.Verify(token, tq.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	var response *TrackOrderResponse
+	err = tq.uow.Do(ctx, func(ctx context.Context, uowc ports.UnitOfWorkComponents) error {
+
+		ord, err := uowc.OrderRepository().Get(ctx, orderID)
+		if err != nil || ord == nil {
+			return err
+		}
+
+		response = &TrackOrderResponse{Status: ord.Status().String()}
+		if ord.Status() == order.StatusSplit {
+			return tq.trackShipments(ctx, uowc, ord, response)
+		}
+
+		response.Eta, response.CourierLocation, err = tq.track(ctx, uowc, ord)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// trackShipments добавляет в ответ состояние отправлений и время, когда прибудет последнее из них
+func (tq *trackOrderQueryHandler) trackShipments(ctx context.Context, uowc ports.UnitOfWorkComponents,
+	ord *order.Order, response *TrackOrderResponse) error {
+
+	shipments, err := uowc.OrderRepository().GetShipments(ctx, ord.Id())
+	if err != nil {
+		return err
+	}
+
+	var latest *float64
+	allOnTheWay := true
+	for _, shipment := range shipments {
+		eta, location, err := tq.track(ctx, uowc, shipment)
+		if err != nil {
+			return err
+		}
+
+		response.Shipments = append(response.Shipments, TrackShipmentResponse{
+			Status:          shipment.Status().String(),
+			Eta:             eta,
+			CourierLocation: location,
+		})
+
+		switch {
+		case shipment.Status().IsFinished():
+			// доставленное или возвращенное отправление прибытия не ждет
+		case eta == nil:
+			allOnTheWay = false
+		case latest == nil || *eta > *latest:
+			latest = eta
+		}
+	}
+
+	if allOnTheWay {
+		response.Eta = latest
+	}
+
+	return nil
+}
+
+// track оценивает прибытие курьера с заказом ord и его примерное положение. nil - курьер к получателю не едет
+func (tq *trackOrderQueryHandler) track(ctx context.Context, uowc ports.UnitOfWorkComponents,
+	ord *order.Order) (*float64, *kernel.Location, error) {
+
+	if !ord.IsOutForDelivery() && ord.Status() != order.StatusAssignedToPickup {
+		return nil, nil, nil
+	}
+
+	cour, err := uowc.CourierRepository().Get(ctx, *ord.CourierId())
+	if err != nil || cour == nil {
+		return nil, nil, err
+	}
+
+	eta, err := estimateArrival(ord, cour, tq.calc)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	location, err := tq.approximate(cour.Location())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &eta, &location, nil
+}
+
+// approximate скрывает точное положение курьера: возвращает центр квадрата сетки, в котором он находится
+func (tq *trackOrderQueryHandler) approximate(location kernel.Location) (kernel.Location, error) {
+	snap := func(c int, limit int) int {
+		centre := (c-1)/trackingLocationPrecision*trackingLocationPrecision + (trackingLocationPrecision+1)/2
+		return min(centre, limit)
+	}
+
+	return kernel.NewLocation(snap(location.X(), tq.area.Width()), snap(location.Y(), tq.area.Height()))
+}
@@ -0,0 +1,137 @@
+package kernel
+
+import (
+	"bufio"
+	"delivery/internal/pkg/errs"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	cityMapFreeCell    = '.'
+	cityMapBlockedCell = '#'
+	cityMapComment     = "//"
+)
+
+// CityMap - карта города: сетка width x height, в которой часть клеток непроходима (реки, парки, перекрытые улицы)
+type CityMap struct {
+	width   int
+	height  int
+	blocked map[Location]struct{}
+
+	isSet bool
+}
+
+func NewCityMap(width int, height int, blocked ...Location) (CityMap, error) {
+	if width < minC {
+		return CityMap{}, errs.NewValueIsOutOfRangeError("width", width, minC, "∞")
+	}
+
+	if height < minC {
+		return CityMap{}, errs.NewValueIsOutOfRangeError("height", height, minC, "∞")
+	}
+
+	m := CityMap{
+		width:   width,
+		height:  height,
+		blocked: make(map[Location]struct{}, len(blocked)),
+		isSet:   true,
+	}
+
+	for _, cell := range blocked {
+		if cell.IsEmpty() || !m.inBounds(cell) {
+			return CityMap{}, fmt.Errorf("blocked cell %d,%d is out of map", cell.X(), cell.Y())
+		}
+
+		m.blocked[cell.Cell()] = struct{}{}
+	}
+
+	return m, nil
+}
+
+// ParseCityMap читает карту в текстовом виде: каждая строка - ряд клеток начиная с y = 1,
+// '.' - проходимая клетка, '#' - непроходимая. Строки, начинающиеся с "//", и пустые строки пропускаются
+func ParseCityMap(r io.Reader) (CityMap, error) {
+	var (
+		blocked []Location
+		width   int
+		height  int
+	)
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		row := strings.TrimSpace(scanner.Text())
+		if row == "" || strings.HasPrefix(row, cityMapComment) {
+			continue
+		}
+
+		height++
+		if width == 0 {
+			width = len(row)
+		} else if len(row) != width {
+			return CityMap{}, fmt.Errorf("row %d: expected %d cells, got %d", height, width, len(row))
+		}
+
+		for i, cell := range row {
+			switch cell {
+			case cityMapFreeCell:
+			case cityMapBlockedCell:
+				blocked = append(blocked, Location{x: i + minC, y: height, isSet: true})
+			default:
+				return CityMap{}, fmt.Errorf("row %d: unknown cell %q", height, cell)
+			}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return CityMap{}, err
+	}
+
+	if height == 0 {
+		return CityMap{}, errors.New("empty city map")
+	}
+
+	return NewCityMap(width, height, blocked...)
+}
+
+func (m CityMap) Width() int {
+	return m.width
+}
+
+func (m CityMap) Height() int {
+	return m.height
+}
+
+func (m CityMap) IsEmpty() bool {
+	return !m.isSet
+}
+
+// BlockedCells возвращает непроходимые клетки в порядке обхода карты
+func (m CityMap) BlockedCells() []Location {
+	cells := make([]Location, 0, len(m.blocked))
+	for y := minC; y <= m.height; y++ {
+		for x := minC; x <= m.width; x++ {
+			l := Location{x: x, y: y, isSet: true}
+			if m.IsBlocked(l) {
+				cells = append(cells, l)
+			}
+		}
+	}
+
+	return cells
+}
+
+func (m CityMap) IsBlocked(l Location) bool {
+	_, found := m.blocked[l.Cell()]
+	return found
+}
+
+func (m CityMap) IsPassable(l Location) bool {
+	return !l.IsEmpty() && m.inBounds(l) && !m.IsBlocked(l)
+}
+
+func (m CityMap) inBounds(l Location) bool {
+	return l.x >= minC && l.y >= minC && l.x <= m.width && l.y <= m.height
+}
@@ -0,0 +1,59 @@
+package kernel
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseCityMap(t *testing.T) {
+
+	tests := []struct {
+		name          string
+		text          string
+		errIsExpected bool
+	}{
+		{name: "empty", text: "// only comment\n\n", errIsExpected: true},
+		{name: "unknown cell", text: "..x\n...", errIsExpected: true},
+		{name: "different row length", text: "...\n..", errIsExpected: true},
+		{name: "valid", text: "// river\n..#\n\n.#.\n", errIsExpected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m, err := ParseCityMap(strings.NewReader(test.text))
+			if test.errIsExpected {
+				if err == nil {
+					t.Fail()
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if m.Width() != 3 || m.Height() != 2 || len(m.BlockedCells()) != 2 {
+					t.Error("wrong city map data")
+				}
+
+				if !m.IsBlocked(RestoreLocation(3, 1)) || !m.IsBlocked(RestoreLocation(2, 2)) {
+					t.Error("wrong blocked cells")
+				}
+
+				if m.IsPassable(RestoreLocation(4, 1)) || !m.IsPassable(RestoreLocation(1, 1)) {
+					t.Error("wrong passable cells")
+				}
+			}
+		})
+	}
+}
+
+func TestNewCityMap(t *testing.T) {
+	_, err := NewCityMap(0, 1)
+	if err == nil {
+		t.Error("invalid width")
+	}
+
+	_, err = NewCityMap(3, 3, RestoreLocation(4, 1))
+	if err == nil {
+		t.Error("blocked cell is out of map")
+	}
+}
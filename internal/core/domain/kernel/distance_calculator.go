@@ -0,0 +1,145 @@
+package kernel
+
+import (
+	"delivery/internal/pkg/errs"
+	"math"
+	"time"
+)
+
+const kmPerDegreeLat = 111.32
+
+// DistanceCalculator задает метрику, в которой курьеры перемещаются по городу.
+// Скорость курьера задается в единицах Distance за SpeedInterval
+type DistanceCalculator interface {
+	// Distance возвращает расстояние между точками
+	Distance(from Location, to Location) (float64, error)
+
+	// Step возвращает точку, в которой окажется курьер, пройдя от from к to не больше maxDistance
+	Step(from Location, to Location, maxDistance float64) (Location, error)
+
+	// SpeedInterval - за какое время курьер со скоростью 1 проходит единицу расстояния
+	SpeedInterval() time.Duration
+}
+
+var _ DistanceCalculator = &gridDistanceCalculator{}
+
+// gridDistanceCalculator - симуляция: манхэттенское расстояние по клеткам,
+// курьер идет сначала по X, потом по Y
+type gridDistanceCalculator struct {
+}
+
+func NewGridDistanceCalculator() DistanceCalculator {
+	return &gridDistanceCalculator{}
+}
+
+func (gc *gridDistanceCalculator) Distance(from Location, to Location) (float64, error) {
+	distance, err := from.DistanceTo(to)
+	if err != nil {
+		return 0, err
+	}
+
+	return float64(distance), nil
+}
+
+// SpeedInterval - на сетке скорость задается в клетках в секунду
+func (gc *gridDistanceCalculator) SpeedInterval() time.Duration {
+	return time.Second
+}
+
+func (gc *gridDistanceCalculator) Step(from Location, to Location, maxDistance float64) (Location, error) {
+	if from.IsEmpty() || to.IsEmpty() {
+		return Location{}, ErrLocationIsEmpty
+	}
+
+	dx := float64(to.X() - from.X())
+	dy := float64(to.Y() - from.Y())
+	remainingRange := math.Floor(maxDistance)
+
+	if math.Abs(dx) > remainingRange {
+		dx = math.Copysign(remainingRange, dx)
+	}
+	remainingRange -= math.Abs(dx)
+
+	if math.Abs(dy) > remainingRange {
+		dy = math.Copysign(remainingRange, dy)
+	}
+
+	return NewLocation(from.X()+int(dx), from.Y()+int(dy))
+}
+
+var _ DistanceCalculator = &geoDistanceCalculator{}
+
+// geoDistanceCalculator считает расстояние в километрах по формуле гаверсинусов между точными координатами точек.
+// Точка, заданная только клеткой (например, адрес от сервиса геолокации), берется в центре клетки (см. GeoGrid).
+// Курьер идет по прямой, скорость задается в км/ч
+type geoDistanceCalculator struct {
+	grid GeoGrid
+}
+
+func NewGeoDistanceCalculator(grid GeoGrid) (DistanceCalculator, error) {
+	if grid.IsEmpty() {
+		return nil, errs.NewValueIsRequiredError("grid")
+	}
+
+	return &geoDistanceCalculator{
+		grid: grid,
+	}, nil
+}
+
+func (gc *geoDistanceCalculator) Distance(from Location, to Location) (float64, error) {
+	fromGeo, err := gc.grid.ToGeoLocation(from)
+	if err != nil {
+		return 0, err
+	}
+
+	toGeo, err := gc.grid.ToGeoLocation(to)
+	if err != nil {
+		return 0, err
+	}
+
+	return fromGeo.DistanceTo(toGeo)
+}
+
+// SpeedInterval - в режиме geo скорость задается в км/ч
+func (gc *geoDistanceCalculator) SpeedInterval() time.Duration {
+	return time.Hour
+}
+
+func (gc *geoDistanceCalculator) Step(from Location, to Location, maxDistance float64) (Location, error) {
+	distance, err := gc.Distance(from, to)
+	if err != nil {
+		return Location{}, err
+	}
+
+	if distance <= maxDistance {
+		return to, nil
+	}
+
+	if maxDistance <= 0 {
+		return from, nil
+	}
+
+	fromGeo, _ := gc.grid.ToGeoLocation(from)
+	toGeo, _ := gc.grid.ToGeoLocation(to)
+
+	// В пределах города прямая в градусах достаточно близка к дуге большого круга
+	fraction := maxDistance / distance
+	geo, err := NewGeoLocation(fromGeo.Lat()+(toGeo.Lat()-fromGeo.Lat())*fraction,
+		fromGeo.Lon()+(toGeo.Lon()-fromGeo.Lon())*fraction)
+	if err != nil {
+		return Location{}, err
+	}
+
+	return gc.grid.ToLocation(geo)
+}
+
+func sign(n int) int {
+	switch {
+	case n > 0:
+		return 1
+	case n < 0:
+		return -1
+	default:
+		return 0
+	}
+}
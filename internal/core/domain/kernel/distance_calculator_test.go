@@ -0,0 +1,102 @@
+package kernel
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestGridDistanceCalculator(t *testing.T) {
+	calc := NewGridDistanceCalculator()
+
+	from, _ := NewLocation(2, 3)
+	to, _ := NewLocation(9, 4)
+
+	distance, err := calc.Distance(from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if distance != 8 {
+		t.Errorf("distance: %f, expected: 8", distance)
+	}
+
+	// сначала по X, потом по Y
+	step, _ := calc.Step(from, to, 3)
+	if step.X() != 5 || step.Y() != 3 {
+		t.Errorf("step: (%d, %d), expected: (5, 3)", step.X(), step.Y())
+	}
+
+	step, _ = calc.Step(from, to, 10)
+	if !step.Equals(to) {
+		t.Error("expected target reached")
+	}
+}
+
+func TestGeoDistanceCalculator(t *testing.T) {
+	origin, _ := NewGeoLocation(55.75, 37.61)
+
+	_, err := NewGeoDistanceCalculator(GeoGrid{})
+	if err == nil {
+		t.Error("empty grid")
+	}
+
+	grid, _ := NewGeoGrid(origin, 0.5)
+	calc, err := NewGeoDistanceCalculator(grid)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if calc.SpeedInterval() != time.Hour {
+		t.Error("expected speed in km/h")
+	}
+
+	from, _ := NewLocation(1, 1)
+	east, _ := NewLocation(3, 1)
+	diagonal, _ := NewLocation(4, 5)
+
+	// точки без координат берутся в центрах клеток: соседние клетки отстоят на размер клетки
+	distance, _ := calc.Distance(from, east)
+	if math.Abs(distance-1) > 0.01 {
+		t.Errorf("distance: %f, expected: ~1", distance)
+	}
+
+	// по прямой: 3 и 4 клетки по катетам - 5 клеток по гипотенузе
+	distance, _ = calc.Distance(from, diagonal)
+	if math.Abs(distance-2.5) > 0.01 {
+		t.Errorf("distance: %f, expected: ~2.5", distance)
+	}
+
+	// точные координаты внутри клетки учитываются
+	exactGeo, _ := NewGeoLocation(origin.Lat(), origin.Lon()+0.2/(kmPerDegreeLat*math.Cos(origin.Lat()*math.Pi/180)))
+	exact, _ := grid.ToLocation(exactGeo)
+	if !exact.Equals(from) {
+		t.Fatal("expected point in origin cell")
+	}
+
+	distance, _ = calc.Distance(exact, east)
+	if math.Abs(distance-0.8) > 0.01 {
+		t.Errorf("distance: %f, expected: ~0.8", distance)
+	}
+
+	// шаг меньше клетки сдвигает точные координаты
+	step, _ := calc.Step(from, diagonal, 0.1)
+	if !step.Equals(from) || step.Geo().IsEmpty() {
+		t.Error("expected courier moved inside the cell")
+	}
+
+	distance, _ = calc.Distance(step, diagonal)
+	if math.Abs(distance-2.4) > 0.01 {
+		t.Errorf("distance: %f, expected: ~2.4", distance)
+	}
+
+	step, _ = calc.Step(from, diagonal, 1)
+	if step.X() != 2 || step.Y() != 3 {
+		t.Errorf("step: (%d, %d), expected: (2, 3)", step.X(), step.Y())
+	}
+
+	step, _ = calc.Step(from, diagonal, 3)
+	if !step.Equals(diagonal) {
+		t.Error("expected target reached")
+	}
+}
@@ -0,0 +1,73 @@
+package kernel
+
+import (
+	"delivery/internal/pkg/errs"
+	"math"
+)
+
+// GeoGrid связывает клетки сетки с координатами: клетка 1,1 - origin, ось X - на восток, ось Y - на север
+type GeoGrid struct {
+	origin     GeoLocation
+	cellSizeKm float64
+
+	isSet bool
+}
+
+func NewGeoGrid(origin GeoLocation, cellSizeKm float64) (GeoGrid, error) {
+	if origin.IsEmpty() {
+		return GeoGrid{}, errs.NewValueIsRequiredError("origin")
+	}
+
+	if cellSizeKm <= 0 {
+		return GeoGrid{}, errs.NewValueIsInvalidError("cellSizeKm")
+	}
+
+	return GeoGrid{
+		origin:     origin,
+		cellSizeKm: cellSizeKm,
+		isSet:      true,
+	}, nil
+}
+
+func (g GeoGrid) IsEmpty() bool {
+	return !g.isSet
+}
+
+// ToGeoLocation возвращает точные координаты точки, а для точки, заданной только клеткой, - координаты центра клетки
+func (g GeoGrid) ToGeoLocation(l Location) (GeoLocation, error) {
+	if l.IsEmpty() {
+		return GeoLocation{}, ErrLocationIsEmpty
+	}
+
+	if !l.Geo().IsEmpty() {
+		return l.Geo(), nil
+	}
+
+	lat := g.origin.Lat() + float64(l.Y()-minC)*g.cellSizeKm/kmPerDegreeLat
+	lon := g.origin.Lon() + float64(l.X()-minC)*g.cellSizeKm/g.kmPerDegreeLon()
+
+	return NewGeoLocation(lat, lon)
+}
+
+// ToLocation возвращает клетку, центр которой ближе всего к точке, вместе с точными координатами.
+// Точка южнее или западнее origin лежит за пределами сетки
+func (g GeoGrid) ToLocation(l GeoLocation) (Location, error) {
+	if l.IsEmpty() {
+		return Location{}, ErrLocationIsEmpty
+	}
+
+	x := minC + int(math.Round((l.Lon()-g.origin.Lon())*g.kmPerDegreeLon()/g.cellSizeKm))
+	y := minC + int(math.Round((l.Lat()-g.origin.Lat())*kmPerDegreeLat/g.cellSizeKm))
+
+	cell, err := NewLocation(x, y)
+	if err != nil {
+		return Location{}, err
+	}
+
+	cell.geo = l
+	return cell, nil
+}
+
+func (g GeoGrid) kmPerDegreeLon() float64 {
+	return kmPerDegreeLat * math.Cos(g.origin.Lat()*math.Pi/180)
+}
@@ -0,0 +1,50 @@
+package kernel
+
+import "testing"
+
+func TestGeoGrid(t *testing.T) {
+	origin, _ := NewGeoLocation(55.75, 37.61)
+
+	if _, err := NewGeoGrid(GeoLocation{}, 1); err == nil {
+		t.Error("empty origin")
+	}
+
+	if _, err := NewGeoGrid(origin, 0); err == nil {
+		t.Error("invalid cell size")
+	}
+
+	grid, err := NewGeoGrid(origin, 0.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// центр клетки переводится обратно в ту же клетку
+	cell, _ := NewLocation(4, 7)
+	center, err := grid.ToGeoLocation(cell)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	back, err := grid.ToLocation(center)
+	if err != nil || !back.Equals(cell) {
+		t.Errorf("expected cell (4, 7), got (%d, %d)", back.X(), back.Y())
+	}
+
+	// точка рядом с центром относится к ближайшей клетке
+	near, _ := NewGeoLocation(center.Lat()+0.001, center.Lon()-0.001)
+	back, _ = grid.ToLocation(near)
+	if !back.Equals(cell) {
+		t.Errorf("expected cell (4, 7), got (%d, %d)", back.X(), back.Y())
+	}
+
+	// точные координаты сохраняются вместе с клеткой
+	exact, _ := grid.ToGeoLocation(back)
+	if !exact.Equals(near) {
+		t.Error("expected exact coordinates kept")
+	}
+
+	south, _ := NewGeoLocation(origin.Lat()-0.1, origin.Lon())
+	if _, err = grid.ToLocation(south); err == nil {
+		t.Error("point south of origin is outside the grid")
+	}
+}
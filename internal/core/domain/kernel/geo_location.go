@@ -0,0 +1,60 @@
+package kernel
+
+import (
+	"delivery/internal/pkg/errs"
+	"math"
+)
+
+const earthRadiusKm = 6371.0
+
+// GeoLocation - точка на поверхности Земли в градусах
+type GeoLocation struct {
+	lat float64
+	lon float64
+
+	isSet bool
+}
+
+func NewGeoLocation(lat float64, lon float64) (GeoLocation, error) {
+	if lat < -90 || lat > 90 {
+		return GeoLocation{}, errs.NewValueIsOutOfRangeError("lat", lat, -90, 90)
+	}
+
+	if lon < -180 || lon > 180 {
+		return GeoLocation{}, errs.NewValueIsOutOfRangeError("lon", lon, -180, 180)
+	}
+
+	return GeoLocation{lat: lat, lon: lon, isSet: true}, nil
+}
+
+func (l GeoLocation) Lat() float64 {
+	return l.lat
+}
+
+func (l GeoLocation) Lon() float64 {
+	return l.lon
+}
+
+func (l GeoLocation) IsEmpty() bool {
+	return !l.isSet
+}
+
+func (l GeoLocation) Equals(other GeoLocation) bool {
+	return l == other
+}
+
+// DistanceTo возвращает расстояние по поверхности Земли в километрах (формула гаверсинусов)
+func (l GeoLocation) DistanceTo(target GeoLocation) (float64, error) {
+	if l.IsEmpty() || target.IsEmpty() {
+		return 0, ErrLocationIsEmpty
+	}
+
+	lat1 := l.lat * math.Pi / 180
+	lat2 := target.lat * math.Pi / 180
+	dLat := lat2 - lat1
+	dLon := (target.lon - l.lon) * math.Pi / 180
+
+	h := math.Pow(math.Sin(dLat/2), 2) + math.Cos(lat1)*math.Cos(lat2)*math.Pow(math.Sin(dLon/2), 2)
+
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(h))), nil
+}
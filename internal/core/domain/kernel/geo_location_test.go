@@ -0,0 +1,66 @@
+package kernel
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestNewGeoLocation(t *testing.T) {
+
+	tests := []struct {
+		lat           float64
+		lon           float64
+		errIsExpected bool
+	}{
+		{lat: 91, lon: 0, errIsExpected: true},
+		{lat: -91, lon: 0, errIsExpected: true},
+		{lat: 0, lon: 181, errIsExpected: true},
+		{lat: 0, lon: -181, errIsExpected: true},
+		{lat: 55.75, lon: 37.61, errIsExpected: false},
+		{lat: -90, lon: 180, errIsExpected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%f,%f", test.lat, test.lon), func(t *testing.T) {
+			l, err := NewGeoLocation(test.lat, test.lon)
+			if test.errIsExpected {
+				if err == nil {
+					t.Fail()
+				}
+			} else {
+				if err != nil {
+					t.Fail()
+				}
+
+				if l.IsEmpty() || l.Lat() != test.lat || l.Lon() != test.lon {
+					t.Error("wrong geo location data")
+				}
+			}
+		})
+	}
+}
+
+func TestGeoLocation_DistanceTo(t *testing.T) {
+	moscow, _ := NewGeoLocation(55.7558, 37.6173)
+	petersburg, _ := NewGeoLocation(59.9343, 30.3351)
+
+	distance, err := moscow.DistanceTo(petersburg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if math.Abs(distance-634) > 2 {
+		t.Errorf("distance: %f, expected: ~634", distance)
+	}
+
+	distance, _ = moscow.DistanceTo(moscow)
+	if distance != 0 {
+		t.Error("distance to itself must be 0")
+	}
+
+	_, err = moscow.DistanceTo(GeoLocation{})
+	if err == nil {
+		t.Error("empty location")
+	}
+}
@@ -3,39 +3,31 @@ package kernel
 import (
 	"delivery/internal/pkg/errs"
 	"errors"
-	"math/rand"
 )
 
-const (
-	minC = 1
-	maxC = 10
-)
+const minC = 1
 
 var ErrLocationIsEmpty = errors.New("location is empty")
 
+// Location - клетка сетки. В режиме geo точка несет и точные координаты (см. GeoGrid.ToLocation):
+// расстояния считаются по ним, а клетка остается зоной прибытия и нужна зоне обслуживания и симуляции
 type Location struct {
-	x int
-	y int
+	x   int
+	y   int
+	geo GeoLocation
 
 	isSet bool
 }
 
+// NewLocation создает точку на сетке. Принадлежность зоне обслуживания проверяет ServiceArea
 func NewLocation(x int, y int) (Location, error) {
-	if x < minC || y < minC || x > maxC || y > maxC {
+	if x < minC || y < minC {
 		return Location{}, errs.ErrValueIsOutOfRange
 	}
 
 	return Location{x: x, y: y, isSet: true}, nil
 }
 
-func NewRandomLocation() Location {
-	return Location{
-		x:     rand.Intn(maxC-minC+1) + minC,
-		y:     rand.Intn(maxC-minC+1) + minC,
-		isSet: true,
-	}
-}
-
 func (l Location) X() int {
 	return l.x
 }
@@ -44,12 +36,24 @@ func (l Location) Y() int {
 	return l.y
 }
 
+// Geo возвращает точные координаты точки. Пустые - точка задана только клеткой
+func (l Location) Geo() GeoLocation {
+	return l.geo
+}
+
+// Cell возвращает клетку точки без координат
+func (l Location) Cell() Location {
+	l.geo = GeoLocation{}
+	return l
+}
+
 func (l Location) IsEmpty() bool {
 	return !l.isSet
 }
 
+// Equals сравнивает клетки: курьер, оказавшийся в клетке цели, прибыл
 func (l Location) Equals(other Location) bool {
-	return l == other
+	return l.Cell() == other.Cell()
 }
 
 func abs(n int) int {
@@ -75,3 +79,13 @@ func RestoreLocation(x int, y int) Location {
 		isSet: true,
 	}
 }
+
+// RestoreGeoLocation should be used ONLY inside Repository
+func RestoreGeoLocation(x int, y int, lat float64, lon float64) Location {
+	return Location{
+		x:     x,
+		y:     y,
+		geo:   GeoLocation{lat: lat, lon: lon, isSet: true},
+		isSet: true,
+	}
+}
@@ -25,7 +25,7 @@ func TestNewLocation(t *testing.T) {
 		{
 			x:             11,
 			y:             3,
-			errIsExpected: true,
+			errIsExpected: false,
 		},
 		{
 			x:             4,
@@ -78,6 +78,12 @@ func TestLocation_Equals(t *testing.T) {
 	if !l1.Equals(l2) {
 		t.Fail()
 	}
+
+	// точки в одной клетке совпадают независимо от точных координат
+	l3 := RestoreGeoLocation(2, 3, 55.75, 37.61)
+	if !l1.Equals(l3) || !l3.Cell().Geo().IsEmpty() {
+		t.Fail()
+	}
 }
 
 func TestLocation_DistanceTo(t *testing.T) {
@@ -152,12 +158,3 @@ func TestLocation_DistanceTo(t *testing.T) {
 	}
 
 }
-
-func TestNewRandomLocation(t *testing.T) {
-	for range 1000 {
-		l := NewRandomLocation()
-		if l.X() < minC || l.Y() < minC || l.X() > maxC || l.Y() > maxC {
-			t.Fail()
-		}
-	}
-}
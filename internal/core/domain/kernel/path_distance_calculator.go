@@ -0,0 +1,188 @@
+package kernel
+
+import (
+	"container/heap"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+var ErrPathNotFound = errors.New("path not found")
+
+var _ DistanceCalculator = &pathDistanceCalculator{}
+
+// pathDistanceCalculator ведет курьера по кратчайшему маршруту в обход непроходимых клеток (A*).
+// Расстояние - число клеток маршрута
+type pathDistanceCalculator struct {
+	cityMap CityMap
+}
+
+func NewPathDistanceCalculator(cityMap CityMap) (DistanceCalculator, error) {
+	if cityMap.IsEmpty() {
+		return nil, errors.New("empty city map")
+	}
+
+	return &pathDistanceCalculator{cityMap: cityMap}, nil
+}
+
+func (pc *pathDistanceCalculator) Distance(from Location, to Location) (float64, error) {
+	path, err := pc.findPath(from, to)
+	if err != nil {
+		return 0, err
+	}
+
+	return float64(len(path)), nil
+}
+
+// SpeedInterval - как и без карты, скорость задается в клетках в секунду
+func (pc *pathDistanceCalculator) SpeedInterval() time.Duration {
+	return time.Second
+}
+
+func (pc *pathDistanceCalculator) Step(from Location, to Location, maxDistance float64) (Location, error) {
+	path, err := pc.findPath(from, to)
+	if err != nil {
+		return Location{}, err
+	}
+
+	steps := int(math.Min(math.Floor(maxDistance), float64(len(path))))
+	if steps <= 0 {
+		return from, nil
+	}
+
+	return path[steps-1], nil
+}
+
+// findPath возвращает клетки маршрута без начальной. При равной длине маршрута
+// предпочитается движение сначала по X, как в симуляции без препятствий
+func (pc *pathDistanceCalculator) findPath(from Location, to Location) ([]Location, error) {
+	if from.IsEmpty() || to.IsEmpty() {
+		return nil, ErrLocationIsEmpty
+	}
+
+	if !pc.cityMap.IsPassable(from) {
+		return nil, fmt.Errorf("%d,%d is not passable: %w", from.X(), from.Y(), ErrPathNotFound)
+	}
+
+	if !pc.cityMap.IsPassable(to) {
+		return nil, fmt.Errorf("%d,%d is not passable: %w", to.X(), to.Y(), ErrPathNotFound)
+	}
+
+	if from.Equals(to) {
+		return []Location{}, nil
+	}
+
+	cameFrom := map[Location]Location{}
+	cost := map[Location]int{from: 0}
+
+	open := &pathQueue{}
+	heap.Push(open, &pathNode{location: from, priority: heuristic(from, to)})
+
+	for open.Len() > 0 {
+		current := heap.Pop(open).(*pathNode).location
+		if current.Equals(to) {
+			return reconstructPath(cameFrom, from, to), nil
+		}
+
+		for _, next := range pc.neighbours(current, to) {
+			nextCost := cost[current] + 1
+			if known, found := cost[next]; found && known <= nextCost {
+				continue
+			}
+
+			cost[next] = nextCost
+			cameFrom[next] = current
+			heap.Push(open, &pathNode{location: next, priority: nextCost + heuristic(next, to)})
+		}
+	}
+
+	return nil, fmt.Errorf("%d,%d -> %d,%d: %w", from.X(), from.Y(), to.X(), to.Y(), ErrPathNotFound)
+}
+
+func (pc *pathDistanceCalculator) neighbours(l Location, target Location) []Location {
+	dx := sign(target.X() - l.X())
+	dy := sign(target.Y() - l.Y())
+
+	// сначала клетки в сторону цели, по X раньше Y
+	candidates := [][2]int{{dx, 0}, {0, dy}, {-dx, 0}, {0, -dy}}
+	if dx == 0 {
+		candidates = append(candidates, [2]int{1, 0}, [2]int{-1, 0})
+	}
+	if dy == 0 {
+		candidates = append(candidates, [2]int{0, 1}, [2]int{0, -1})
+	}
+
+	result := make([]Location, 0, 4)
+	for _, c := range candidates {
+		if c[0] == 0 && c[1] == 0 {
+			continue
+		}
+
+		n := Location{x: l.X() + c[0], y: l.Y() + c[1], isSet: true}
+		if pc.cityMap.IsPassable(n) {
+			result = append(result, n)
+		}
+	}
+
+	return result
+}
+
+func heuristic(from Location, to Location) int {
+	return abs(from.X()-to.X()) + abs(from.Y()-to.Y())
+}
+
+func reconstructPath(cameFrom map[Location]Location, from Location, to Location) []Location {
+	path := []Location{}
+	for current := to; !current.Equals(from); current = cameFrom[current] {
+		path = append(path, current)
+	}
+
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+
+	return path
+}
+
+type pathNode struct {
+	location Location
+	priority int
+	order    int
+}
+
+// pathQueue - очередь с приоритетом для A*, при равном приоритете раньше извлекается добавленный раньше узел
+type pathQueue struct {
+	nodes []*pathNode
+	seq   int
+}
+
+func (q *pathQueue) Len() int {
+	return len(q.nodes)
+}
+
+func (q *pathQueue) Less(i, j int) bool {
+	if q.nodes[i].priority != q.nodes[j].priority {
+		return q.nodes[i].priority < q.nodes[j].priority
+	}
+
+	return q.nodes[i].order < q.nodes[j].order
+}
+
+func (q *pathQueue) Swap(i, j int) {
+	q.nodes[i], q.nodes[j] = q.nodes[j], q.nodes[i]
+}
+
+func (q *pathQueue) Push(x any) {
+	node := x.(*pathNode)
+	node.order = q.seq
+	q.seq++
+	q.nodes = append(q.nodes, node)
+}
+
+func (q *pathQueue) Pop() any {
+	n := len(q.nodes)
+	node := q.nodes[n-1]
+	q.nodes = q.nodes[:n-1]
+	return node
+}
@@ -0,0 +1,90 @@
+package kernel
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// река через весь город с единственным мостом в нижнем ряду
+const riverMap = `
+.....
+..#..
+..#..
+..#..
+.....
+`
+
+func TestPathDistanceCalculator_Distance(t *testing.T) {
+	m, _ := ParseCityMap(strings.NewReader(riverMap))
+	calc, err := NewPathDistanceCalculator(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	from := RestoreLocation(1, 3)
+	to := RestoreLocation(5, 3)
+
+	// по прямой 4 клетки, в обход реки через верхний ряд - 8
+	distance, err := calc.Distance(from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if distance != 8 {
+		t.Errorf("distance: %f, expected: 8", distance)
+	}
+
+	distance, _ = calc.Distance(from, from)
+	if distance != 0 {
+		t.Error("distance to itself must be 0")
+	}
+
+	_, err = calc.Distance(from, RestoreLocation(3, 2))
+	if !errors.Is(err, ErrPathNotFound) {
+		t.Error("target is blocked")
+	}
+
+	closed, _ := ParseCityMap(strings.NewReader("..#..\n..#.."))
+	calc, _ = NewPathDistanceCalculator(closed)
+	_, err = calc.Distance(RestoreLocation(1, 1), RestoreLocation(5, 2))
+	if !errors.Is(err, ErrPathNotFound) {
+		t.Error("target is unreachable")
+	}
+}
+
+func TestPathDistanceCalculator_Step(t *testing.T) {
+	m, _ := ParseCityMap(strings.NewReader(riverMap))
+	calc, _ := NewPathDistanceCalculator(m)
+
+	from := RestoreLocation(1, 3)
+	to := RestoreLocation(5, 3)
+
+	// курьер идет вдоль реки, а не сквозь нее
+	location := from
+	for range 8 {
+		next, err := calc.Step(location, to, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if m.IsBlocked(next) {
+			t.Fatalf("courier stepped into blocked cell %d,%d", next.X(), next.Y())
+		}
+
+		location = next
+	}
+
+	if !location.Equals(to) {
+		t.Errorf("location: (%d, %d), expected target reached", location.X(), location.Y())
+	}
+
+	// без препятствий идем сначала по X, как в симуляции
+	open, _ := NewCityMap(10, 10)
+	calc, _ = NewPathDistanceCalculator(open)
+
+	next, _ := calc.Step(RestoreLocation(2, 3), RestoreLocation(9, 4), 3)
+	if next.X() != 5 || next.Y() != 3 {
+		t.Errorf("step: (%d, %d), expected: (5, 3)", next.X(), next.Y())
+	}
+}
@@ -0,0 +1,35 @@
+package kernel
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// IDGenerator выдает идентификаторы новых сущностей и событий
+type IDGenerator interface {
+	NewId() uuid.UUID
+}
+
+// Clock - источник текущего времени
+type Clock interface {
+	Now() time.Time
+}
+
+// RandomSource - источник случайных чисел. Intn возвращает число в [0, n)
+type RandomSource interface {
+	Intn(n int) int
+}
+
+// IDGeneratorFunc позволяет использовать функцию как IDGenerator, например kernel.IDGeneratorFunc(uuid.New)
+type IDGeneratorFunc func() uuid.UUID
+
+func (f IDGeneratorFunc) NewId() uuid.UUID {
+	return f()
+}
+
+// ClockFunc позволяет использовать функцию как Clock
+type ClockFunc func() time.Time
+
+func (f ClockFunc) Now() time.Time {
+	return f()
+}
@@ -0,0 +1,110 @@
+package kernel
+
+import (
+	"delivery/internal/pkg/errs"
+	"errors"
+	"fmt"
+)
+
+var ErrLocationOutOfServiceArea = errors.New("location is out of service area")
+
+// ServiceArea - зона обслуживания города: сетка width x height с координатами от 1,
+// из которой могут быть исключены отдельные клетки (водоемы, закрытые территории и т.п.)
+type ServiceArea struct {
+	width    int
+	height   int
+	excluded map[Location]struct{}
+
+	isSet bool
+}
+
+func NewServiceArea(width int, height int, excluded ...Location) (ServiceArea, error) {
+	if width < minC {
+		return ServiceArea{}, errs.NewValueIsOutOfRangeError("width", width, minC, "∞")
+	}
+
+	if height < minC {
+		return ServiceArea{}, errs.NewValueIsOutOfRangeError("height", height, minC, "∞")
+	}
+
+	area := ServiceArea{
+		width:    width,
+		height:   height,
+		excluded: make(map[Location]struct{}, len(excluded)),
+		isSet:    true,
+	}
+
+	for _, cell := range excluded {
+		if cell.IsEmpty() || !area.inBounds(cell) {
+			return ServiceArea{}, fmt.Errorf("excluded cell %d,%d: %w", cell.X(), cell.Y(), ErrLocationOutOfServiceArea)
+		}
+
+		area.excluded[cell.Cell()] = struct{}{}
+	}
+
+	if len(area.excluded) == width*height {
+		return ServiceArea{}, errors.New("all cells of service area are excluded")
+	}
+
+	return area, nil
+}
+
+func (a ServiceArea) Width() int {
+	return a.width
+}
+
+func (a ServiceArea) Height() int {
+	return a.height
+}
+
+func (a ServiceArea) IsEmpty() bool {
+	return !a.isSet
+}
+
+func (a ServiceArea) IsExcluded(l Location) bool {
+	_, found := a.excluded[l.Cell()]
+	return found
+}
+
+func (a ServiceArea) Contains(l Location) bool {
+	return !l.IsEmpty() && a.inBounds(l) && !a.IsExcluded(l)
+}
+
+// Validate возвращает ErrLocationOutOfServiceArea, если доставить в точку нельзя
+func (a ServiceArea) Validate(l Location) error {
+	if l.IsEmpty() {
+		return ErrLocationIsEmpty
+	}
+
+	if !a.Contains(l) {
+		return fmt.Errorf("%d,%d: %w (%dx%d)", l.X(), l.Y(), ErrLocationOutOfServiceArea, a.width, a.height)
+	}
+
+	return nil
+}
+
+// RandomLocation возвращает случайную точку зоны, исключенные клетки не выпадают
+func (a ServiceArea) RandomLocation(rnd RandomSource) Location {
+	free := a.width*a.height - len(a.excluded)
+	n := rnd.Intn(free)
+
+	for y := minC; y <= a.height; y++ {
+		for x := minC; x <= a.width; x++ {
+			l := Location{x: x, y: y, isSet: true}
+			if a.IsExcluded(l) {
+				continue
+			}
+
+			if n == 0 {
+				return l
+			}
+			n--
+		}
+	}
+
+	return Location{}
+}
+
+func (a ServiceArea) inBounds(l Location) bool {
+	return l.x >= minC && l.y >= minC && l.x <= a.width && l.y <= a.height
+}
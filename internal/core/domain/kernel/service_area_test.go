@@ -0,0 +1,108 @@
+package kernel
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func TestNewServiceArea(t *testing.T) {
+
+	outside, _ := NewLocation(11, 1)
+	inside, _ := NewLocation(2, 2)
+
+	tests := []struct {
+		width         int
+		height        int
+		excluded      []Location
+		errIsExpected bool
+	}{
+		{width: 0, height: 10, errIsExpected: true},
+		{width: 10, height: 0, errIsExpected: true},
+		{width: 10, height: 10, excluded: []Location{{}}, errIsExpected: true},
+		{width: 10, height: 10, excluded: []Location{outside}, errIsExpected: true},
+		{width: 2, height: 2, excluded: []Location{
+			RestoreLocation(1, 1), RestoreLocation(1, 2), RestoreLocation(2, 1), RestoreLocation(2, 2),
+		}, errIsExpected: true},
+		{width: 10, height: 10, excluded: []Location{inside}, errIsExpected: false},
+		{width: 20, height: 5, errIsExpected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%dx%d", test.width, test.height), func(t *testing.T) {
+			a, err := NewServiceArea(test.width, test.height, test.excluded...)
+			if test.errIsExpected {
+				if err == nil {
+					t.Fail()
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if a.IsEmpty() || a.Width() != test.width || a.Height() != test.height {
+					t.Error("wrong service area data")
+				}
+			}
+		})
+	}
+}
+
+func TestServiceArea_Validate(t *testing.T) {
+	excluded, _ := NewLocation(3, 3)
+	a, _ := NewServiceArea(20, 5, excluded)
+
+	tests := []struct {
+		location      Location
+		errIsExpected bool
+	}{
+		{location: RestoreLocation(1, 1), errIsExpected: false},
+		{location: RestoreLocation(20, 5), errIsExpected: false},
+		{location: RestoreLocation(21, 5), errIsExpected: true},
+		{location: RestoreLocation(5, 6), errIsExpected: true},
+		{location: excluded, errIsExpected: true},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%d,%d", test.location.X(), test.location.Y()), func(t *testing.T) {
+			err := a.Validate(test.location)
+			if test.errIsExpected {
+				if !errors.Is(err, ErrLocationOutOfServiceArea) {
+					t.Fail()
+				}
+			} else {
+				if err != nil {
+					t.Fail()
+				}
+			}
+		})
+	}
+
+	if !errors.Is(a.Validate(Location{}), ErrLocationIsEmpty) {
+		t.Error("empty location")
+	}
+}
+
+func TestServiceArea_RandomLocation(t *testing.T) {
+	excluded, _ := NewLocation(1, 1)
+	a, _ := NewServiceArea(2, 3, excluded)
+
+	rnd := rand.New(rand.NewSource(time.Now().UnixNano()))
+	for range 1000 {
+		l := a.RandomLocation(rnd)
+		if !a.Contains(l) {
+			t.Fatalf("%d,%d is out of service area", l.X(), l.Y())
+		}
+	}
+
+	// с одинаковым seed точки совпадают
+	rnd1 := rand.New(rand.NewSource(42))
+	rnd2 := rand.New(rand.NewSource(42))
+	for range 100 {
+		if a.RandomLocation(rnd1) != a.RandomLocation(rnd2) {
+			t.Fatal("expected same locations for same seed")
+		}
+	}
+}
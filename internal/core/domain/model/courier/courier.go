@@ -3,21 +3,35 @@ package courier
 import (
 	"delivery/internal/core/domain/kernel"
 	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/ddd"
 	"errors"
+	"fmt"
 	"github.com/google/uuid"
 	"math"
+	"slices"
 	"strings"
+	"time"
 )
 
+var ErrStaleLocation = errors.New("location report is not newer than the last one")
+
 type Courier struct {
 	id            uuid.UUID
 	name          string
 	speed         int
 	location      kernel.Location
 	storagePlaces []*StoragePlace
+	lastMovedAt   *time.Time
+	homeDepot     kernel.Location
+	// qualifications - допуски к вручению заказов с ограничениями
+	qualifications []Qualification
+	// locationReportedAt - время последней принятой отметки GPS. Пустое - курьер еще не присылал координаты
+	locationReportedAt *time.Time
+
+	events []ddd.DomainEvent
 }
 
-func NewCourier(name string, speed int, location kernel.Location) (*Courier, error) {
+func NewCourier(name string, speed int, location kernel.Location, ids kernel.IDGenerator) (*Courier, error) {
 
 	if strings.TrimSpace(name) == "" {
 		return nil, errors.New("empty name")
@@ -31,17 +45,35 @@ func NewCourier(name string, speed int, location kernel.Location) (*Courier, err
 		return nil, errors.New("location is empty")
 	}
 
-	bag, _ := NewStoragePlace("Bag", 10)
+	if ids == nil {
+		return nil, errors.New("empty id generator")
+	}
+
+	id := ids.NewId()
+	bag, _ := NewStoragePlace("Bag", StoragePlaceStandard, 10, 0, ids)
 
 	return &Courier{
-		id:            uuid.New(),
+		id:            id,
 		name:          name,
 		speed:         speed,
 		location:      location,
 		storagePlaces: []*StoragePlace{bag},
+		events:        []ddd.DomainEvent{},
 	}, nil
 }
 
+func (c *Courier) GetDomainEvents() []ddd.DomainEvent {
+	return c.events
+}
+
+func (c *Courier) ClearDomainEvents() {
+	c.events = []ddd.DomainEvent{}
+}
+
+func (c *Courier) RaiseDomainEvent(event ddd.DomainEvent) {
+	c.events = append(c.events, event)
+}
+
 func (c *Courier) Equals(other *Courier) bool {
 	return other != nil && c.id == other.id
 }
@@ -62,12 +94,87 @@ func (c *Courier) Location() kernel.Location {
 	return c.location
 }
 
+// LastMovedAt возвращает момент, до которого учтено перемещение курьера. nil - курьер стоит на месте
+func (c *Courier) LastMovedAt() *time.Time {
+	return c.lastMovedAt
+}
+
+func (c *Courier) LocationReportedAt() *time.Time {
+	return c.locationReportedAt
+}
+
+// HomeDepot - склад, к которому приписан курьер (пустая точка, если не задан)
+func (c *Courier) HomeDepot() kernel.Location {
+	return c.homeDepot
+}
+
+func (c *Courier) SetHomeDepot(depot kernel.Location) error {
+	if depot.IsEmpty() {
+		return errors.New("empty depot")
+	}
+
+	c.homeDepot = depot
+	return nil
+}
+
+func (c *Courier) Qualifications() []Qualification {
+	return c.qualifications
+}
+
+func (c *Courier) HasQualification(q Qualification) bool {
+	return slices.Contains(c.qualifications, q)
+}
+
+// GrantQualification выдает курьеру допуск. Повторная выдача ничего не меняет
+func (c *Courier) GrantQualification(q Qualification) error {
+	if !q.IsValid() {
+		return errors.New("invalid qualification")
+	}
+
+	if !c.HasQualification(q) {
+		c.qualifications = append(c.qualifications, q)
+	}
+
+	return nil
+}
+
+// RevokeQualification отзывает допуск. Заказы, которые курьер уже везет, остаются у него
+func (c *Courier) RevokeQualification(q Qualification) error {
+	if !q.IsValid() {
+		return errors.New("invalid qualification")
+	}
+
+	c.qualifications = slices.DeleteFunc(c.qualifications, func(existing Qualification) bool {
+		return existing == q
+	})
+
+	return nil
+}
+
+// MissingQualification возвращает первый допуск, которого не хватает курьеру для вручения заказа.
+// Пустое значение - допусков достаточно
+func (c *Courier) MissingQualification(o *order.Order) (Qualification, error) {
+	for _, restriction := range o.Restrictions() {
+		q, err := RequiredQualification(restriction)
+		if err != nil {
+			return "", err
+		}
+
+		if !c.HasQualification(q) {
+			return q, nil
+		}
+	}
+
+	return "", nil
+}
+
 func (c *Courier) StoragePlaces() []*StoragePlace {
 	return c.storagePlaces
 }
 
-func (c *Courier) AddStoragePlace(name string, volume int) error {
-	s, err := NewStoragePlace(name, volume)
+func (c *Courier) AddStoragePlace(name string, placeType StoragePlaceType, volume int, maxWeight int,
+	ids kernel.IDGenerator) error {
+	s, err := NewStoragePlace(name, placeType, volume, maxWeight, ids)
 	if err != nil {
 		return err
 	}
@@ -81,21 +188,50 @@ func (c *Courier) CanTakeOrder(o *order.Order) (bool, error) {
 		return false, errors.New("invalid order status")
 	}
 
-	if o.Status() == order.StatusAssigned {
+	// Заказ в работе может взять только курьер, которому его передал оператор (см. Order.Reassign)
+	if o.Status().IsInProgress() && (o.CourierId() == nil || *o.CourierId() != c.id) {
 		return false, errors.New("order is already assigned")
 	}
 
+	if c.holds(o) != nil {
+		return false, errors.New("order is already taken")
+	}
+
 	if o.Status() == order.StatusCompleted {
 		return false, errors.New("order is completed")
 	}
 
-	for _, place := range c.StoragePlaces() {
-		if !place.IsOccupied() && place.CanStore(o.Volume()) {
+	missing, err := c.MissingQualification(o)
+	if err != nil {
+		return false, err
+	}
+
+	if missing != "" {
+		return false, fmt.Errorf("missing qualification %s", missing)
+	}
+
+	if c.findPlaceFor(o) == nil {
+		return false, errors.New("storage place not found")
+	}
+
+	return true, nil
+}
+
+// IsSuitableFor сообщает, может ли курьер в принципе доставить заказ: допусков хватает
+// и есть место хранения нужного типа, объема и грузоподъемности, пусть сейчас и занятое
+func (c *Courier) IsSuitableFor(o *order.Order) (bool, error) {
+	missing, err := c.MissingQualification(o)
+	if err != nil || missing != "" {
+		return false, err
+	}
+
+	for _, place := range c.storagePlaces {
+		if place.Type().Serves(o.Handling()) && place.CanStore(o.Volume(), o.Weight()) {
 			return true, nil
 		}
 	}
 
-	return false, errors.New("storage place not found")
+	return false, nil
 }
 
 func (c *Courier) TakeOrder(o *order.Order) error {
@@ -104,98 +240,269 @@ func (c *Courier) TakeOrder(o *order.Order) error {
 		return err
 	}
 
-	for _, place := range c.StoragePlaces() {
-		if !place.IsOccupied() && place.CanStore(o.Volume()) {
+	return c.findPlaceFor(o).Store(o.Id(), o.Volume(), o.Weight())
+}
 
-			err = place.Store(o.Id(), o.Volume())
-			if err != nil {
-				return err
-			}
+// findPlaceFor ищет свободное место, подходящее заказу по объему, весу и условиям перевозки.
+// Место, предназначенное именно для таких заказов, выбирается раньше универсального
+func (c *Courier) findPlaceFor(o *order.Order) *StoragePlace {
+	var fallback *StoragePlace
+	for _, place := range c.storagePlaces {
+		if place.IsOccupied() || !place.Type().Serves(o.Handling()) || !place.CanStore(o.Volume(), o.Weight()) {
+			continue
+		}
 
-			return nil
+		if place.Type().matches(o.Handling()) {
+			return place
+		}
+
+		if fallback == nil {
+			fallback = place
 		}
 	}
 
-	return errors.New("storage place not found")
+	return fallback
 }
 
 func (c *Courier) CompleteOrder(o *order.Order) error {
+	return c.ReleaseOrder(o)
+}
 
-	var place *StoragePlace = nil
-	for _, p := range c.StoragePlaces() {
-		if p.OrderID() != nil && *p.OrderID() == o.Id() {
-			place = p
-			break
-		}
-	}
-
+// ReleaseOrder освобождает место хранения заказа, который снят с курьера или передан другому
+func (c *Courier) ReleaseOrder(o *order.Order) error {
+	place := c.holds(o)
 	if place == nil {
 		return errors.New("non-owned order")
 	}
 
 	place.Clear()
+
+	// Свободный курьер стоит на месте - время простоя не должно превратиться в пройденный путь
+	if c.isFree() {
+		c.lastMovedAt = nil
+	}
+
+	return nil
+}
+
+// holds возвращает место хранения, в котором лежит заказ
+func (c *Courier) holds(o *order.Order) *StoragePlace {
+	for _, p := range c.storagePlaces {
+		if p.OrderID() != nil && *p.OrderID() == o.Id() {
+			return p
+		}
+	}
+
 	return nil
 }
 
-func (c *Courier) CalculateTimeToLocation(target kernel.Location) (float64, error) {
+func (c *Courier) isFree() bool {
+	for _, p := range c.storagePlaces {
+		if p.IsOccupied() {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (c *Courier) CalculateTimeToLocation(target kernel.Location, calc kernel.DistanceCalculator) (float64, error) {
 	if target.IsEmpty() {
 		return 0, errors.New("empty location")
 	}
 
-	distance, err := c.Location().DistanceTo(target)
+	if calc == nil {
+		return 0, errors.New("empty distance calculator")
+	}
+
+	distance, err := calc.Distance(c.Location(), target)
 	if err != nil {
 		return 0, err
 	}
 
-	return roundFloat(float64(distance)/float64(c.speed), 3), nil
+	return roundFloat(c.travelTime(distance, calc), 3), nil
 }
 
-func (c *Courier) Move(target kernel.Location) error {
+// travelTime возвращает время в секундах, за которое курьер проходит distance. Скорость курьера задается
+// в единицах DistanceCalculator за его SpeedInterval: в клетках в секунду на сетке, в км/ч в режиме geo
+func (c *Courier) travelTime(distance float64, calc kernel.DistanceCalculator) float64 {
+	return distance / float64(c.speed) * calc.SpeedInterval().Seconds()
+}
+
+// CalculateDeliveryTime оценивает время доставки заказа: для заказа с точкой забора это путь
+// курьер -> pickup плюс pickup -> получатель, иначе - путь от курьера сразу к получателю
+func (c *Courier) CalculateDeliveryTime(o *order.Order, calc kernel.DistanceCalculator) (float64, error) {
+	if o == nil {
+		return 0, errors.New("empty order")
+	}
+
+	if !o.HasPickup() {
+		return c.CalculateTimeToLocation(o.Location(), calc)
+	}
+
+	toPickup, err := c.CalculateTimeToLocation(o.PickupLocation(), calc)
+	if err != nil {
+		return 0, err
+	}
+
+	distance, err := calc.Distance(o.PickupLocation(), o.Location())
+	if err != nil {
+		return 0, err
+	}
+
+	return roundFloat(toPickup+c.travelTime(distance, calc), 3), nil
+}
+
+// Move перемещает курьера к цели на расстояние, пройденное со скоростью speed с последнего перемещения до now.
+// Первый вызов только запоминает время старта. Неизрасходованное время (например, меньше одной клетки на сетке)
+// копится до следующего вызова. Свободный курьер, дошедший до цели, останавливается: следующее перемещение
+// снова начнется с запоминания времени
+func (c *Courier) Move(target kernel.Location, calc kernel.DistanceCalculator, now time.Time,
+	ids kernel.IDGenerator) error {
 	if target.IsEmpty() {
 		return errors.New("empty location")
 	}
 
+	if calc == nil {
+		return errors.New("empty distance calculator")
+	}
+
+	if ids == nil {
+		return errors.New("empty id generator")
+	}
+
+	if now.IsZero() {
+		return errors.New("empty time")
+	}
+
 	if c.location.Equals(target) {
 		return errors.New("on the target")
 	}
 
-	dx := float64(target.X() - c.location.X())
-	dy := float64(target.Y() - c.location.Y())
-	remainingRange := float64(c.speed)
+	if c.lastMovedAt == nil {
+		c.lastMovedAt = &now
+		return nil
+	}
 
-	if math.Abs(dx) > remainingRange {
-		dx = math.Copysign(remainingRange, dx)
+	elapsed := now.Sub(*c.lastMovedAt)
+	maxDistance := float64(c.speed) * elapsed.Seconds() / calc.SpeedInterval().Seconds()
+
+	newLocation, err := calc.Step(c.location, target, maxDistance)
+	if err != nil {
+		return err
 	}
-	remainingRange -= math.Abs(dx)
 
-	if math.Abs(dy) > remainingRange {
-		dy = math.Copysign(remainingRange, dy)
+	if newLocation == c.location {
+		return nil // not enough time to move (no error here)
 	}
 
-	newX := c.location.X() + int(dx)
-	newY := c.location.Y() + int(dy)
+	traveled, err := calc.Distance(c.location, newLocation)
+	if err != nil {
+		return err
+	}
 
-	newLocation, err := kernel.NewLocation(newX, newY)
+	err = c.moveTo(newLocation, now, ids)
 	if err != nil {
 		return err
 	}
 
-	c.location = newLocation
+	if c.location.Equals(target) && c.isFree() {
+		c.lastMovedAt = nil
+	} else if c.location.Equals(target) {
+		c.lastMovedAt = &now
+	} else {
+		movedAt := c.lastMovedAt.Add(time.Duration(c.travelTime(traveled, calc) * float64(time.Second)))
+		c.lastMovedAt = &movedAt
+	}
+
 	return nil
 }
 
+// ReportLocation перемещает курьера в точку, которую прислал его телефон. Отметки приходят пачками
+// и могут опаздывать: отметка не новее последней принятой отклоняется с ErrStaleLocation
+func (c *Courier) ReportLocation(location kernel.Location, reportedAt time.Time, ids kernel.IDGenerator) error {
+	if location.IsEmpty() {
+		return errors.New("empty location")
+	}
+
+	if reportedAt.IsZero() {
+		return errors.New("empty time")
+	}
+
+	if ids == nil {
+		return errors.New("empty id generator")
+	}
+
+	if c.locationReportedAt != nil && !reportedAt.After(*c.locationReportedAt) {
+		return ErrStaleLocation
+	}
+
+	// Новые координаты внутри той же клетки тоже сохраняются
+	if location != c.location {
+		err := c.moveTo(location, reportedAt, ids)
+		if err != nil {
+			return err
+		}
+	}
+
+	c.locationReportedAt = &reportedAt
+
+	return nil
+}
+
+// moveTo переводит курьера в location и выпускает MovedDomainEvent. at - когда курьер там оказался
+func (c *Courier) moveTo(location kernel.Location, at time.Time, ids kernel.IDGenerator) error {
+	movedEvent, err := NewMovedDomainEvent(c.id, location, ids, kernel.ClockFunc(func() time.Time { return at }))
+	if err != nil {
+		return err
+	}
+
+	c.location = location
+	c.RaiseDomainEvent(movedEvent)
+
+	return nil
+}
+
+// ReturnLocation - куда курьер везет товар, который не удалось доставить: на свой склад, без склада -
+// туда, где товар забрал. Если ни того, ни другого нет, товар сдается там, где стоит курьер
+func (c *Courier) ReturnLocation(o *order.Order) kernel.Location {
+	if !c.homeDepot.IsEmpty() {
+		return c.homeDepot
+	}
+
+	if o != nil && o.HasPickup() {
+		return o.PickupLocation()
+	}
+
+	return c.location
+}
+
+// Wait отмечает, что курьер простоял на месте до now: время ожидания не превращается в пройденный путь
+func (c *Courier) Wait(now time.Time) {
+	if c.lastMovedAt != nil {
+		c.lastMovedAt = &now
+	}
+}
+
 func roundFloat(val float64, precision uint) float64 {
 	ratio := math.Pow(10, float64(precision))
 	return math.Round(val*ratio) / ratio
 }
 
 // RestoreCourier should be used ONLY inside Repository
-func RestoreCourier(id uuid.UUID, name string, speed int, location kernel.Location, storagePlaces []*StoragePlace) *Courier {
+func RestoreCourier(id uuid.UUID, name string, speed int, location kernel.Location, storagePlaces []*StoragePlace,
+	lastMovedAt *time.Time, homeDepot kernel.Location, qualifications []Qualification,
+	locationReportedAt *time.Time) *Courier {
 	return &Courier{
-		id:            id,
-		name:          name,
-		speed:         speed,
-		location:      location,
-		storagePlaces: storagePlaces,
+		id:             id,
+		name:           name,
+		speed:          speed,
+		location:       location,
+		storagePlaces:  storagePlaces,
+		lastMovedAt:    lastMovedAt,
+		homeDepot:      homeDepot,
+		qualifications: qualifications,
+
+		locationReportedAt: locationReportedAt,
 	}
 }
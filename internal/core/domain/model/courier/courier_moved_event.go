@@ -0,0 +1,77 @@
+package courier
+
+import (
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/pkg/ddd"
+	"delivery/internal/pkg/errs"
+	"github.com/google/uuid"
+	"reflect"
+	"time"
+)
+
+var _ ddd.TransientEvent = &MovedDomainEvent{}
+
+// MovedDomainEvent - курьер оказался в новой клетке. Событие нужно только карте диспетчера и выпускается
+// на каждом шаге курьера, поэтому в outbox не сохраняется
+type MovedDomainEvent struct {
+	Id         uuid.UUID
+	Name       string
+	OccurredAt time.Time
+
+	CourierId uuid.UUID
+	LocationX int
+	LocationY int
+
+	isValid bool
+}
+
+func NewMovedDomainEvent(courierId uuid.UUID, location kernel.Location, ids kernel.IDGenerator,
+	clock kernel.Clock) (ddd.DomainEvent, error) {
+
+	event := &MovedDomainEvent{}
+	if courierId == uuid.Nil {
+		return event, errs.NewValueIsRequiredError("courierId")
+	}
+
+	if location.IsEmpty() {
+		return event, errs.NewValueIsRequiredError("location")
+	}
+
+	if ids == nil {
+		return event, errs.NewValueIsRequiredError("ids")
+	}
+
+	if clock == nil {
+		return event, errs.NewValueIsRequiredError("clock")
+	}
+
+	event.Id = ids.NewId()
+	event.Name = reflect.TypeOf(event).Elem().Name()
+	event.OccurredAt = clock.Now().UTC()
+	event.CourierId = courierId
+	event.LocationX = location.X()
+	event.LocationY = location.Y()
+	event.isValid = true
+
+	return event, nil
+}
+
+func (e *MovedDomainEvent) GetID() uuid.UUID {
+	return e.Id
+}
+
+func (e *MovedDomainEvent) GetName() string {
+	return e.Name
+}
+
+func (e *MovedDomainEvent) GetOccurredAt() time.Time {
+	return e.OccurredAt
+}
+
+func (e *MovedDomainEvent) IsValid() bool {
+	return e.isValid
+}
+
+func (e *MovedDomainEvent) IsTransient() bool {
+	return true
+}
@@ -0,0 +1,48 @@
+package courier
+
+import (
+	"delivery/internal/core/domain/model/order"
+	"errors"
+)
+
+// Qualification - допуск курьера к вручению заказов с ограничениями (с проверкой документов получателя)
+type Qualification string
+
+const (
+	QualificationAlcohol  Qualification = "alcohol"
+	QualificationMedicine Qualification = "medicine"
+)
+
+func (q Qualification) String() string {
+	return string(q)
+}
+
+func (q Qualification) IsValid() bool {
+	switch q {
+	case QualificationAlcohol, QualificationMedicine:
+		return true
+	default:
+		return false
+	}
+}
+
+func QualificationFromString(s string) (Qualification, error) {
+	qualification := Qualification(s)
+	if qualification.IsValid() {
+		return qualification, nil
+	}
+
+	return qualification, errors.New("invalid qualification")
+}
+
+// RequiredQualification возвращает допуск, без которого заказ с ограничением restriction вручить нельзя
+func RequiredQualification(restriction order.Restriction) (Qualification, error) {
+	switch restriction {
+	case order.RestrictionAlcohol:
+		return QualificationAlcohol, nil
+	case order.RestrictionMedicine:
+		return QualificationMedicine, nil
+	default:
+		return "", errors.New("invalid restriction")
+	}
+}
@@ -7,8 +7,13 @@ import (
 	"math"
 	"math/rand"
 	"testing"
+	"time"
 )
 
+var testIds = kernel.IDGeneratorFunc(uuid.New)
+var testClock = kernel.ClockFunc(func() time.Time { return time.Now().UTC() })
+var testRnd = rand.New(rand.NewSource(time.Now().UnixNano()))
+
 func newValidLocation() kernel.Location {
 	loc, _ := kernel.NewLocation(1, 1)
 	return loc
@@ -55,7 +60,7 @@ func TestNewCourier(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			c, err := NewCourier(test.courierName, test.speed, test.location)
+			c, err := NewCourier(test.courierName, test.speed, test.location, testIds)
 			if test.expectError {
 				if err == nil {
 					t.Fail()
@@ -98,19 +103,19 @@ func TestNewCourier(t *testing.T) {
 }
 
 func TestCourier_AddStoragePlace(t *testing.T) {
-	c, _ := NewCourier("vzuh", 8, newValidLocation())
+	c, _ := NewCourier("vzuh", 8, newValidLocation(), testIds)
 
-	err := c.AddStoragePlace("", 500)
+	err := c.AddStoragePlace("", StoragePlaceStandard, 500, 0, testIds)
 	if err == nil {
 		t.Error("invalid storage name")
 	}
 
-	err = c.AddStoragePlace("trunk", 0)
+	err = c.AddStoragePlace("trunk", StoragePlaceStandard, 0, 0, testIds)
 	if err == nil {
 		t.Error("invalid storage volume")
 	}
 
-	err = c.AddStoragePlace("trunk", 500)
+	err = c.AddStoragePlace("trunk", StoragePlaceStandard, 500, 0, testIds)
 	if err != nil {
 		t.Error(err)
 	}
@@ -129,47 +134,172 @@ func TestCourier_AddStoragePlace(t *testing.T) {
 }
 
 func TestCourier_CanTakeOrder(t *testing.T) {
-	c, _ := NewCourier("Slow", 2, newValidLocation())
+	c, _ := NewCourier("Slow", 2, newValidLocation(), testIds)
 
-	o, _ := order.NewOrder(uuid.New(), newValidLocation(), 200)
+	o, _ := order.NewOrder(uuid.New(), newValidLocation(), 200, 0, order.PriorityStandard, testIds, testClock)
 	if ok, _ := c.CanTakeOrder(o); ok {
 		t.Error("can't take volume 200")
 	}
 
-	o, _ = order.NewOrder(uuid.New(), newValidLocation(), 10)
+	o, _ = order.NewOrder(uuid.New(), newValidLocation(), 10, 0, order.PriorityStandard, testIds, testClock)
 	if ok, _ := c.CanTakeOrder(o); !ok {
 		t.Error("can take volume 10")
 	}
 
-	_ = c.AddStoragePlace("trunk", 500)
-	o, _ = order.NewOrder(uuid.New(), newValidLocation(), 500)
+	_ = c.AddStoragePlace("trunk", StoragePlaceStandard, 500, 0, testIds)
+	o, _ = order.NewOrder(uuid.New(), newValidLocation(), 500, 0, order.PriorityStandard, testIds, testClock)
 	if ok, _ := c.CanTakeOrder(o); !ok {
 		t.Error("can take volume 500")
 	}
 
-	o, _ = order.NewOrder(uuid.New(), newValidLocation(), 501)
+	o, _ = order.NewOrder(uuid.New(), newValidLocation(), 501, 0, order.PriorityStandard, testIds, testClock)
 	if ok, _ := c.CanTakeOrder(o); ok {
 		t.Error("can't take volume 501")
 	}
 }
 
+func TestCourier_CanTakeOrder_Weight(t *testing.T) {
+	c, _ := NewCourier("Bike", 2, newValidLocation(), testIds)
+	_ = c.AddStoragePlace("basket", StoragePlaceStandard, 50, 4000, testIds)
+
+	o, _ := order.NewOrder(uuid.New(), newValidLocation(), 20, 5000, order.PriorityStandard, testIds, testClock)
+	if ok, _ := c.CanTakeOrder(o); ok {
+		t.Error("can't take weight 5000 in a volume 20 order")
+	}
+
+	o, _ = order.NewOrder(uuid.New(), newValidLocation(), 5, 5000, order.PriorityStandard, testIds, testClock)
+	if ok, _ := c.CanTakeOrder(o); !ok {
+		t.Error("bag has no weight limit")
+	}
+
+	o, _ = order.NewOrder(uuid.New(), newValidLocation(), 20, 4000, order.PriorityStandard, testIds, testClock)
+	if ok, _ := c.CanTakeOrder(o); !ok {
+		t.Error("can take weight 4000")
+	}
+}
+
+func TestCourier_CanTakeOrder_Handling(t *testing.T) {
+	c, _ := NewCourier("Food", 2, newValidLocation(), testIds)
+
+	o, _ := order.NewOrder(uuid.New(), newValidLocation(), 5, 0, order.PriorityStandard, testIds, testClock)
+	_ = o.RequireHandling(order.HandlingHot)
+	if ok, _ := c.CanTakeOrder(o); ok {
+		t.Error("can't take hot order without thermal bag")
+	}
+
+	_ = c.AddStoragePlace("thermal", StoragePlaceThermal, 20, 0, testIds)
+	if ok, _ := c.CanTakeOrder(o); !ok {
+		t.Error("can take hot order in thermal bag")
+	}
+
+	_ = o.RequireHandling(order.HandlingFrozen)
+	if ok, _ := c.CanTakeOrder(o); ok {
+		t.Error("can't take frozen order in thermal bag")
+	}
+}
+
+func TestCourier_IsSuitableFor(t *testing.T) {
+	c, _ := NewCourier("Busy", 2, newValidLocation(), testIds)
+
+	// единственное место занято, но курьер в принципе может взять такой заказ
+	taken, _ := order.NewOrder(uuid.New(), newValidLocation(), 5, 0, order.PriorityStandard, testIds, testClock)
+	_ = c.TakeOrder(taken)
+
+	o, _ := order.NewOrder(uuid.New(), newValidLocation(), 10, 0, order.PriorityStandard, testIds, testClock)
+	if ok, _ := c.CanTakeOrder(o); ok {
+		t.Error("busy courier can't take order")
+	}
+
+	if ok, err := c.IsSuitableFor(o); err != nil || !ok {
+		t.Error("busy courier is suitable for order", err)
+	}
+
+	o, _ = order.NewOrder(uuid.New(), newValidLocation(), 200, 0, order.PriorityStandard, testIds, testClock)
+	if ok, _ := c.IsSuitableFor(o); ok {
+		t.Error("volume 200 never fits")
+	}
+
+	o, _ = order.NewOrder(uuid.New(), newValidLocation(), 5, 0, order.PriorityStandard, testIds, testClock)
+	_ = o.RequireHandling(order.HandlingFrozen)
+	if ok, _ := c.IsSuitableFor(o); ok {
+		t.Error("frozen order needs freezer")
+	}
+
+	o, _ = order.NewOrder(uuid.New(), newValidLocation(), 5, 0, order.PriorityStandard, testIds, testClock)
+	_ = o.AddRestriction(order.RestrictionAlcohol)
+	if ok, _ := c.IsSuitableFor(o); ok {
+		t.Error("alcohol needs qualification")
+	}
+}
+
+func TestCourier_TakeOrder_PrefersMatchingPlace(t *testing.T) {
+	c, _ := NewCourier("Food", 2, newValidLocation(), testIds)
+	_ = c.AddStoragePlace("thermal", StoragePlaceThermal, 20, 0, testIds)
+	c.storagePlaces[0], c.storagePlaces[1] = c.storagePlaces[1], c.storagePlaces[0]
+
+	standard, _ := order.NewOrder(uuid.New(), newValidLocation(), 5, 0, order.PriorityStandard, testIds, testClock)
+	if err := c.TakeOrder(standard); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, place := range c.StoragePlaces() {
+		if place.Type() == StoragePlaceThermal && place.IsOccupied() {
+			t.Fatal("standard order must not occupy thermal bag while the standard bag is free")
+		}
+	}
+}
+
+func TestCourier_Qualifications(t *testing.T) {
+	c, _ := NewCourier("Careful", 2, newValidLocation(), testIds)
+
+	o, _ := order.NewOrder(uuid.New(), newValidLocation(), 5, 0, order.PriorityStandard, testIds, testClock)
+	_ = o.AddRestriction(order.RestrictionMedicine)
+
+	if q, _ := c.MissingQualification(o); q != QualificationMedicine {
+		t.Error("medicine qualification is missing")
+	}
+
+	if ok, _ := c.CanTakeOrder(o); ok {
+		t.Error("can't take restricted order without qualification")
+	}
+
+	if err := c.GrantQualification("unknown"); err == nil {
+		t.Error("unknown qualification must be rejected")
+	}
+
+	_ = c.GrantQualification(QualificationMedicine)
+	_ = c.GrantQualification(QualificationMedicine)
+	if len(c.Qualifications()) != 1 {
+		t.Error("qualification must be granted once")
+	}
+
+	if ok, _ := c.CanTakeOrder(o); !ok {
+		t.Error("can take restricted order with qualification")
+	}
+
+	_ = c.RevokeQualification(QualificationMedicine)
+	if c.HasQualification(QualificationMedicine) {
+		t.Error("qualification must be revoked")
+	}
+}
+
 func TestCourier_TakeOrder(t *testing.T) {
-	c, _ := NewCourier("Slow", 2, newValidLocation())
+	c, _ := NewCourier("Slow", 2, newValidLocation(), testIds)
 
-	o, _ := order.NewOrder(uuid.New(), newValidLocation(), 100)
+	o, _ := order.NewOrder(uuid.New(), newValidLocation(), 100, 0, order.PriorityStandard, testIds, testClock)
 	if err := c.TakeOrder(o); err == nil {
 		t.Error("can't take volume 100")
 	}
 
-	o, _ = order.NewOrder(uuid.New(), newValidLocation(), 5)
+	o, _ = order.NewOrder(uuid.New(), newValidLocation(), 5, 0, order.PriorityStandard, testIds, testClock)
 	if err := c.TakeOrder(o); err != nil {
 		t.Error("can take volume 5")
 	}
 }
 
 func TestCourier_CompleteOrder(t *testing.T) {
-	c, _ := NewCourier("Slow", 2, newValidLocation())
-	o, _ := order.NewOrder(uuid.New(), newValidLocation(), 8)
+	c, _ := NewCourier("Slow", 2, newValidLocation(), testIds)
+	o, _ := order.NewOrder(uuid.New(), newValidLocation(), 8, 0, order.PriorityStandard, testIds, testClock)
 
 	if err := c.CompleteOrder(o); err == nil {
 		t.Error("must not complete non-owned order")
@@ -239,9 +369,9 @@ func TestCourier_CalculateTimeToLocation(t *testing.T) {
 		t.Run(test.courierName, func(t *testing.T) {
 			loc, _ := kernel.NewLocation(test.courierLocationX, test.courierLocationY)
 			target, _ := kernel.NewLocation(test.targetLocationX, test.targetLocationY)
-			c, _ := NewCourier(test.courierName, test.courierSpeed, loc)
+			c, _ := NewCourier(test.courierName, test.courierSpeed, loc, testIds)
 
-			time, err := c.CalculateTimeToLocation(target)
+			time, err := c.CalculateTimeToLocation(target, kernel.NewGridDistanceCalculator())
 			if err != nil {
 				t.Error(err)
 			}
@@ -254,22 +384,72 @@ func TestCourier_CalculateTimeToLocation(t *testing.T) {
 
 }
 
+func TestCourier_CalculateDeliveryTime(t *testing.T) {
+	loc, _ := kernel.NewLocation(1, 1)
+	pickup, _ := kernel.NewLocation(4, 1)
+	dropoff, _ := kernel.NewLocation(4, 5)
+	c, _ := NewCourier("bike", 2, loc, testIds)
+	calc := kernel.NewGridDistanceCalculator()
+
+	o, _ := order.NewOrder(uuid.New(), dropoff, 5, 0, order.PriorityStandard, testIds, testClock)
+	time, err := c.CalculateDeliveryTime(o, calc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if time != 3.5 {
+		t.Errorf("time: %f, expected: %f", time, 3.5)
+	}
+
+	// курьер -> склад (3 клетки) плюс склад -> получатель (4 клетки)
+	o, _ = order.NewPickupOrder(uuid.New(), pickup, dropoff, 5, 0, order.PriorityStandard, testIds, testClock)
+	time, err = c.CalculateDeliveryTime(o, calc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if time != 3.5 {
+		t.Errorf("time: %f, expected: %f", time, 3.5)
+	}
+
+	far, _ := kernel.NewLocation(10, 10)
+	o, _ = order.NewPickupOrder(uuid.New(), far, dropoff, 5, 0, order.PriorityStandard, testIds, testClock)
+	time, err = c.CalculateDeliveryTime(o, calc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 18 клеток до склада и 11 обратно к получателю
+	if time != 14.5 {
+		t.Errorf("time: %f, expected: %f", time, 14.5)
+	}
+}
+
 func TestCourier_Move(t *testing.T) {
 
+	area, _ := kernel.NewServiceArea(10, 10)
+	calc := kernel.NewGridDistanceCalculator()
+
 outerLoop:
 	for range 10000 {
 
-		loc := kernel.NewRandomLocation()
+		loc := area.RandomLocation(testRnd)
 		speed := rand.Intn(9) + 1
-		c, _ := NewCourier("test courier", speed, loc)
+		c, _ := NewCourier("test courier", speed, loc, testIds)
 
-		target := kernel.NewRandomLocation()
+		target := area.RandomLocation(testRnd)
 
-		time, _ := c.CalculateTimeToLocation(target)
-		steps := int(math.Ceil(time))
+		eta, _ := c.CalculateTimeToLocation(target, calc)
+		steps := int(math.Ceil(eta))
+
+		now := time.Now()
+		if steps > 0 {
+			_ = c.Move(target, calc, now, testIds)
+		}
 
 		for range steps {
-			err := c.Move(target)
+			now = now.Add(time.Second)
+			err := c.Move(target, calc, now, testIds)
 			if err != nil {
 				t.Error(err)
 				break outerLoop
@@ -282,3 +462,291 @@ outerLoop:
 		}
 	}
 }
+
+func TestCourier_MoveGeo(t *testing.T) {
+
+	area, _ := kernel.NewServiceArea(10, 10)
+	origin, _ := kernel.NewGeoLocation(55.75, 37.61)
+	grid, _ := kernel.NewGeoGrid(origin, 0.5)
+	calc, _ := kernel.NewGeoDistanceCalculator(grid)
+
+	for range 1000 {
+
+		loc := area.RandomLocation(testRnd)
+		speed := rand.Intn(20) + 5
+		c, _ := NewCourier("test courier", speed, loc, testIds)
+
+		target := area.RandomLocation(testRnd)
+
+		// курьер приходит к цели за рассчитанное время
+		eta, _ := c.CalculateTimeToLocation(target, calc)
+		steps := int(math.Ceil(eta / 10))
+		now := time.Now()
+		_ = c.Move(target, calc, now, testIds)
+
+		for range steps {
+			if c.Location().Equals(target) {
+				break
+			}
+
+			now = now.Add(10 * time.Second)
+			err := c.Move(target, calc, now, testIds)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		if !c.Location().Equals(target) {
+			t.Fatalf("location: (%d, %d) target: (%d, %d)", c.Location().X(), c.Location().Y(), target.X(), target.Y())
+		}
+	}
+}
+
+func TestCourier_CalculateTimeToLocationGeo(t *testing.T) {
+	origin, _ := kernel.NewGeoLocation(55.75, 37.61)
+	grid, _ := kernel.NewGeoGrid(origin, 1)
+	calc, _ := kernel.NewGeoDistanceCalculator(grid)
+
+	loc, _ := kernel.NewLocation(1, 1)
+	target, _ := kernel.NewLocation(4, 1)
+	c, _ := NewCourier("test courier", 15, loc, testIds)
+
+	// 3 км со скоростью 15 км/ч - 12 минут
+	eta, err := c.CalculateTimeToLocation(target, calc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if math.Abs(eta-720) > 1 {
+		t.Fatalf("eta: %f, expected: ~720", eta)
+	}
+
+	// за 4 минуты курьер проходит 1 км, и его точные координаты сдвигаются
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	_ = c.Move(target, calc, start, testIds)
+	_ = c.Move(target, calc, start.Add(4*time.Minute), testIds)
+
+	if c.Location().X() != 2 || c.Location().Geo().IsEmpty() {
+		t.Fatalf("location: %d, expected: 2", c.Location().X())
+	}
+
+	eta, _ = c.CalculateTimeToLocation(target, calc)
+	if math.Abs(eta-480) > 1 {
+		t.Fatalf("eta: %f, expected: ~480", eta)
+	}
+}
+
+func TestCourier_MoveByElapsedTime(t *testing.T) {
+	calc := kernel.NewGridDistanceCalculator()
+	loc, _ := kernel.NewLocation(1, 1)
+	target, _ := kernel.NewLocation(10, 1)
+	c, _ := NewCourier("test courier", 2, loc, testIds)
+	o, _ := order.NewOrder(uuid.New(), target, 5, 0, order.PriorityStandard, testIds, testClock)
+	_ = c.TakeOrder(o)
+
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	// первый вызов только запоминает время старта
+	_ = c.Move(target, calc, start, testIds)
+	if !c.Location().Equals(loc) || c.LastMovedAt() == nil || !c.LastMovedAt().Equal(start) {
+		t.Fatal("courier must not move on start")
+	}
+
+	// за 2.3 секунды со скоростью 2 курьер проходит 4 целые клетки
+	_ = c.Move(target, calc, start.Add(2300*time.Millisecond), testIds)
+	if c.Location().X() != 5 {
+		t.Fatalf("location: %d, expected: 5", c.Location().X())
+	}
+
+	// неизрасходованные 0.3 секунды дают еще одну клетку через 0.3 секунды
+	_ = c.Move(target, calc, start.Add(2600*time.Millisecond), testIds)
+	if c.Location().X() != 6 {
+		t.Fatalf("location: %d, expected: 6", c.Location().X())
+	}
+
+	// долгая пауза не уводит курьера дальше цели
+	now := start.Add(time.Hour)
+	_ = c.Move(target, calc, now, testIds)
+	if !c.Location().Equals(target) || !c.LastMovedAt().Equal(now) {
+		t.Fatal("courier must stop on the target")
+	}
+}
+
+func TestCourier_CompleteOrderResetsLastMovedAt(t *testing.T) {
+	loc, _ := kernel.NewLocation(1, 1)
+	c, _ := NewCourier("test courier", 1, loc, testIds)
+	o, _ := order.NewOrder(uuid.New(), loc, 5, 0, order.PriorityStandard, testIds, testClock)
+	_ = c.TakeOrder(o)
+
+	target, _ := kernel.NewLocation(2, 1)
+	_ = c.Move(target, kernel.NewGridDistanceCalculator(), time.Now(), testIds)
+
+	if err := c.CompleteOrder(o); err != nil {
+		t.Fatal(err)
+	}
+
+	if c.LastMovedAt() != nil {
+		t.Error("free courier must not accumulate movement time")
+	}
+}
+
+func TestCourier_SetHomeDepot(t *testing.T) {
+	loc, _ := kernel.NewLocation(1, 1)
+	depot, _ := kernel.NewLocation(5, 5)
+	c, _ := NewCourier("test courier", 1, loc, testIds)
+
+	if !c.HomeDepot().IsEmpty() {
+		t.Fatal("new courier must not have a depot")
+	}
+
+	if err := c.SetHomeDepot(kernel.Location{}); err == nil {
+		t.Fatal("expected error for empty depot")
+	}
+
+	if err := c.SetHomeDepot(depot); err != nil || !c.HomeDepot().Equals(depot) {
+		t.Fatal("depot must be set")
+	}
+}
+
+func TestCourier_MoveStopsFreeCourierOnTarget(t *testing.T) {
+	calc := kernel.NewGridDistanceCalculator()
+	loc, _ := kernel.NewLocation(1, 1)
+	depot, _ := kernel.NewLocation(3, 1)
+	c, _ := NewCourier("test courier", 1, loc, testIds)
+
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	_ = c.Move(depot, calc, start, testIds)
+	_ = c.Move(depot, calc, start.Add(time.Minute), testIds)
+
+	// свободный курьер на складе больше не идет, и время следующего перемещения отсчитывается заново
+	if !c.Location().Equals(depot) || c.LastMovedAt() != nil {
+		t.Fatal("free courier must stop on the target")
+	}
+}
+
+func TestCourier_ReturnLocation(t *testing.T) {
+	loc, _ := kernel.NewLocation(1, 1)
+	store, _ := kernel.NewLocation(3, 3)
+	depot, _ := kernel.NewLocation(9, 9)
+	dropoff, _ := kernel.NewLocation(5, 5)
+	c, _ := NewCourier("bike", 1, loc, testIds)
+
+	o, _ := order.NewOrder(uuid.New(), dropoff, 5, 0, order.PriorityStandard, testIds, testClock)
+	if !c.ReturnLocation(o).Equals(loc) {
+		t.Error("w/o depot and store parcel must be returned on the spot")
+	}
+
+	o, _ = order.NewPickupOrder(uuid.New(), store, dropoff, 5, 0, order.PriorityStandard, testIds, testClock)
+	if !c.ReturnLocation(o).Equals(store) {
+		t.Error("w/o depot parcel must be returned to store")
+	}
+
+	_ = c.SetHomeDepot(depot)
+	if !c.ReturnLocation(o).Equals(depot) {
+		t.Error("parcel must be returned to courier depot")
+	}
+}
+
+func TestCourier_Wait(t *testing.T) {
+	loc, _ := kernel.NewLocation(1, 1)
+	target, _ := kernel.NewLocation(5, 1)
+	c, _ := NewCourier("bike", 1, loc, testIds)
+	o, _ := order.NewOrder(uuid.New(), target, 5, 0, order.PriorityStandard, testIds, testClock)
+	_ = c.TakeOrder(o)
+
+	start := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	calc := kernel.NewGridDistanceCalculator()
+	_ = c.Move(target, calc, start, testIds)
+
+	// курьер простоял 10 секунд - за это время он никуда не уехал
+	c.Wait(start.Add(10 * time.Second))
+	_ = c.Move(target, calc, start.Add(11*time.Second), testIds)
+
+	expected, _ := kernel.NewLocation(2, 1)
+	if !c.Location().Equals(expected) {
+		t.Errorf("location: %v, expected: %v", c.Location(), expected)
+	}
+}
+
+func TestCourier_TakeReassignedOrder(t *testing.T) {
+	from, _ := NewCourier("From", 2, newValidLocation(), testIds)
+	to, _ := NewCourier("To", 2, newValidLocation(), testIds)
+
+	o, _ := order.NewOrder(uuid.New(), newValidLocation(), 5, 0, order.PriorityStandard, testIds, testClock)
+	_ = from.TakeOrder(o)
+	_ = o.AssignCourier(from.Id(), testIds, testClock)
+
+	if err := to.TakeOrder(o); err == nil {
+		t.Fatal("order assigned to another courier must not be taken")
+	}
+
+	if err := from.TakeOrder(o); err == nil {
+		t.Fatal("order must not be taken twice")
+	}
+
+	_ = o.Reassign(to.Id(), "operator", testIds, testClock)
+	if err := from.ReleaseOrder(o); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := to.TakeOrder(o); err != nil {
+		t.Fatal(err)
+	}
+
+	if from.StoragePlaces()[0].IsOccupied() || !to.StoragePlaces()[0].IsOccupied() {
+		t.Error("order must move to the new courier")
+	}
+}
+
+func TestCourier_ReportLocation(t *testing.T) {
+	c, _ := NewCourier("Test", 2, newValidLocation(), testIds)
+	target, _ := kernel.NewLocation(7, 3)
+	now := time.Now()
+
+	if err := c.ReportLocation(target, now, testIds); err != nil {
+		t.Fatal(err)
+	}
+
+	if !c.Location().Equals(target) || !c.LocationReportedAt().Equal(now) {
+		t.Fatalf("location = %v at %v, want %v at %v", c.Location(), c.LocationReportedAt(), target, now)
+	}
+
+	// Опоздавшая отметка не возвращает курьера назад
+	if err := c.ReportLocation(newValidLocation(), now.Add(-time.Second), testIds); err != ErrStaleLocation {
+		t.Fatalf("err = %v, want ErrStaleLocation", err)
+	}
+
+	if err := c.ReportLocation(target, now, testIds); err != ErrStaleLocation {
+		t.Fatalf("err = %v, want ErrStaleLocation", err)
+	}
+
+	if !c.Location().Equals(target) {
+		t.Error("stale report must not move courier")
+	}
+
+	if err := c.ReportLocation(kernel.Location{}, now.Add(time.Second), testIds); err == nil {
+		t.Error("empty location must be rejected")
+	}
+}
+
+func TestCourier_MoveRaisesMovedEvent(t *testing.T) {
+	c, _ := NewCourier("Test", 2, newValidLocation(), testIds)
+	target, _ := kernel.NewLocation(5, 1)
+	calc := kernel.NewGridDistanceCalculator()
+	start := time.Now()
+
+	_ = c.Move(target, calc, start, testIds)
+	if len(c.GetDomainEvents()) != 0 {
+		t.Fatal("courier must not raise event before moving")
+	}
+
+	_ = c.Move(target, calc, start.Add(time.Second), testIds)
+	if len(c.GetDomainEvents()) != 1 {
+		t.Fatal("expected moved event")
+	}
+
+	e, ok := c.GetDomainEvents()[0].(*MovedDomainEvent)
+	if !ok || e.CourierId != c.Id() || e.LocationX != 3 || e.LocationY != 1 {
+		t.Errorf("wrong moved event: %+v", e)
+	}
+}
@@ -1,6 +1,7 @@
 package courier
 
 import (
+	"delivery/internal/core/domain/kernel"
 	"errors"
 	"github.com/google/uuid"
 	"strings"
@@ -10,23 +11,41 @@ import (
 type StoragePlace struct {
 	id          uuid.UUID
 	name        string
+	placeType   StoragePlaceType
 	totalVolume int
-	orderID     *uuid.UUID
+	// maxWeight - допустимый вес в граммах. 0 - вес не ограничен
+	maxWeight int
+	orderID   *uuid.UUID
 }
 
-func NewStoragePlace(name string, totalVolume int) (*StoragePlace, error) {
+func NewStoragePlace(name string, placeType StoragePlaceType, totalVolume int, maxWeight int,
+	ids kernel.IDGenerator) (*StoragePlace, error) {
 	if strings.TrimSpace(name) == "" {
 		return nil, errors.New("name")
 	}
 
+	if !placeType.IsValid() {
+		return nil, errors.New("placeType")
+	}
+
 	if totalVolume <= 0 {
 		return nil, errors.New("totalVolume")
 	}
 
+	if maxWeight < 0 {
+		return nil, errors.New("maxWeight")
+	}
+
+	if ids == nil {
+		return nil, errors.New("ids")
+	}
+
 	return &StoragePlace{
-		id:          uuid.New(),
+		id:          ids.NewId(),
 		name:        name,
+		placeType:   placeType,
 		totalVolume: totalVolume,
+		maxWeight:   maxWeight,
 		orderID:     nil,
 	}, nil
 }
@@ -39,10 +58,18 @@ func (s *StoragePlace) Name() string {
 	return s.name
 }
 
+func (s *StoragePlace) Type() StoragePlaceType {
+	return s.placeType
+}
+
 func (s *StoragePlace) TotalVolume() int {
 	return s.totalVolume
 }
 
+func (s *StoragePlace) MaxWeight() int {
+	return s.maxWeight
+}
+
 func (s *StoragePlace) OrderID() *uuid.UUID {
 	return s.orderID
 }
@@ -51,11 +78,11 @@ func (s *StoragePlace) Equals(other *StoragePlace) bool {
 	return other != nil && s.id == other.id
 }
 
-func (s *StoragePlace) CanStore(volume int) bool {
-	return volume <= s.totalVolume
+func (s *StoragePlace) CanStore(volume int, weight int) bool {
+	return volume <= s.totalVolume && (s.maxWeight == 0 || weight <= s.maxWeight)
 }
 
-func (s *StoragePlace) Store(orderId uuid.UUID, volume int) error {
+func (s *StoragePlace) Store(orderId uuid.UUID, volume int, weight int) error {
 
 	if s.orderID != nil {
 		return errors.New("storage place already occupied")
@@ -65,6 +92,10 @@ func (s *StoragePlace) Store(orderId uuid.UUID, volume int) error {
 		return errors.New("volume must not exceed totalVolume")
 	}
 
+	if s.maxWeight != 0 && weight > s.maxWeight {
+		return errors.New("weight must not exceed maxWeight")
+	}
+
 	s.orderID = &orderId
 
 	return nil
@@ -79,11 +110,14 @@ func (s *StoragePlace) IsOccupied() bool {
 }
 
 // RestoreStoragePlace should be used ONLY inside Repository
-func RestoreStoragePlace(id uuid.UUID, name string, totalVolume int, orderID *uuid.UUID) *StoragePlace {
+func RestoreStoragePlace(id uuid.UUID, name string, placeType StoragePlaceType, totalVolume int, maxWeight int,
+	orderID *uuid.UUID) *StoragePlace {
 	return &StoragePlace{
 		id:          id,
 		name:        name,
+		placeType:   placeType,
 		totalVolume: totalVolume,
+		maxWeight:   maxWeight,
 		orderID:     orderID,
 	}
 }
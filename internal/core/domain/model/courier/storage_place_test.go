@@ -1,6 +1,7 @@
 package courier
 
 import (
+	"delivery/internal/core/domain/model/order"
 	"github.com/google/uuid"
 	"testing"
 )
@@ -11,6 +12,7 @@ func TestNewStoragePLace(t *testing.T) {
 		testTitle     string
 		name          string
 		totalVolume   int
+		maxWeight     int
 		errIsExpected bool
 	}{
 		{
@@ -25,17 +27,31 @@ func TestNewStoragePLace(t *testing.T) {
 			totalVolume:   0,
 			errIsExpected: true,
 		},
+		{
+			testTitle:     "Wrong max weight",
+			name:          "suitcase",
+			totalVolume:   100,
+			maxWeight:     -1,
+			errIsExpected: true,
+		},
 		{
 			testTitle:     "Correct",
 			name:          "suitcase",
 			totalVolume:   100,
 			errIsExpected: false,
 		},
+		{
+			testTitle:     "Correct with max weight",
+			name:          "suitcase",
+			totalVolume:   100,
+			maxWeight:     5000,
+			errIsExpected: false,
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.testTitle, func(t *testing.T) {
-			_, err := NewStoragePlace(test.name, test.totalVolume)
+			_, err := NewStoragePlace(test.name, StoragePlaceStandard, test.totalVolume, test.maxWeight, testIds)
 			if test.errIsExpected {
 				if err == nil {
 					t.Fail()
@@ -49,12 +65,46 @@ func TestNewStoragePLace(t *testing.T) {
 	}
 }
 
+func TestNewStoragePlace_InvalidType(t *testing.T) {
+	_, err := NewStoragePlace("suitcase", "unknown", 100, 0, testIds)
+	if err == nil {
+		t.Fail()
+	}
+}
+
+func TestStoragePlaceType_Serves(t *testing.T) {
+	tests := []struct {
+		placeType StoragePlaceType
+		handling  order.Handling
+		expected  bool
+	}{
+		{StoragePlaceStandard, order.HandlingStandard, true},
+		{StoragePlaceThermal, order.HandlingStandard, true},
+		{StoragePlaceStandard, order.HandlingHot, false},
+		{StoragePlaceThermal, order.HandlingHot, true},
+		{StoragePlaceThermal, order.HandlingFrozen, false},
+		{StoragePlaceRefrigerated, order.HandlingFrozen, true},
+		{StoragePlaceRefrigerated, order.HandlingFragile, false},
+		{StoragePlacePadded, order.HandlingFragile, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.placeType.String()+"/"+test.handling.String(), func(t *testing.T) {
+			if got := test.placeType.Serves(test.handling); got != test.expected {
+				t.Fail()
+			}
+		})
+	}
+}
+
 func TestStoragePlace_CanStore(t *testing.T) {
 	tests := []struct {
 		testTitle   string
 		name        string
 		totalVolume int
+		maxWeight   int
 		volume      int
+		weight      int
 		expected    bool
 	}{
 		{
@@ -78,11 +128,37 @@ func TestStoragePlace_CanStore(t *testing.T) {
 			volume:      500,
 			expected:    true,
 		},
+		{
+			testTitle:   "Too heavy",
+			name:        "Bag",
+			totalVolume: 1000,
+			maxWeight:   3000,
+			volume:      500,
+			weight:      3500,
+			expected:    false,
+		},
+		{
+			testTitle:   "Weight limit fit",
+			name:        "Bag",
+			totalVolume: 1000,
+			maxWeight:   3000,
+			volume:      500,
+			weight:      3000,
+			expected:    true,
+		},
+		{
+			testTitle:   "Unlimited weight",
+			name:        "Bag",
+			totalVolume: 1000,
+			volume:      500,
+			weight:      100000,
+			expected:    true,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.testTitle, func(t *testing.T) {
-			s, _ := NewStoragePlace(test.name, test.totalVolume)
-			if got := s.CanStore(test.volume); got != test.expected {
+			s, _ := NewStoragePlace(test.name, StoragePlaceStandard, test.totalVolume, test.maxWeight, testIds)
+			if got := s.CanStore(test.volume, test.weight); got != test.expected {
 				t.Fail()
 			}
 		})
@@ -90,15 +166,20 @@ func TestStoragePlace_CanStore(t *testing.T) {
 }
 
 func TestStoragePlace_Store(t *testing.T) {
-	sp, _ := NewStoragePlace("Bag", 100)
+	sp, _ := NewStoragePlace("Bag", StoragePlaceStandard, 100, 2000, testIds)
 
 	orderId := uuid.New()
-	err := sp.Store(orderId, 200)
+	err := sp.Store(orderId, 200, 0)
+	if err == nil {
+		t.Fail()
+	}
+
+	err = sp.Store(orderId, 100, 2500)
 	if err == nil {
 		t.Fail()
 	}
 
-	err = sp.Store(orderId, 100)
+	err = sp.Store(orderId, 100, 2000)
 	if err != nil {
 		t.Fail()
 	}
@@ -109,9 +190,9 @@ func TestStoragePlace_Store(t *testing.T) {
 }
 
 func TestStoragePlace_Clear(t *testing.T) {
-	sp, _ := NewStoragePlace("Bag", 100)
+	sp, _ := NewStoragePlace("Bag", StoragePlaceStandard, 100, 0, testIds)
 
-	_ = sp.Store(uuid.New(), 80)
+	_ = sp.Store(uuid.New(), 80, 0)
 
 	sp.Clear()
 	if sp.orderID != nil {
@@ -0,0 +1,61 @@
+package courier
+
+import (
+	"delivery/internal/core/domain/model/order"
+	"errors"
+)
+
+// StoragePlaceType - тип места хранения, определяет, какие заказы в нем можно везти
+type StoragePlaceType string
+
+const (
+	StoragePlaceStandard     StoragePlaceType = "standard"
+	StoragePlaceThermal      StoragePlaceType = "thermal"
+	StoragePlaceRefrigerated StoragePlaceType = "refrigerated"
+	StoragePlacePadded       StoragePlaceType = "padded"
+)
+
+func (t StoragePlaceType) String() string {
+	return string(t)
+}
+
+func (t StoragePlaceType) IsValid() bool {
+	switch t {
+	case StoragePlaceStandard, StoragePlaceThermal, StoragePlaceRefrigerated, StoragePlacePadded:
+		return true
+	default:
+		return false
+	}
+}
+
+// Serves возвращает true, если в месте этого типа можно везти заказ с требованием handling.
+// Заказы без особых требований помещаются в любое место
+func (t StoragePlaceType) Serves(handling order.Handling) bool {
+	switch handling {
+	case order.HandlingStandard:
+		return t.IsValid()
+	case order.HandlingHot:
+		return t == StoragePlaceThermal
+	case order.HandlingFrozen:
+		return t == StoragePlaceRefrigerated
+	case order.HandlingFragile:
+		return t == StoragePlacePadded
+	default:
+		return false
+	}
+}
+
+// matches возвращает true, если тип места предназначен именно для handling.
+// Такие места занимаются в первую очередь, чтобы особые места оставались свободными
+func (t StoragePlaceType) matches(handling order.Handling) bool {
+	return t.Serves(handling) && (handling != order.HandlingStandard || t == StoragePlaceStandard)
+}
+
+func StoragePlaceTypeFromString(s string) (StoragePlaceType, error) {
+	placeType := StoragePlaceType(s)
+	if placeType.IsValid() {
+		return placeType, nil
+	}
+
+	return placeType, errors.New("invalid storage place type")
+}
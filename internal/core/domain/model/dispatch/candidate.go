@@ -0,0 +1,88 @@
+package dispatch
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"strings"
+)
+
+// Candidate - курьер, которого диспетчер рассматривал при назначении заказа
+type Candidate struct {
+	courierId       uuid.UUID
+	courierName     string
+	eta             float64
+	rejectionReason string
+	// missingQualification - допуск, которого не хватило курьеру. Пустой, если курьер отклонен по другой причине
+	missingQualification string
+
+	isSet bool
+}
+
+func NewCandidate(courierId uuid.UUID, courierName string, eta float64, rejectionReason string,
+	missingQualification string) (Candidate, error) {
+	if courierId == uuid.Nil {
+		return Candidate{}, errors.New("empty courierId")
+	}
+
+	if strings.TrimSpace(courierName) == "" {
+		return Candidate{}, errors.New("empty courierName")
+	}
+
+	if eta < 0 {
+		return Candidate{}, errors.New("eta < 0")
+	}
+
+	if missingQualification != "" && rejectionReason == "" {
+		return Candidate{}, errors.New("candidate with missing qualification must be rejected")
+	}
+
+	return Candidate{
+		courierId:            courierId,
+		courierName:          courierName,
+		eta:                  eta,
+		rejectionReason:      rejectionReason,
+		missingQualification: missingQualification,
+		isSet:                true,
+	}, nil
+}
+
+func (c Candidate) CourierId() uuid.UUID {
+	return c.courierId
+}
+
+func (c Candidate) CourierName() string {
+	return c.courierName
+}
+
+func (c Candidate) Eta() float64 {
+	return c.eta
+}
+
+func (c Candidate) RejectionReason() string {
+	return c.rejectionReason
+}
+
+func (c Candidate) MissingQualification() string {
+	return c.missingQualification
+}
+
+func (c Candidate) IsEligible() bool {
+	return c.rejectionReason == ""
+}
+
+func (c Candidate) IsEmpty() bool {
+	return !c.isSet
+}
+
+// RestoreCandidate should be used ONLY inside Repository
+func RestoreCandidate(courierId uuid.UUID, courierName string, eta float64, rejectionReason string,
+	missingQualification string) Candidate {
+	return Candidate{
+		courierId:            courierId,
+		courierName:          courierName,
+		eta:                  eta,
+		rejectionReason:      rejectionReason,
+		missingQualification: missingQualification,
+		isSet:                true,
+	}
+}
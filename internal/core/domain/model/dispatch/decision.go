@@ -0,0 +1,143 @@
+package dispatch
+
+import (
+	"cmp"
+	"delivery/internal/core/domain/kernel"
+	"errors"
+	"github.com/google/uuid"
+	"slices"
+	"time"
+)
+
+// Decision - запись о назначении заказа: какие курьеры рассматривались,
+// с каким временем доставки и почему были отклонены
+type Decision struct {
+	id         uuid.UUID
+	orderId    uuid.UUID
+	courierId  *uuid.UUID
+	candidates []Candidate
+	decidedAt  time.Time
+}
+
+func NewDecision(orderId uuid.UUID, ids kernel.IDGenerator, clock kernel.Clock) (*Decision, error) {
+	if orderId == uuid.Nil {
+		return nil, errors.New("empty orderId")
+	}
+
+	if ids == nil {
+		return nil, errors.New("empty id generator")
+	}
+
+	if clock == nil {
+		return nil, errors.New("empty clock")
+	}
+
+	return &Decision{
+		id:         ids.NewId(),
+		orderId:    orderId,
+		candidates: []Candidate{},
+		decidedAt:  clock.Now().UTC(),
+	}, nil
+}
+
+func (d *Decision) Id() uuid.UUID {
+	return d.id
+}
+
+func (d *Decision) OrderId() uuid.UUID {
+	return d.orderId
+}
+
+// CourierId - выбранный курьер (nil, если подходящего курьера не нашлось)
+func (d *Decision) CourierId() *uuid.UUID {
+	return d.courierId
+}
+
+func (d *Decision) Candidates() []Candidate {
+	return d.candidates
+}
+
+func (d *Decision) DecidedAt() time.Time {
+	return d.decidedAt
+}
+
+func (d *Decision) Equals(other *Decision) bool {
+	return other != nil && d.id == other.id
+}
+
+func (d *Decision) AddCandidate(candidate Candidate) error {
+	if candidate.IsEmpty() {
+		return errors.New("empty candidate")
+	}
+
+	for _, c := range d.candidates {
+		if c.CourierId() == candidate.CourierId() {
+			return errors.New("candidate already added")
+		}
+	}
+
+	d.candidates = append(d.candidates, candidate)
+	return nil
+}
+
+// RankedCandidates возвращает подходящих курьеров, отсортированных по времени доставки
+func (d *Decision) RankedCandidates() []Candidate {
+	ranked := make([]Candidate, 0, len(d.candidates))
+	for _, c := range d.candidates {
+		if c.IsEligible() {
+			ranked = append(ranked, c)
+		}
+	}
+
+	slices.SortStableFunc(ranked, func(a, b Candidate) int {
+		return cmp.Compare(a.Eta(), b.Eta())
+	})
+
+	return ranked
+}
+
+func (d *Decision) ChooseCourier(courierId uuid.UUID) error {
+	if d.courierId != nil {
+		return errors.New("courier already chosen")
+	}
+
+	for _, c := range d.candidates {
+		if c.CourierId() != courierId {
+			continue
+		}
+
+		if !c.IsEligible() {
+			return errors.New("courier was rejected")
+		}
+
+		d.courierId = &courierId
+		return nil
+	}
+
+	return errors.New("courier is not a candidate")
+}
+
+// Repeats сообщает, что решение ничего не добавляет к предыдущему решению по заказу: курьер
+// не выбран ни тогда, ни сейчас, и рассматривались те же курьеры с теми же причинами отказа.
+// Время доставки не сравнивается - оно меняется с каждым перемещением курьеров
+func (d *Decision) Repeats(prev *Decision) bool {
+	if prev == nil || prev.orderId != d.orderId || prev.courierId != nil || d.courierId != nil {
+		return false
+	}
+
+	return slices.EqualFunc(d.candidates, prev.candidates, func(a, b Candidate) bool {
+		return a.CourierId() == b.CourierId() && a.RejectionReason() == b.RejectionReason() &&
+			a.MissingQualification() == b.MissingQualification()
+	})
+}
+
+// RestoreDecision should be used ONLY inside Repository
+func RestoreDecision(id uuid.UUID, orderId uuid.UUID, courierId *uuid.UUID, candidates []Candidate, decidedAt time.Time) *Decision {
+	return &Decision{
+		id:         id,
+		orderId:    orderId,
+		courierId:  courierId,
+		candidates: candidates,
+		decidedAt:  decidedAt,
+	}
+}
@@ -0,0 +1,218 @@
+package dispatch
+
+import (
+	"delivery/internal/core/domain/kernel"
+	"github.com/google/uuid"
+	"testing"
+	"time"
+)
+
+var testIds = kernel.IDGeneratorFunc(uuid.New)
+var testClock = kernel.ClockFunc(func() time.Time { return time.Now().UTC() })
+
+func TestNewCandidate(t *testing.T) {
+
+	tests := []struct {
+		testName    string
+		courierId   uuid.UUID
+		courierName string
+		eta         float64
+		expectError bool
+	}{
+		{
+			testName:    "invalid courier id",
+			courierId:   uuid.Nil,
+			courierName: "speedy",
+			eta:         1,
+			expectError: true,
+		},
+		{
+			testName:    "invalid courier name",
+			courierId:   uuid.New(),
+			courierName: " ",
+			eta:         1,
+			expectError: true,
+		},
+		{
+			testName:    "invalid eta",
+			courierId:   uuid.New(),
+			courierName: "speedy",
+			eta:         -1,
+			expectError: true,
+		},
+		{
+			testName:    "valid candidate",
+			courierId:   uuid.New(),
+			courierName: "speedy",
+			eta:         1.5,
+			expectError: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			c, err := NewCandidate(test.courierId, test.courierName, test.eta, "", "")
+			if test.expectError {
+				if err == nil {
+					t.Fail()
+				}
+			} else {
+				if err != nil {
+					t.Error(err)
+				}
+
+				if c.IsEmpty() || !c.IsEligible() {
+					t.Error("candidate must be eligible")
+				}
+			}
+		})
+	}
+}
+
+func TestNewCandidate_MissingQualification(t *testing.T) {
+	_, err := NewCandidate(uuid.New(), "speedy", 1, "", "alcohol")
+	if err == nil {
+		t.Error("candidate with missing qualification must be rejected")
+	}
+
+	c, err := NewCandidate(uuid.New(), "speedy", 1, "missing qualification", "alcohol")
+	if err != nil || c.IsEligible() || c.MissingQualification() != "alcohol" {
+		t.Error("missing qualification must be recorded")
+	}
+}
+
+func TestNewDecision(t *testing.T) {
+	_, err := NewDecision(uuid.Nil, testIds, testClock)
+	if err == nil {
+		t.Error("invalid order id")
+	}
+
+	orderId := uuid.New()
+	d, err := NewDecision(orderId, testIds, testClock)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Id() == uuid.Nil || d.OrderId() != orderId || d.CourierId() != nil || len(d.Candidates()) != 0 {
+		t.Error("wrong decision data")
+	}
+}
+
+func TestDecision_AddCandidate(t *testing.T) {
+	d, _ := NewDecision(uuid.New(), testIds, testClock)
+
+	err := d.AddCandidate(Candidate{})
+	if err == nil {
+		t.Error("empty candidate")
+	}
+
+	c, _ := NewCandidate(uuid.New(), "speedy", 2, "", "")
+	err = d.AddCandidate(c)
+	if err != nil {
+		t.Error(err)
+	}
+
+	err = d.AddCandidate(c)
+	if err == nil {
+		t.Error("candidate already added")
+	}
+}
+
+func TestDecision_RankedCandidates(t *testing.T) {
+	d, _ := NewDecision(uuid.New(), testIds, testClock)
+
+	slow, _ := NewCandidate(uuid.New(), "slow", 5, "", "")
+	fast, _ := NewCandidate(uuid.New(), "fast", 1, "", "")
+	full, _ := NewCandidate(uuid.New(), "full", 0.5, "storage place not found", "")
+
+	_ = d.AddCandidate(slow)
+	_ = d.AddCandidate(full)
+	_ = d.AddCandidate(fast)
+
+	ranked := d.RankedCandidates()
+	if len(ranked) != 2 {
+		t.Fatal("rejected candidates must not be ranked")
+	}
+
+	if ranked[0].CourierId() != fast.CourierId() || ranked[1].CourierId() != slow.CourierId() {
+		t.Error("candidates must be ranked by eta")
+	}
+}
+
+func TestDecision_ChooseCourier(t *testing.T) {
+	d, _ := NewDecision(uuid.New(), testIds, testClock)
+
+	eligible, _ := NewCandidate(uuid.New(), "eligible", 1, "", "")
+	rejected, _ := NewCandidate(uuid.New(), "rejected", 1, "order is already assigned", "")
+
+	_ = d.AddCandidate(eligible)
+	_ = d.AddCandidate(rejected)
+
+	if err := d.ChooseCourier(uuid.New()); err == nil {
+		t.Error("courier is not a candidate")
+	}
+
+	if err := d.ChooseCourier(rejected.CourierId()); err == nil {
+		t.Error("rejected courier must not be chosen")
+	}
+
+	if err := d.ChooseCourier(eligible.CourierId()); err != nil {
+		t.Error(err)
+	}
+
+	if d.CourierId() == nil || *d.CourierId() != eligible.CourierId() {
+		t.Error("invalid courierId")
+	}
+
+	if err := d.ChooseCourier(eligible.CourierId()); err == nil {
+		t.Error("courier already chosen")
+	}
+}
+
+func TestDecision_Repeats(t *testing.T) {
+	orderId := uuid.New()
+	full, _ := NewCandidate(uuid.New(), "full", 1, "storage place not found", "")
+	busy, _ := NewCandidate(uuid.New(), "busy", 2, "order is already assigned", "")
+
+	prev, _ := NewDecision(orderId, testIds, testClock)
+	_ = prev.AddCandidate(full)
+	_ = prev.AddCandidate(busy)
+
+	// тот же набор кандидатов, курьеры лишь переместились
+	moved, _ := NewCandidate(full.CourierId(), "full", 3, "storage place not found", "")
+	same, _ := NewDecision(orderId, testIds, testClock)
+	_ = same.AddCandidate(moved)
+	_ = same.AddCandidate(busy)
+
+	if !same.Repeats(prev) {
+		t.Error("decision with the same candidates must repeat the previous one")
+	}
+
+	if same.Repeats(nil) {
+		t.Error("first decision must not repeat anything")
+	}
+
+	// кандидатов стало меньше
+	fewer, _ := NewDecision(orderId, testIds, testClock)
+	_ = fewer.AddCandidate(full)
+
+	if fewer.Repeats(prev) {
+		t.Error("decision with other candidates must not repeat the previous one")
+	}
+
+	// причина отказа изменилась
+	freed, _ := NewCandidate(busy.CourierId(), "busy", 2, "", "")
+	changed, _ := NewDecision(orderId, testIds, testClock)
+	_ = changed.AddCandidate(full)
+	_ = changed.AddCandidate(freed)
+
+	if changed.Repeats(prev) {
+		t.Error("decision with other rejection reasons must not repeat the previous one")
+	}
+
+	// выбранный курьер всегда записывается
+	_ = changed.ChooseCourier(freed.CourierId())
+	if changed.Repeats(prev) {
+		t.Error("decision with chosen courier must not repeat the previous one")
+	}
+}
@@ -1,23 +1,65 @@
 package order
 
 import (
+	"crypto/subtle"
 	"delivery/internal/core/domain/kernel"
 	"delivery/internal/pkg/ddd"
 	"errors"
+	"fmt"
 	"github.com/google/uuid"
+	"slices"
+	"time"
+)
+
+// confirmationCodeDigits - длина кода подтверждения доставки
+const confirmationCodeDigits = 4
+
+var (
+	ErrNotAwaitingConfirmation = errors.New("order is not awaiting delivery confirmation")
+	ErrNotOutForDelivery       = errors.New("order is not out for delivery")
+	ErrAlreadyAssigned         = errors.New("courier already assigned")
+	ErrNotReassignable         = errors.New("order is not assigned or goods are already picked up")
 )
 
 type Order struct {
 	id        uuid.UUID
 	courierId *uuid.UUID
-	location  kernel.Location
-	volume    int
-	status    Status
+	// pickupLocation - магазин или склад, откуда курьер забирает товар. Пустое значение - товар уже у курьера
+	pickupLocation kernel.Location
+	location       kernel.Location
+	volume         int
+	// weight - вес в граммах. 0 - вес неизвестен и не ограничивает выбор места хранения
+	weight int
+	// handling - требование к месту хранения (термосумка, холодильник и т.п.)
+	handling Handling
+	// restrictions - товары, которые может вручить только курьер с допуском (алкоголь, лекарства)
+	restrictions []Restriction
+	status       Status
+	priority     Priority
+	createdAt    time.Time
+
+	dispatchAttempts int
+
+	// confirmationCode - код, который получатель сообщает курьеру. Пустой - заказ завершается по прибытии курьера
+	confirmationCode string
+	deliveryAttempts int
+
+	// returnLocation - склад, куда курьер везет недоставленный товар
+	returnLocation kernel.Location
+	// retryDelivery - после возврата на склад заказ снова ждет курьера, забор товара - со склада
+	retryDelivery bool
+
+	// parentId - заказ, из которого выделено это отправление. Пустой - обычный заказ
+	parentId *uuid.UUID
+
+	// assignedBy - оператор, вручную назначивший курьера. Пустой - курьера выбрал диспетчер
+	assignedBy string
 
 	events []ddd.DomainEvent
 }
 
-func NewOrder(orderId uuid.UUID, location kernel.Location, volume int) (*Order, error) {
+func NewOrder(orderId uuid.UUID, location kernel.Location, volume int, weight int, priority Priority,
+	ids kernel.IDGenerator, clock kernel.Clock) (*Order, error) {
 	if orderId == uuid.Nil {
 		return nil, errors.New("empty orderId")
 	}
@@ -30,21 +72,62 @@ func NewOrder(orderId uuid.UUID, location kernel.Location, volume int) (*Order,
 		return nil, errors.New("volume <= 0")
 	}
 
-	orderCreatedEvent, err := NewCreatedDomainEvent(orderId)
+	if weight < 0 {
+		return nil, errors.New("weight < 0")
+	}
+
+	if !priority.IsValid() {
+		return nil, errors.New("invalid priority")
+	}
+
+	if ids == nil {
+		return nil, errors.New("empty id generator")
+	}
+
+	if clock == nil {
+		return nil, errors.New("empty clock")
+	}
+
+	orderCreatedEvent, err := NewCreatedDomainEvent(orderId, ids, clock)
 	if err != nil {
 		return nil, err
 	}
 
 	order := &Order{
-		id:       orderId,
-		location: location,
-		volume:   volume,
-		status:   StatusCreated,
-		events:   []ddd.DomainEvent{},
+		id:        orderId,
+		location:  location,
+		volume:    volume,
+		weight:    weight,
+		handling:  HandlingStandard,
+		status:    StatusCreated,
+		priority:  priority,
+		createdAt: orderCreatedEvent.GetOccurredAt(),
+		events:    []ddd.DomainEvent{},
 	}
 
 	order.RaiseDomainEvent(orderCreatedEvent)
 
+	err = order.update(StatusCreated, nil, ids, clock)
+	if err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+// NewPickupOrder создает двухплечевой заказ: курьер сначала забирает товар в pickup, затем везет его в location
+func NewPickupOrder(orderId uuid.UUID, pickup kernel.Location, location kernel.Location, volume int, weight int,
+	priority Priority, ids kernel.IDGenerator, clock kernel.Clock) (*Order, error) {
+	if pickup.IsEmpty() {
+		return nil, errors.New("empty pickup location")
+	}
+
+	order, err := NewOrder(orderId, location, volume, weight, priority, ids, clock)
+	if err != nil {
+		return nil, err
+	}
+
+	order.pickupLocation = pickup
 	return order, nil
 }
 
@@ -72,60 +155,622 @@ func (o *Order) Location() kernel.Location {
 	return o.location
 }
 
+func (o *Order) PickupLocation() kernel.Location {
+	return o.pickupLocation
+}
+
+func (o *Order) HasPickup() bool {
+	return !o.pickupLocation.IsEmpty()
+}
+
+// CurrentTarget возвращает точку, к которой курьер должен двигаться сейчас
+func (o *Order) CurrentTarget() kernel.Location {
+	if o.status == StatusAssignedToPickup {
+		return o.pickupLocation
+	}
+
+	if o.status == StatusDeliveryFailed {
+		return o.returnLocation
+	}
+
+	return o.location
+}
+
 func (o *Order) Volume() int {
 	return o.volume
 }
 
+func (o *Order) Weight() int {
+	return o.weight
+}
+
+func (o *Order) Handling() Handling {
+	return o.handling
+}
+
+func (o *Order) Restrictions() []Restriction {
+	return o.restrictions
+}
+
 func (o *Order) Status() Status {
 	return o.status
 }
 
+func (o *Order) Priority() Priority {
+	return o.priority
+}
+
+func (o *Order) CreatedAt() time.Time {
+	return o.createdAt
+}
+
+func (o *Order) DispatchAttempts() int {
+	return o.dispatchAttempts
+}
+
+func (o *Order) ConfirmationCode() string {
+	return o.confirmationCode
+}
+
+func (o *Order) RequiresConfirmation() bool {
+	return o.confirmationCode != ""
+}
+
+func (o *Order) DeliveryAttempts() int {
+	return o.deliveryAttempts
+}
+
+func (o *Order) ReturnLocation() kernel.Location {
+	return o.returnLocation
+}
+
+func (o *Order) RetryDelivery() bool {
+	return o.retryDelivery
+}
+
+func (o *Order) ParentId() *uuid.UUID {
+	return o.parentId
+}
+
+func (o *Order) AssignedBy() string {
+	return o.assignedBy
+}
+
+// IsShipment - заказ является отправлением разделенного заказа
+func (o *Order) IsShipment() bool {
+	return o.parentId != nil
+}
+
+// trackingId - идентификатор заказа, известный получателю. Для отправления это идентификатор исходного заказа
+func (o *Order) trackingId() uuid.UUID {
+	if o.IsShipment() {
+		return *o.parentId
+	}
+
+	return o.id
+}
+
+// IsOutForDelivery - товар у курьера и едет к получателю
+func (o *Order) IsOutForDelivery() bool {
+	return o.status == StatusAssigned || o.status == StatusPickedUp
+}
+
 func (o *Order) Equals(other *Order) bool {
 	return other != nil && o.id == other.id
 }
 
-func (o *Order) AssignCourier(courierId uuid.UUID) error {
+func (o *Order) AssignCourier(courierId uuid.UUID, ids kernel.IDGenerator, clock kernel.Clock) error {
 	if o.status != StatusCreated {
-		return errors.New("courier already assigned")
+		return ErrAlreadyAssigned
 	}
 
 	if courierId == uuid.Nil {
 		return errors.New("empty courierId")
 	}
 
-	o.courierId = &courierId
-	o.status = StatusAssigned
+	status := StatusAssigned
+	if o.HasPickup() {
+		status = StatusAssignedToPickup
+	}
+
+	return o.update(status, &courierId, ids, clock)
+}
+
+// Assign назначает заказ курьеру по решению оператора, минуя диспетчера
+func (o *Order) Assign(courierId uuid.UUID, operator string, ids kernel.IDGenerator, clock kernel.Clock) error {
+	if operator == "" {
+		return errors.New("empty operator")
+	}
+
+	assignedEvent, err := NewAssignedDomainEvent(o.id, courierId, operator, ids, clock)
+	if err != nil {
+		return err
+	}
+
+	err = o.AssignCourier(courierId, ids, clock)
+	if err != nil {
+		return err
+	}
+
+	o.assignedBy = operator
+	o.RaiseDomainEvent(assignedEvent)
+
 	return nil
 }
 
-func (o *Order) Complete() error {
+// Unassign снимает заказ с курьера, и заказ снова ждет назначения. Снять можно только заказ,
+// который курьер еще не забрал в точке забора или который не требует забора
+func (o *Order) Unassign(operator string, ids kernel.IDGenerator, clock kernel.Clock) error {
+	if operator == "" {
+		return errors.New("empty operator")
+	}
+
+	if !o.isReassignable() {
+		return ErrNotReassignable
+	}
+
+	unassignedEvent, err := NewUnassignedDomainEvent(o.id, *o.courierId, operator, ids, clock)
+	if err != nil {
+		return err
+	}
+
+	o.assignedBy = ""
+	o.RaiseDomainEvent(unassignedEvent)
+
+	return o.update(StatusCreated, nil, ids, clock)
+}
+
+// Reassign передает заказ другому курьеру. Статус заказа не меняется: новый курьер продолжает с того же этапа
+func (o *Order) Reassign(courierId uuid.UUID, operator string, ids kernel.IDGenerator, clock kernel.Clock) error {
+	if courierId == uuid.Nil {
+		return errors.New("empty courierId")
+	}
+
+	if operator == "" {
+		return errors.New("empty operator")
+	}
+
+	if !o.isReassignable() {
+		return ErrNotReassignable
+	}
+
+	if *o.courierId == courierId {
+		return ErrAlreadyAssigned
+	}
+
+	reassignedEvent, err := NewReassignedDomainEvent(o.id, *o.courierId, courierId, operator, ids, clock)
+	if err != nil {
+		return err
+	}
+
+	o.assignedBy = operator
+	o.RaiseDomainEvent(reassignedEvent)
+
+	return o.update(o.status, &courierId, ids, clock)
+}
+
+// isReassignable - заказ назначен, но курьер еще не забрал товар и не пытался его вручить
+func (o *Order) isReassignable() bool {
+	return o.status == StatusAssigned || o.status == StatusAssignedToPickup
+}
+
+// PickUp фиксирует, что курьер забрал товар в точке pickup и везет его получателю
+func (o *Order) PickUp(ids kernel.IDGenerator, clock kernel.Clock) error {
+	if o.status != StatusAssignedToPickup {
+		return errors.New("order is not awaiting pickup")
+	}
+
+	return o.update(StatusPickedUp, o.courierId, ids, clock)
+}
+
+func (o *Order) Complete(ids kernel.IDGenerator, clock kernel.Clock) error {
 	if o.status == StatusCompleted {
 		return errors.New("already completed")
 	}
 
-	if o.status == StatusCreated {
+	if o.status == StatusCreated || o.status == StatusUndeliverable {
 		return errors.New("order w/o assigned courier")
 	}
 
-	o.status = StatusCompleted
+	if o.status == StatusDeliveryFailed || o.status == StatusReturned {
+		return errors.New("delivery failed")
+	}
 
-	orderCompletedEvent, err := NewCompletedDomainEvent(o.id, *o.courierId)
+	if o.status == StatusAssignedToPickup {
+		return errors.New("order is not picked up")
+	}
+
+	if o.status == StatusSplit {
+		return errors.New("order is split into shipments")
+	}
+
+	// О доставке отправления получатель не уведомляется, событие выпускает исходный заказ, см. FinishShipments
+	if o.IsShipment() {
+		return o.update(StatusCompleted, o.courierId, ids, clock)
+	}
+
+	orderCompletedEvent, err := NewCompletedDomainEvent(o.id, *o.courierId, ids, clock)
 	if err != nil {
 		return err
 	}
 
 	o.RaiseDomainEvent(orderCompletedEvent)
 
+	return o.update(StatusCompleted, o.courierId, ids, clock)
+}
+
+// Split делит заказ на отправления по группам товаров. Каждое отправление назначается курьеру независимо,
+// сам заказ больше не участвует в диспетчеризации и закрывается, когда закрыты все отправления
+func (o *Order) Split(groups [][]Item, ids kernel.IDGenerator, clock kernel.Clock) ([]*Order, error) {
+	if ids == nil {
+		return nil, errors.New("empty id generator")
+	}
+
+	if clock == nil {
+		return nil, errors.New("empty clock")
+	}
+
+	if o.status != StatusCreated {
+		return nil, errors.New("order is not in created status")
+	}
+
+	if o.IsShipment() {
+		return nil, errors.New("shipment can not be split")
+	}
+
+	if len(groups) < 2 {
+		return nil, errors.New("at least two shipments required")
+	}
+
+	shipments := make([]*Order, 0, len(groups))
+	for _, group := range groups {
+		if len(group) == 0 {
+			return nil, errors.New("empty shipment")
+		}
+
+		volume, weight := 0, 0
+		handlings := make([]Handling, 0, len(group))
+		for _, item := range group {
+			if item.IsEmpty() {
+				return nil, errors.New("empty item")
+			}
+
+			volume += item.volume
+			weight += item.weight
+			handlings = append(handlings, item.handling)
+		}
+
+		// Отправление перевозится по условиям своих товаров, а обычные товары - по условиям заказа
+		handling, err := CombineHandling(handlings...)
+		if err != nil {
+			return nil, err
+		}
+
+		if handling == HandlingStandard {
+			handling = o.handling
+		}
+
+		parentId := o.id
+		shipments = append(shipments, &Order{
+			id:               ids.NewId(),
+			pickupLocation:   o.pickupLocation,
+			location:         o.location,
+			volume:           volume,
+			weight:           weight,
+			handling:         handling,
+			restrictions:     slices.Clone(o.restrictions),
+			status:           StatusCreated,
+			priority:         o.priority,
+			createdAt:        o.createdAt,
+			confirmationCode: o.confirmationCode,
+			parentId:         &parentId,
+			events:           []ddd.DomainEvent{},
+		})
+	}
+
+	err := o.update(StatusSplit, o.courierId, ids, clock)
+	if err != nil {
+		return nil, err
+	}
+
+	return shipments, nil
+}
+
+// FinishShipments закрывает разделенный заказ, когда закрыты все его отправления. Заказ доставлен, только если
+// доставлены все отправления. Иначе он закрывается худшим из исходов: недоставляемым, если какое-то отправление
+// не смог взять ни один курьер, или возвращенным. Получатель уже узнал о таком исходе из события отправления,
+// поэтому отдельное событие выпускается только о доставке. Возвращает true, если заказ закрыт
+func (o *Order) FinishShipments(shipments []*Order, ids kernel.IDGenerator, clock kernel.Clock) (bool, error) {
+	if o.status != StatusSplit {
+		return false, errors.New("order is not split")
+	}
+
+	if len(shipments) == 0 {
+		return false, errors.New("empty shipments")
+	}
+
+	status := StatusCompleted
+	var courierId *uuid.UUID
+	for _, shipment := range shipments {
+		if shipment.parentId == nil || *shipment.parentId != o.id {
+			return false, errors.New("shipment belongs to another order")
+		}
+
+		switch shipment.status {
+		case StatusCompleted:
+			courierId = shipment.courierId
+		case StatusUndeliverable:
+			status = StatusUndeliverable
+		case StatusReturned:
+			if status == StatusCompleted {
+				status = StatusReturned
+			}
+		default:
+			return false, nil
+		}
+	}
+
+	if status == StatusCompleted {
+		orderCompletedEvent, err := NewCompletedDomainEvent(o.id, *courierId, ids, clock)
+		if err != nil {
+			return false, err
+		}
+
+		o.RaiseDomainEvent(orderCompletedEvent)
+	}
+
+	return true, o.update(status, o.courierId, ids, clock)
+}
+
+// RequireConfirmation назначает заказу случайный код подтверждения доставки. Код - секрет, поэтому rnd
+// должен быть криптостойким. Код передается получателю
+// в событии о создании заказа, поэтому задать его можно только новому, еще не сохраненному заказу
+func (o *Order) RequireConfirmation(rnd kernel.RandomSource) error {
+	if rnd == nil {
+		return errors.New("empty random source")
+	}
+
+	if o.status != StatusCreated {
+		return errors.New("order is not in created status")
+	}
+
+	if o.RequiresConfirmation() {
+		return errors.New("confirmation code already set")
+	}
+
+	createdEvent, err := o.pendingCreatedEvent()
+	if err != nil {
+		return err
+	}
+
+	maxCode := 1
+	for range confirmationCodeDigits {
+		maxCode *= 10
+	}
+
+	o.confirmationCode = fmt.Sprintf("%0*d", confirmationCodeDigits, rnd.Intn(maxCode))
+	createdEvent.ConfirmationCode = o.confirmationCode
+
+	return nil
+}
+
+// AttachTrackingToken передает получателю токен ссылки для отслеживания заказа. Токен не хранится в заказе:
+// он попадает только в событие о создании заказа, поэтому передать его можно только новому заказу
+func (o *Order) AttachTrackingToken(token string) error {
+	if token == "" {
+		return errors.New("empty tracking token")
+	}
+
+	createdEvent, err := o.pendingCreatedEvent()
+	if err != nil {
+		return err
+	}
+
+	if createdEvent.TrackingToken != "" {
+		return errors.New("tracking token already attached")
+	}
+
+	createdEvent.TrackingToken = token
+
+	return nil
+}
+
+// pendingCreatedEvent - событие о создании заказа, которое еще не сохранено
+func (o *Order) pendingCreatedEvent() (*CreatedDomainEvent, error) {
+	for _, event := range o.events {
+		if e, ok := event.(*CreatedDomainEvent); ok {
+			return e, nil
+		}
+	}
+
+	return nil, errors.New("order is already saved")
+}
+
+// RequireHandling задает требование к месту хранения. Менять его можно только до назначения курьера,
+// иначе заказ может оказаться в неподходящем месте
+func (o *Order) RequireHandling(handling Handling) error {
+	if !handling.IsValid() {
+		return errors.New("invalid handling")
+	}
+
+	if o.status != StatusCreated {
+		return errors.New("order is not in created status")
+	}
+
+	o.handling = handling
+	return nil
+}
+
+// AddRestriction добавляет ограничение на вручение. Как и требование к месту хранения,
+// менять его можно только до назначения курьера
+func (o *Order) AddRestriction(restriction Restriction) error {
+	if !restriction.IsValid() {
+		return errors.New("invalid restriction")
+	}
+
+	if o.status != StatusCreated {
+		return errors.New("order is not in created status")
+	}
+
+	if slices.Contains(o.restrictions, restriction) {
+		return nil
+	}
+
+	o.restrictions = append(o.restrictions, restriction)
+	return nil
+}
+
+// ConfirmDelivery завершает заказ, если курьер ввел верный код. Неверный код учитывается как неудачная попытка,
+// после maxAttempts попыток доставка считается несостоявшейся и товар едет в returnTo. Возвращает true,
+// если заказ завершен
+func (o *Order) ConfirmDelivery(code string, maxAttempts int, returnTo kernel.Location, ids kernel.IDGenerator,
+	clock kernel.Clock) (bool, error) {
+	if !o.RequiresConfirmation() || !o.IsOutForDelivery() {
+		return false, ErrNotAwaitingConfirmation
+	}
+
+	if maxAttempts <= 0 {
+		return false, errors.New("maxAttempts <= 0")
+	}
+
+	if subtle.ConstantTimeCompare([]byte(code), []byte(o.confirmationCode)) == 1 {
+		return true, o.Complete(ids, clock)
+	}
+
+	o.deliveryAttempts++
+	if o.deliveryAttempts < maxAttempts {
+		return false, nil
+	}
+
+	return false, o.FailDelivery("confirmation attempts exceeded", returnTo, false, ids, clock)
+}
+
+// FailDelivery фиксирует, что товар не удалось вручить получателю (например, его нет дома).
+// Курьер везет товар в returnTo. С retry заказ после возврата снова ждет курьера, иначе остается на складе
+func (o *Order) FailDelivery(reason string, returnTo kernel.Location, retry bool, ids kernel.IDGenerator,
+	clock kernel.Clock) error {
+	if !o.IsOutForDelivery() {
+		return ErrNotOutForDelivery
+	}
+
+	if returnTo.IsEmpty() {
+		return errors.New("empty return location")
+	}
+
+	deliveryFailedEvent, err := NewDeliveryFailedDomainEvent(o.trackingId(), *o.courierId, reason, ids, clock)
+	if err != nil {
+		return err
+	}
+
+	o.returnLocation = returnTo
+	o.retryDelivery = retry
+	o.RaiseDomainEvent(deliveryFailedEvent)
+
+	return o.update(StatusDeliveryFailed, o.courierId, ids, clock)
+}
+
+// Return фиксирует, что курьер привез недоставленный товар на склад. Заказ с повторной доставкой
+// снова ждет курьера и забирается уже со склада
+func (o *Order) Return(ids kernel.IDGenerator, clock kernel.Clock) error {
+	if o.status != StatusDeliveryFailed {
+		return errors.New("order is not in delivery failed status")
+	}
+
+	if !o.retryDelivery {
+		return o.update(StatusReturned, o.courierId, ids, clock)
+	}
+
+	if ids == nil {
+		return errors.New("empty id generator")
+	}
+
+	if clock == nil {
+		return errors.New("empty clock")
+	}
+
+	o.pickupLocation = o.returnLocation
+	o.dispatchAttempts = 0
+	o.deliveryAttempts = 0
+	o.returnLocation = kernel.Location{}
+	o.retryDelivery = false
+	o.assignedBy = ""
+
+	return o.update(StatusCreated, nil, ids, clock)
+}
+
+// FailDispatch учитывает неудачную попытку назначить курьера. После maxAttempts попыток
+// заказ становится недоставляемым и больше не участвует в диспетчеризации
+func (o *Order) FailDispatch(maxAttempts int, reason string, ids kernel.IDGenerator, clock kernel.Clock) error {
+	if o.status != StatusCreated {
+		return errors.New("order is not in created status")
+	}
+
+	if maxAttempts <= 0 {
+		return errors.New("maxAttempts <= 0")
+	}
+
+	o.dispatchAttempts++
+	if o.dispatchAttempts < maxAttempts {
+		return nil
+	}
+
+	return o.Reject(reason, ids, clock)
+}
+
+// Reject признает новый заказ недоставляемым, не дожидаясь диспетчеризации, например если его товары
+// нельзя везти вместе. Получатель узнает об этом из события
+func (o *Order) Reject(reason string, ids kernel.IDGenerator, clock kernel.Clock) error {
+	if o.status != StatusCreated {
+		return errors.New("order is not in created status")
+	}
+
+	orderRejectedEvent, err := NewRejectedDomainEvent(o.trackingId(), reason, ids, clock)
+	if err != nil {
+		return err
+	}
+
+	o.RaiseDomainEvent(orderRejectedEvent)
+
+	return o.update(StatusUndeliverable, o.courierId, ids, clock)
+}
+
+// update переводит заказ в status с курьером courierId и выпускает UpdatedDomainEvent
+func (o *Order) update(status Status, courierId *uuid.UUID, ids kernel.IDGenerator, clock kernel.Clock) error {
+	updatedEvent, err := NewUpdatedDomainEvent(o.id, courierId, status, o.location, ids, clock)
+	if err != nil {
+		return err
+	}
+
+	o.status = status
+	o.courierId = courierId
+	o.RaiseDomainEvent(updatedEvent)
+
 	return nil
 }
 
 // RestoreOrder should be used ONLY inside Repository
-func RestoreOrder(id uuid.UUID, courierId *uuid.UUID, location kernel.Location, volume int, status Status) *Order {
+func RestoreOrder(id uuid.UUID, courierId *uuid.UUID, pickupLocation kernel.Location, location kernel.Location,
+	volume int, weight int, handling Handling, restrictions []Restriction, status Status, priority Priority,
+	createdAt time.Time, dispatchAttempts int, confirmationCode string, deliveryAttempts int,
+	returnLocation kernel.Location, retryDelivery bool, parentId *uuid.UUID, assignedBy string) *Order {
 	return &Order{
-		id:        id,
-		courierId: courierId,
-		location:  location,
-		volume:    volume,
-		status:    status,
+		id:               id,
+		courierId:        courierId,
+		pickupLocation:   pickupLocation,
+		location:         location,
+		volume:           volume,
+		weight:           weight,
+		handling:         handling,
+		restrictions:     restrictions,
+		status:           status,
+		priority:         priority,
+		createdAt:        createdAt,
+		dispatchAttempts: dispatchAttempts,
+		confirmationCode: confirmationCode,
+		deliveryAttempts: deliveryAttempts,
+		returnLocation:   returnLocation,
+		retryDelivery:    retryDelivery,
+		parentId:         parentId,
+		assignedBy:       assignedBy,
 	}
 }
@@ -0,0 +1,77 @@
+package order
+
+import (
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/pkg/ddd"
+	"delivery/internal/pkg/errs"
+	"github.com/google/uuid"
+	"reflect"
+	"time"
+)
+
+var _ ddd.DomainEvent = &AssignedDomainEvent{}
+
+// AssignedDomainEvent - оператор вручную назначил заказ курьеру
+type AssignedDomainEvent struct {
+	Id         uuid.UUID
+	Name       string
+	OccurredAt time.Time
+
+	OrderId   uuid.UUID
+	CourierId uuid.UUID
+	// Operator - оператор, назначивший заказ
+	Operator string
+
+	isValid bool
+}
+
+func NewAssignedDomainEvent(orderId uuid.UUID, courierId uuid.UUID, operator string, ids kernel.IDGenerator,
+	clock kernel.Clock) (ddd.DomainEvent, error) {
+
+	event := &AssignedDomainEvent{}
+	if orderId == uuid.Nil {
+		return event, errs.NewValueIsRequiredError("orderId")
+	}
+
+	if courierId == uuid.Nil {
+		return event, errs.NewValueIsRequiredError("courierId")
+	}
+
+	if operator == "" {
+		return event, errs.NewValueIsRequiredError("operator")
+	}
+
+	if ids == nil {
+		return event, errs.NewValueIsRequiredError("ids")
+	}
+
+	if clock == nil {
+		return event, errs.NewValueIsRequiredError("clock")
+	}
+
+	event.Id = ids.NewId()
+	event.Name = reflect.TypeOf(event).Elem().Name()
+	event.OccurredAt = clock.Now().UTC()
+	event.OrderId = orderId
+	event.CourierId = courierId
+	event.Operator = operator
+	event.isValid = true
+
+	return event, nil
+}
+
+func (e *AssignedDomainEvent) GetID() uuid.UUID {
+	return e.Id
+}
+
+func (e *AssignedDomainEvent) GetName() string {
+	return e.Name
+}
+
+func (e *AssignedDomainEvent) GetOccurredAt() time.Time {
+	return e.OccurredAt
+}
+
+func (e *AssignedDomainEvent) IsValid() bool {
+	return e.isValid
+}
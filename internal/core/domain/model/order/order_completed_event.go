@@ -1,6 +1,7 @@
 package order
 
 import (
+	"delivery/internal/core/domain/kernel"
 	"delivery/internal/pkg/ddd"
 	"delivery/internal/pkg/errs"
 	"github.com/google/uuid"
@@ -21,7 +22,8 @@ type CompletedDomainEvent struct {
 	isValid bool
 }
 
-func NewCompletedDomainEvent(orderId uuid.UUID, courierId uuid.UUID) (ddd.DomainEvent, error) {
+func NewCompletedDomainEvent(orderId uuid.UUID, courierId uuid.UUID, ids kernel.IDGenerator,
+	clock kernel.Clock) (ddd.DomainEvent, error) {
 
 	event := &CompletedDomainEvent{}
 	if orderId == uuid.Nil {
@@ -32,9 +34,17 @@ func NewCompletedDomainEvent(orderId uuid.UUID, courierId uuid.UUID) (ddd.Domain
 		return event, errs.NewValueIsRequiredError("courierId")
 	}
 
-	event.Id = uuid.New()
+	if ids == nil {
+		return event, errs.NewValueIsRequiredError("ids")
+	}
+
+	if clock == nil {
+		return event, errs.NewValueIsRequiredError("clock")
+	}
+
+	event.Id = ids.NewId()
 	event.Name = reflect.TypeOf(event).Elem().Name()
-	event.OccurredAt = time.Now().UTC()
+	event.OccurredAt = clock.Now().UTC()
 	event.OrderId = orderId
 	event.CourierId = courierId
 	event.isValid = true
@@ -50,6 +60,10 @@ func (e *CompletedDomainEvent) GetName() string {
 	return e.Name
 }
 
+func (e *CompletedDomainEvent) GetOccurredAt() time.Time {
+	return e.OccurredAt
+}
+
 func (e *CompletedDomainEvent) IsValid() bool {
 	return e.isValid
 }
@@ -1,6 +1,7 @@
 package order
 
 import (
+	"delivery/internal/core/domain/kernel"
 	"delivery/internal/pkg/ddd"
 	"delivery/internal/pkg/errs"
 	"github.com/google/uuid"
@@ -16,19 +17,31 @@ type CreatedDomainEvent struct {
 	OccurredAt time.Time
 
 	OrderId uuid.UUID
+	// ConfirmationCode - код подтверждения доставки для получателя. Пустой - доставка без подтверждения
+	ConfirmationCode string
+	// TrackingToken - токен ссылки, по которой получатель отслеживает заказ. Пустой - ссылка не выдана
+	TrackingToken string
 
 	isValid bool
 }
 
-func NewCreatedDomainEvent(orderId uuid.UUID) (ddd.DomainEvent, error) {
+func NewCreatedDomainEvent(orderId uuid.UUID, ids kernel.IDGenerator, clock kernel.Clock) (ddd.DomainEvent, error) {
 	event := &CreatedDomainEvent{}
 	if orderId == uuid.Nil {
 		return event, errs.NewValueIsRequiredError("orderId")
 	}
 
-	event.Id = uuid.New()
+	if ids == nil {
+		return event, errs.NewValueIsRequiredError("ids")
+	}
+
+	if clock == nil {
+		return event, errs.NewValueIsRequiredError("clock")
+	}
+
+	event.Id = ids.NewId()
 	event.Name = reflect.TypeOf(event).Elem().Name()
-	event.OccurredAt = time.Now().UTC()
+	event.OccurredAt = clock.Now().UTC()
 	event.OrderId = orderId
 	event.isValid = true
 
@@ -43,6 +56,10 @@ func (e *CreatedDomainEvent) GetName() string {
 	return e.Name
 }
 
+func (e *CreatedDomainEvent) GetOccurredAt() time.Time {
+	return e.OccurredAt
+}
+
 func (e *CreatedDomainEvent) IsValid() bool {
 	return e.isValid
 }
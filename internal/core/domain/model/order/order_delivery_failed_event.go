@@ -0,0 +1,72 @@
+package order
+
+import (
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/pkg/ddd"
+	"delivery/internal/pkg/errs"
+	"github.com/google/uuid"
+	"reflect"
+	"time"
+)
+
+var _ ddd.DomainEvent = &DeliveryFailedDomainEvent{}
+
+// DeliveryFailedDomainEvent - заказ не удалось доставить получателю
+type DeliveryFailedDomainEvent struct {
+	Id         uuid.UUID
+	Name       string
+	OccurredAt time.Time
+
+	OrderId   uuid.UUID
+	CourierId uuid.UUID
+	Reason    string
+
+	isValid bool
+}
+
+func NewDeliveryFailedDomainEvent(orderId uuid.UUID, courierId uuid.UUID, reason string, ids kernel.IDGenerator,
+	clock kernel.Clock) (ddd.DomainEvent, error) {
+
+	event := &DeliveryFailedDomainEvent{}
+	if orderId == uuid.Nil {
+		return event, errs.NewValueIsRequiredError("orderId")
+	}
+
+	if courierId == uuid.Nil {
+		return event, errs.NewValueIsRequiredError("courierId")
+	}
+
+	if ids == nil {
+		return event, errs.NewValueIsRequiredError("ids")
+	}
+
+	if clock == nil {
+		return event, errs.NewValueIsRequiredError("clock")
+	}
+
+	event.Id = ids.NewId()
+	event.Name = reflect.TypeOf(event).Elem().Name()
+	event.OccurredAt = clock.Now().UTC()
+	event.OrderId = orderId
+	event.CourierId = courierId
+	event.Reason = reason
+	event.isValid = true
+
+	return event, nil
+}
+
+func (e *DeliveryFailedDomainEvent) GetID() uuid.UUID {
+	return e.Id
+}
+
+func (e *DeliveryFailedDomainEvent) GetName() string {
+	return e.Name
+}
+
+func (e *DeliveryFailedDomainEvent) GetOccurredAt() time.Time {
+	return e.OccurredAt
+}
+
+func (e *DeliveryFailedDomainEvent) IsValid() bool {
+	return e.isValid
+}
@@ -0,0 +1,61 @@
+package order
+
+import (
+	"errors"
+)
+
+// Handling - требование к условиям перевозки заказа
+type Handling string
+
+const (
+	HandlingStandard Handling = "standard"
+	HandlingHot      Handling = "hot"
+	HandlingFrozen   Handling = "frozen"
+	HandlingFragile  Handling = "fragile"
+)
+
+func (h Handling) String() string {
+	return string(h)
+}
+
+func (h Handling) IsValid() bool {
+	switch h {
+	case HandlingStandard, HandlingHot, HandlingFrozen, HandlingFragile:
+		return true
+	default:
+		return false
+	}
+}
+
+func HandlingFromString(s string) (Handling, error) {
+	handling := Handling(s)
+	if handling.IsValid() {
+		return handling, nil
+	}
+
+	return handling, errors.New("invalid handling")
+}
+
+// CombineHandling возвращает требование к заказу из требований его товаров.
+// Обычные товары едут вместе с любыми, а товары с разными особыми требованиями
+// (например, горячее и замороженное) в одно место хранения не помещаются
+func CombineHandling(handlings ...Handling) (Handling, error) {
+	result := HandlingStandard
+	for _, h := range handlings {
+		if !h.IsValid() {
+			return "", errors.New("invalid handling")
+		}
+
+		if h == HandlingStandard || h == result {
+			continue
+		}
+
+		if result != HandlingStandard {
+			return "", errors.New("incompatible handling requirements")
+		}
+
+		result = h
+	}
+
+	return result, nil
+}
@@ -0,0 +1,53 @@
+package order
+
+import "testing"
+
+func TestHandlingFromString(t *testing.T) {
+	validHandlings := []string{
+		"standard",
+		"hot",
+		"frozen",
+		"fragile",
+	}
+
+	for _, handling := range validHandlings {
+		_, err := HandlingFromString(handling)
+		if err != nil {
+			t.Fail()
+		}
+	}
+
+	inValidHandlings := []string{
+		"",
+		"unknown",
+	}
+
+	for _, handling := range inValidHandlings {
+		_, err := HandlingFromString(handling)
+		if err == nil {
+			t.Fail()
+		}
+	}
+}
+
+func TestCombineHandling(t *testing.T) {
+	h, err := CombineHandling()
+	if err != nil || h != HandlingStandard {
+		t.Error("no items means standard handling")
+	}
+
+	h, err = CombineHandling(HandlingStandard, HandlingHot, HandlingHot)
+	if err != nil || h != HandlingHot {
+		t.Error("standard items travel with hot ones")
+	}
+
+	_, err = CombineHandling(HandlingHot, HandlingStandard, HandlingFrozen)
+	if err == nil {
+		t.Error("hot and frozen items are incompatible")
+	}
+
+	_, err = CombineHandling(HandlingStandard, "unknown")
+	if err == nil {
+		t.Error("unknown handling must be rejected")
+	}
+}
@@ -0,0 +1,137 @@
+package order
+
+import (
+	"errors"
+	"slices"
+)
+
+// Item - одна единица товара заказа. Единицу нельзя разделить между отправлениями
+type Item struct {
+	volume int
+	// weight - вес в граммах, 0 - вес неизвестен
+	weight int
+	// handling - условия перевозки товара
+	handling Handling
+
+	isSet bool
+}
+
+func NewItem(volume int, weight int, handling Handling) (Item, error) {
+	if volume <= 0 {
+		return Item{}, errors.New("volume <= 0")
+	}
+
+	if weight < 0 {
+		return Item{}, errors.New("weight < 0")
+	}
+
+	if !handling.IsValid() {
+		return Item{}, errors.New("invalid handling")
+	}
+
+	return Item{
+		volume:   volume,
+		weight:   weight,
+		handling: handling,
+		isSet:    true,
+	}, nil
+}
+
+func (i Item) Volume() int {
+	return i.volume
+}
+
+func (i Item) Weight() int {
+	return i.weight
+}
+
+func (i Item) Handling() Handling {
+	return i.handling
+}
+
+func (i Item) IsEmpty() bool {
+	return !i.isSet
+}
+
+// PackItems раскладывает товары по отправлениям так, чтобы каждое помещалось в maxVolume и maxWeight.
+// Товары с разными условиями перевозки едут в разных отправлениях. Внутри одних условий товары
+// раскладываются от крупных к мелким, каждый - в первое отправление, где для него есть место.
+// Товар, который сам по себе превышает ограничения, получает отдельное отправление.
+// maxWeight = 0 - вес не ограничен
+func PackItems(items []Item, maxVolume int, maxWeight int) ([][]Item, error) {
+	if maxVolume <= 0 {
+		return nil, errors.New("maxVolume <= 0")
+	}
+
+	if maxWeight < 0 {
+		return nil, errors.New("maxWeight < 0")
+	}
+
+	handlings := make([]Handling, 0, 1)
+	byHandling := map[Handling][]Item{}
+	for _, item := range items {
+		if item.IsEmpty() {
+			return nil, errors.New("empty item")
+		}
+
+		if _, found := byHandling[item.handling]; !found {
+			handlings = append(handlings, item.handling)
+		}
+
+		byHandling[item.handling] = append(byHandling[item.handling], item)
+	}
+
+	groups := make([][]Item, 0, len(handlings))
+	for _, handling := range handlings {
+		groups = append(groups, packGroup(byHandling[handling], maxVolume, maxWeight)...)
+	}
+
+	return groups, nil
+}
+
+// packGroup раскладывает товары с одинаковыми условиями перевозки
+func packGroup(items []Item, maxVolume int, maxWeight int) [][]Item {
+	sorted := slices.Clone(items)
+	slices.SortStableFunc(sorted, func(a, b Item) int {
+		return b.volume - a.volume
+	})
+
+	type shipment struct {
+		items  []Item
+		volume int
+		weight int
+	}
+
+	var shipments []*shipment
+	for _, item := range sorted {
+		var target *shipment
+		for _, s := range shipments {
+			if s.volume+item.volume > maxVolume {
+				continue
+			}
+
+			if maxWeight > 0 && s.weight+item.weight > maxWeight {
+				continue
+			}
+
+			target = s
+			break
+		}
+
+		if target == nil {
+			target = &shipment{}
+			shipments = append(shipments, target)
+		}
+
+		target.items = append(target.items, item)
+		target.volume += item.volume
+		target.weight += item.weight
+	}
+
+	groups := make([][]Item, 0, len(shipments))
+	for _, s := range shipments {
+		groups = append(groups, s.items)
+	}
+
+	return groups
+}
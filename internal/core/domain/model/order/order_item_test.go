@@ -0,0 +1,130 @@
+package order
+
+import "testing"
+
+func newItems(t *testing.T, volumes ...int) []Item {
+	items := make([]Item, 0, len(volumes))
+	for _, volume := range volumes {
+		item, err := NewItem(volume, 100, HandlingStandard)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		items = append(items, item)
+	}
+	return items
+}
+
+func TestNewItem(t *testing.T) {
+	if _, err := NewItem(0, 0, HandlingStandard); err == nil {
+		t.Error("volume <= 0 must be rejected")
+	}
+
+	if _, err := NewItem(1, -1, HandlingStandard); err == nil {
+		t.Error("weight < 0 must be rejected")
+	}
+
+	if _, err := NewItem(1, 0, "boiling"); err == nil {
+		t.Error("invalid handling must be rejected")
+	}
+
+	item, err := NewItem(3, 0, HandlingHot)
+	if err != nil || item.IsEmpty() || item.Volume() != 3 || item.Handling() != HandlingHot {
+		t.Error("valid item")
+	}
+}
+
+func TestPackItems_SeparatesHandling(t *testing.T) {
+	hot, _ := NewItem(2, 0, HandlingHot)
+	frozen, _ := NewItem(3, 0, HandlingFrozen)
+	standard, _ := NewItem(1, 0, HandlingStandard)
+
+	groups, err := PackItems([]Item{hot, frozen, standard, hot}, 100, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(groups) != 3 {
+		t.Fatalf("expected shipment per handling, got %d", len(groups))
+	}
+
+	for _, group := range groups {
+		for _, item := range group {
+			if item.Handling() != group[0].Handling() {
+				t.Fatal("items with different handling must not share shipment")
+			}
+		}
+	}
+
+	if len(groups[0]) != 2 || groups[0][0].Handling() != HandlingHot {
+		t.Error("hot items must travel together")
+	}
+}
+
+func TestPackItems(t *testing.T) {
+	tests := []struct {
+		name      string
+		volumes   []int
+		maxVolume int
+		maxWeight int
+		expected  []int // объемы отправлений
+	}{
+		{
+			name:      "fits one shipment",
+			volumes:   []int{3, 4, 2},
+			maxVolume: 10,
+			expected:  []int{9},
+		},
+		{
+			name:      "largest items first",
+			volumes:   []int{2, 7, 5, 3},
+			maxVolume: 10,
+			expected:  []int{10, 7},
+		},
+		{
+			name:      "oversized item gets own shipment",
+			volumes:   []int{12, 1},
+			maxVolume: 10,
+			expected:  []int{12, 1},
+		},
+		{
+			name:      "weight limit",
+			volumes:   []int{1, 1, 1},
+			maxVolume: 10,
+			maxWeight: 200,
+			expected:  []int{2, 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groups, err := PackItems(newItems(t, tt.volumes...), tt.maxVolume, tt.maxWeight)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(groups) != len(tt.expected) {
+				t.Fatalf("expected %d shipments, got %d", len(tt.expected), len(groups))
+			}
+
+			for i, group := range groups {
+				volume := 0
+				for _, item := range group {
+					volume += item.Volume()
+				}
+
+				if volume != tt.expected[i] {
+					t.Errorf("shipment %d: expected volume %d, got %d", i, tt.expected[i], volume)
+				}
+			}
+		})
+	}
+
+	if _, err := PackItems(newItems(t, 1), 0, 0); err == nil {
+		t.Error("maxVolume <= 0 must be rejected")
+	}
+
+	if _, err := PackItems([]Item{{}}, 10, 0); err == nil {
+		t.Error("empty item must be rejected")
+	}
+}
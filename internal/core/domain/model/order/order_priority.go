@@ -0,0 +1,48 @@
+package order
+
+import (
+	"errors"
+	"time"
+)
+
+type Priority string
+
+const (
+	PriorityStandard Priority = "standard"
+	PriorityExpress  Priority = "express"
+)
+
+// expressHeadStart - на сколько раньше срочный заказ попадает на диспетчеризацию.
+// Обычный заказ, прождавший дольше, все равно будет назначен первым
+const expressHeadStart = 5 * time.Minute
+
+func (p Priority) String() string {
+	return string(p)
+}
+
+func (p Priority) IsValid() bool {
+	switch p {
+	case PriorityStandard, PriorityExpress:
+		return true
+	default:
+		return false
+	}
+}
+
+// HeadStart возвращает фору, с которой заказ встает в очередь на диспетчеризацию
+func (p Priority) HeadStart() time.Duration {
+	if p == PriorityExpress {
+		return expressHeadStart
+	}
+
+	return 0
+}
+
+func PriorityFromString(s string) (Priority, error) {
+	priority := Priority(s)
+	if priority.IsValid() {
+		return priority, nil
+	}
+
+	return priority, errors.New("invalid priority")
+}
@@ -0,0 +1,39 @@
+package order
+
+import "testing"
+
+func TestPriorityFromString(t *testing.T) {
+	validPriorities := []string{
+		"standard",
+		"express",
+	}
+
+	for _, priority := range validPriorities {
+		_, err := PriorityFromString(priority)
+		if err != nil {
+			t.Fail()
+		}
+	}
+
+	inValidPriorities := []string{
+		"",
+		"unknown",
+	}
+
+	for _, priority := range inValidPriorities {
+		_, err := PriorityFromString(priority)
+		if err == nil {
+			t.Fail()
+		}
+	}
+}
+
+func TestPriority_HeadStart(t *testing.T) {
+	if PriorityStandard.HeadStart() != 0 {
+		t.Error("standard order must not have head start")
+	}
+
+	if PriorityExpress.HeadStart() <= 0 {
+		t.Error("express order must have head start")
+	}
+}
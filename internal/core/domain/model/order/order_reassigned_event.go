@@ -0,0 +1,83 @@
+package order
+
+import (
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/pkg/ddd"
+	"delivery/internal/pkg/errs"
+	"github.com/google/uuid"
+	"reflect"
+	"time"
+)
+
+var _ ddd.DomainEvent = &ReassignedDomainEvent{}
+
+// ReassignedDomainEvent - оператор передал заказ от одного курьера другому
+type ReassignedDomainEvent struct {
+	Id         uuid.UUID
+	Name       string
+	OccurredAt time.Time
+
+	OrderId       uuid.UUID
+	FromCourierId uuid.UUID
+	ToCourierId   uuid.UUID
+	// Operator - оператор, передавший заказ
+	Operator string
+
+	isValid bool
+}
+
+func NewReassignedDomainEvent(orderId uuid.UUID, fromCourierId uuid.UUID, toCourierId uuid.UUID, operator string,
+	ids kernel.IDGenerator, clock kernel.Clock) (ddd.DomainEvent, error) {
+
+	event := &ReassignedDomainEvent{}
+	if orderId == uuid.Nil {
+		return event, errs.NewValueIsRequiredError("orderId")
+	}
+
+	if fromCourierId == uuid.Nil {
+		return event, errs.NewValueIsRequiredError("fromCourierId")
+	}
+
+	if toCourierId == uuid.Nil {
+		return event, errs.NewValueIsRequiredError("toCourierId")
+	}
+
+	if operator == "" {
+		return event, errs.NewValueIsRequiredError("operator")
+	}
+
+	if ids == nil {
+		return event, errs.NewValueIsRequiredError("ids")
+	}
+
+	if clock == nil {
+		return event, errs.NewValueIsRequiredError("clock")
+	}
+
+	event.Id = ids.NewId()
+	event.Name = reflect.TypeOf(event).Elem().Name()
+	event.OccurredAt = clock.Now().UTC()
+	event.OrderId = orderId
+	event.FromCourierId = fromCourierId
+	event.ToCourierId = toCourierId
+	event.Operator = operator
+	event.isValid = true
+
+	return event, nil
+}
+
+func (e *ReassignedDomainEvent) GetID() uuid.UUID {
+	return e.Id
+}
+
+func (e *ReassignedDomainEvent) GetName() string {
+	return e.Name
+}
+
+func (e *ReassignedDomainEvent) GetOccurredAt() time.Time {
+	return e.OccurredAt
+}
+
+func (e *ReassignedDomainEvent) IsValid() bool {
+	return e.isValid
+}
@@ -0,0 +1,63 @@
+package order
+
+import (
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/pkg/ddd"
+	"delivery/internal/pkg/errs"
+	"github.com/google/uuid"
+	"reflect"
+	"time"
+)
+
+var _ ddd.DomainEvent = &RejectedDomainEvent{}
+
+type RejectedDomainEvent struct {
+	Id         uuid.UUID
+	Name       string
+	OccurredAt time.Time
+
+	OrderId uuid.UUID
+	Reason  string
+
+	isValid bool
+}
+
+func NewRejectedDomainEvent(orderId uuid.UUID, reason string, ids kernel.IDGenerator, clock kernel.Clock) (ddd.DomainEvent, error) {
+	event := &RejectedDomainEvent{}
+	if orderId == uuid.Nil {
+		return event, errs.NewValueIsRequiredError("orderId")
+	}
+
+	if ids == nil {
+		return event, errs.NewValueIsRequiredError("ids")
+	}
+
+	if clock == nil {
+		return event, errs.NewValueIsRequiredError("clock")
+	}
+
+	event.Id = ids.NewId()
+	event.Name = reflect.TypeOf(event).Elem().Name()
+	event.OccurredAt = clock.Now().UTC()
+	event.OrderId = orderId
+	event.Reason = reason
+	event.isValid = true
+
+	return event, nil
+}
+
+func (e *RejectedDomainEvent) GetID() uuid.UUID {
+	return e.Id
+}
+
+func (e *RejectedDomainEvent) GetName() string {
+	return e.Name
+}
+
+func (e *RejectedDomainEvent) GetOccurredAt() time.Time {
+	return e.OccurredAt
+}
+
+func (e *RejectedDomainEvent) IsValid() bool {
+	return e.isValid
+}
@@ -0,0 +1,36 @@
+package order
+
+import (
+	"errors"
+)
+
+// Restriction - ограничение на вручение заказа: получателю нужно предъявить документ,
+// а курьер должен иметь соответствующий допуск
+type Restriction string
+
+const (
+	RestrictionAlcohol  Restriction = "alcohol"
+	RestrictionMedicine Restriction = "medicine"
+)
+
+func (r Restriction) String() string {
+	return string(r)
+}
+
+func (r Restriction) IsValid() bool {
+	switch r {
+	case RestrictionAlcohol, RestrictionMedicine:
+		return true
+	default:
+		return false
+	}
+}
+
+func RestrictionFromString(s string) (Restriction, error) {
+	restriction := Restriction(s)
+	if restriction.IsValid() {
+		return restriction, nil
+	}
+
+	return restriction, errors.New("invalid restriction")
+}
@@ -5,9 +5,21 @@ import "errors"
 type Status string
 
 const (
-	StatusCreated   Status = "created"
-	StatusAssigned  Status = "assigned"
+	StatusCreated  Status = "created"
+	StatusAssigned Status = "assigned"
+	// StatusAssignedToPickup - курьер назначен и едет за товаром в магазин или на склад
+	StatusAssignedToPickup Status = "assigned_to_pickup"
+	// StatusPickedUp - курьер забрал товар и везет его получателю
+	StatusPickedUp  Status = "picked_up"
 	StatusCompleted Status = "completed"
+	// StatusUndeliverable - ни один курьер не смог взять заказ за отведенное число попыток
+	StatusUndeliverable Status = "undeliverable"
+	// StatusDeliveryFailed - доставка не удалась, курьер везет товар обратно на склад
+	StatusDeliveryFailed Status = "delivery_failed"
+	// StatusReturned - недоставленный товар возвращен на склад
+	StatusReturned Status = "returned"
+	// StatusSplit - заказ разделен на отправления и ждет их доставки
+	StatusSplit Status = "split"
 )
 
 func (s Status) String() string {
@@ -16,7 +28,28 @@ func (s Status) String() string {
 
 func (s Status) IsValid() bool {
 	switch s {
-	case StatusCreated, StatusAssigned, StatusCompleted:
+	case StatusCreated, StatusAssigned, StatusAssignedToPickup, StatusPickedUp, StatusCompleted, StatusUndeliverable,
+		StatusDeliveryFailed, StatusReturned, StatusSplit:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsInProgress - заказ назначен курьеру и товар еще не доставлен и не возвращен
+func (s Status) IsInProgress() bool {
+	switch s {
+	case StatusAssigned, StatusAssignedToPickup, StatusPickedUp, StatusDeliveryFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsFinished - заказ закрыт: доставлен, признан недоставляемым или возвращен на склад
+func (s Status) IsFinished() bool {
+	switch s {
+	case StatusCompleted, StatusUndeliverable, StatusReturned:
 		return true
 	default:
 		return false
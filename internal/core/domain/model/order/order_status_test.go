@@ -6,7 +6,13 @@ func TestStatus_IsValid(t *testing.T) {
 	validStatuses := []string{
 		"created",
 		"assigned",
+		"assigned_to_pickup",
+		"picked_up",
 		"completed",
+		"undeliverable",
+		"delivery_failed",
+		"returned",
+		"split",
 	}
 
 	for _, status := range validStatuses {
@@ -34,6 +40,7 @@ func TestStatusFromString(t *testing.T) {
 		"created",
 		"assigned",
 		"completed",
+		"undeliverable",
 	}
 
 	for _, status := range validStatuses {
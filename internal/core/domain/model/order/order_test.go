@@ -2,10 +2,18 @@ package order
 
 import (
 	"delivery/internal/core/domain/kernel"
+	"errors"
 	"github.com/google/uuid"
+	"math/rand"
+	"slices"
 	"testing"
+	"time"
 )
 
+var testIds = kernel.IDGeneratorFunc(uuid.New)
+var testClock = kernel.ClockFunc(func() time.Time { return time.Now().UTC() })
+var testRnd = rand.New(rand.NewSource(time.Now().UnixNano()))
+
 func newValidLocation() kernel.Location {
 	loc, _ := kernel.NewLocation(1, 1)
 	return loc
@@ -20,6 +28,8 @@ func TestNewOrder(t *testing.T) {
 		orderId     uuid.UUID
 		location    kernel.Location
 		volume      int
+		weight      int
+		priority    Priority
 		expectError bool
 	}{
 		{
@@ -27,6 +37,7 @@ func TestNewOrder(t *testing.T) {
 			orderId:     uuid.UUID{},
 			volume:      10,
 			location:    loc,
+			priority:    PriorityStandard,
 			expectError: true,
 		},
 		{
@@ -34,6 +45,7 @@ func TestNewOrder(t *testing.T) {
 			orderId:     uuid.New(),
 			volume:      10,
 			location:    kernel.Location{},
+			priority:    PriorityStandard,
 			expectError: true,
 		},
 		{
@@ -41,6 +53,24 @@ func TestNewOrder(t *testing.T) {
 			orderId:     uuid.New(),
 			volume:      0,
 			location:    loc,
+			priority:    PriorityStandard,
+			expectError: true,
+		},
+		{
+			name:        "invalid weight",
+			orderId:     uuid.New(),
+			volume:      10,
+			weight:      -1,
+			location:    loc,
+			priority:    PriorityStandard,
+			expectError: true,
+		},
+		{
+			name:        "invalid priority",
+			orderId:     uuid.New(),
+			volume:      10,
+			location:    loc,
+			priority:    "unknown",
 			expectError: true,
 		},
 		{
@@ -48,13 +78,22 @@ func TestNewOrder(t *testing.T) {
 			orderId:     uuid.New(),
 			volume:      10,
 			location:    loc,
+			priority:    PriorityStandard,
+			expectError: false,
+		},
+		{
+			name:        "valid express order",
+			orderId:     uuid.New(),
+			volume:      10,
+			location:    loc,
+			priority:    PriorityExpress,
 			expectError: false,
 		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			o, err := NewOrder(test.orderId, test.location, test.volume)
+			o, err := NewOrder(test.orderId, test.location, test.volume, test.weight, test.priority, testIds, testClock)
 			if test.expectError {
 				if err == nil {
 					t.Fail()
@@ -84,6 +123,14 @@ func TestNewOrder(t *testing.T) {
 					t.Error("courierId")
 				}
 
+				if o.Priority() != test.priority {
+					t.Error("priority")
+				}
+
+				if o.CreatedAt().IsZero() {
+					t.Error("createdAt")
+				}
+
 				if !o.Location().Equals(test.location) {
 					t.Error("location")
 				}
@@ -97,14 +144,14 @@ func TestOrder_Equals(t *testing.T) {
 	volume := 10
 	orderId := uuid.New()
 
-	o1, _ := NewOrder(orderId, loc, volume)
-	o2, _ := NewOrder(orderId, loc, volume)
+	o1, _ := NewOrder(orderId, loc, volume, 0, PriorityStandard, testIds, testClock)
+	o2, _ := NewOrder(orderId, loc, volume, 0, PriorityStandard, testIds, testClock)
 
 	if !o1.Equals(o2) {
 		t.Error("must be equal")
 	}
 
-	o2, _ = NewOrder(uuid.New(), loc, volume)
+	o2, _ = NewOrder(uuid.New(), loc, volume, 0, PriorityStandard, testIds, testClock)
 
 	if o1.Equals(o2) {
 		t.Error("must not be equal")
@@ -113,15 +160,15 @@ func TestOrder_Equals(t *testing.T) {
 }
 
 func TestOrder_AssignCourier(t *testing.T) {
-	o, _ := NewOrder(uuid.New(), newValidLocation(), 10)
+	o, _ := NewOrder(uuid.New(), newValidLocation(), 10, 0, PriorityStandard, testIds, testClock)
 
-	err := o.AssignCourier(uuid.UUID{})
+	err := o.AssignCourier(uuid.UUID{}, testIds, testClock)
 	if err == nil {
 		t.Error("invalid courier Id")
 	}
 
 	courierId := uuid.New()
-	err = o.AssignCourier(courierId)
+	err = o.AssignCourier(courierId, testIds, testClock)
 	if err != nil {
 		t.Error(err)
 	}
@@ -134,23 +181,23 @@ func TestOrder_AssignCourier(t *testing.T) {
 		t.Error("invalid courierId")
 	}
 
-	err = o.AssignCourier(courierId)
+	err = o.AssignCourier(courierId, testIds, testClock)
 	if err == nil {
 		t.Error("courier already assigned")
 	}
 }
 
 func TestOrder_Complete(t *testing.T) {
-	o, _ := NewOrder(uuid.New(), newValidLocation(), 10)
+	o, _ := NewOrder(uuid.New(), newValidLocation(), 10, 0, PriorityStandard, testIds, testClock)
 
-	err := o.Complete()
+	err := o.Complete(testIds, testClock)
 	if err == nil {
 		t.Error("no courier")
 	}
 
-	_ = o.AssignCourier(uuid.New())
+	_ = o.AssignCourier(uuid.New(), testIds, testClock)
 
-	err = o.Complete()
+	err = o.Complete(testIds, testClock)
 	if err != nil {
 		t.Error(err)
 	}
@@ -159,9 +206,679 @@ func TestOrder_Complete(t *testing.T) {
 		t.Error("status != completed")
 	}
 
-	err = o.Complete()
+	err = o.Complete(testIds, testClock)
+	if err == nil {
+		t.Error("already completed")
+	}
+
+}
+
+func TestNewPickupOrder(t *testing.T) {
+	_, err := NewPickupOrder(uuid.New(), kernel.Location{}, newValidLocation(), 10, 0, PriorityStandard, testIds, testClock)
+	if err == nil {
+		t.Error("empty pickup location")
+	}
+
+	pickup, _ := kernel.NewLocation(5, 5)
+	o, err := NewPickupOrder(uuid.New(), pickup, newValidLocation(), 10, 0, PriorityStandard, testIds, testClock)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !o.HasPickup() || !o.PickupLocation().Equals(pickup) || !o.Location().Equals(newValidLocation()) {
+		t.Error("wrong pickup order locations")
+	}
+}
+
+func TestOrder_PickUp(t *testing.T) {
+	pickup, _ := kernel.NewLocation(5, 5)
+	o, _ := NewPickupOrder(uuid.New(), pickup, newValidLocation(), 10, 0, PriorityStandard, testIds, testClock)
+
+	err := o.PickUp(testIds, testClock)
+	if err == nil {
+		t.Error("order w/o courier must not be picked up")
+	}
+
+	_ = o.AssignCourier(uuid.New(), testIds, testClock)
+	if o.Status() != StatusAssignedToPickup || !o.CurrentTarget().Equals(pickup) {
+		t.Error("courier must go to pickup location first")
+	}
+
+	err = o.Complete(testIds, testClock)
+	if err == nil {
+		t.Error("order must not be completed before pickup")
+	}
+
+	err = o.PickUp(testIds, testClock)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if o.Status() != StatusPickedUp || !o.CurrentTarget().Equals(o.Location()) {
+		t.Error("courier must go to dropoff location after pickup")
+	}
+
+	err = o.PickUp(testIds, testClock)
+	if err == nil {
+		t.Error("already picked up")
+	}
+
+	err = o.Complete(testIds, testClock)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if o.Status() != StatusCompleted {
+		t.Error("status != completed")
+	}
+}
+
+func TestOrder_RequireConfirmation(t *testing.T) {
+	o, _ := NewOrder(uuid.New(), newValidLocation(), 10, 0, PriorityStandard, testIds, testClock)
+
+	err := o.RequireConfirmation(testRnd)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !o.RequiresConfirmation() || len(o.ConfirmationCode()) != confirmationCodeDigits {
+		t.Errorf("wrong confirmation code %q", o.ConfirmationCode())
+	}
+
+	// код уходит получателю вместе с событием о создании заказа
+	e, ok := o.GetDomainEvents()[0].(*CreatedDomainEvent)
+	if !ok || e.ConfirmationCode != o.ConfirmationCode() {
+		t.Error("created event must contain confirmation code")
+	}
+
+	err = o.RequireConfirmation(testRnd)
+	if err == nil {
+		t.Error("confirmation code already set")
+	}
+
+	saved, _ := NewOrder(uuid.New(), newValidLocation(), 10, 0, PriorityStandard, testIds, testClock)
+	saved.ClearDomainEvents()
+
+	err = saved.RequireConfirmation(testRnd)
+	if err == nil {
+		t.Error("saved order must not get confirmation code")
+	}
+}
+
+func TestOrder_AttachTrackingToken(t *testing.T) {
+	o, _ := NewOrder(uuid.New(), newValidLocation(), 10, 0, PriorityStandard, testIds, testClock)
+
+	err := o.AttachTrackingToken("token")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// токен уходит получателю вместе с событием о создании заказа
+	e, ok := o.GetDomainEvents()[0].(*CreatedDomainEvent)
+	if !ok || e.TrackingToken != "token" {
+		t.Error("created event must contain tracking token")
+	}
+
+	if o.AttachTrackingToken("other") == nil {
+		t.Error("tracking token already attached")
+	}
+
+	saved, _ := NewOrder(uuid.New(), newValidLocation(), 10, 0, PriorityStandard, testIds, testClock)
+	saved.ClearDomainEvents()
+
+	if saved.AttachTrackingToken("token") == nil {
+		t.Error("saved order must not get tracking token")
+	}
+}
+
+func TestOrder_ConfirmDelivery(t *testing.T) {
+	o, _ := NewOrder(uuid.New(), newValidLocation(), 10, 0, PriorityStandard, testIds, testClock)
+	_ = o.RequireConfirmation(testRnd)
+
+	_, err := o.ConfirmDelivery(o.ConfirmationCode(), 3, newValidLocation(), testIds, testClock)
+	if err == nil {
+		t.Error("order w/o courier must not be confirmed")
+	}
+
+	_ = o.AssignCourier(uuid.New(), testIds, testClock)
+	o.ClearDomainEvents()
+
+	confirmed, err := o.ConfirmDelivery("wrong", 3, newValidLocation(), testIds, testClock)
+	if err != nil || confirmed {
+		t.Fatal("wrong code must not confirm delivery")
+	}
+
+	if o.Status() != StatusAssigned || o.DeliveryAttempts() != 1 {
+		t.Error("wrong code must be counted as failed attempt")
+	}
+
+	confirmed, err = o.ConfirmDelivery(o.ConfirmationCode(), 3, newValidLocation(), testIds, testClock)
+	if err != nil || !confirmed {
+		t.Fatal("right code must confirm delivery")
+	}
+
+	if o.Status() != StatusCompleted {
+		t.Error("status != completed")
+	}
+
+	_, err = o.ConfirmDelivery(o.ConfirmationCode(), 3, newValidLocation(), testIds, testClock)
 	if err == nil {
 		t.Error("already completed")
 	}
+}
+
+func TestOrder_ConfirmDeliveryFails(t *testing.T) {
+	o, _ := NewOrder(uuid.New(), newValidLocation(), 10, 0, PriorityStandard, testIds, testClock)
+	_ = o.RequireConfirmation(testRnd)
+	_ = o.AssignCourier(uuid.New(), testIds, testClock)
+	o.ClearDomainEvents()
+
+	for range 2 {
+		_, err := o.ConfirmDelivery("wrong", 2, newValidLocation(), testIds, testClock)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if o.Status() != StatusDeliveryFailed || o.DeliveryAttempts() != 2 {
+		t.Error("status != delivery_failed")
+	}
+
+	if len(o.GetDomainEvents()) != 2 {
+		t.Fatal("expected delivery failed and updated events")
+	}
+
+	if e, ok := o.GetDomainEvents()[0].(*DeliveryFailedDomainEvent); !ok || e.OrderId != o.Id() || e.CourierId != *o.CourierId() {
+		t.Error("wrong delivery failed event")
+	}
+
+	_, err := o.ConfirmDelivery(o.ConfirmationCode(), 2, newValidLocation(), testIds, testClock)
+	if err == nil {
+		t.Error("failed delivery must not be confirmed")
+	}
+
+	err = o.Complete(testIds, testClock)
+	if err == nil {
+		t.Error("failed delivery must not be completed")
+	}
+}
+
+func TestOrder_FailDelivery(t *testing.T) {
+	depot, _ := kernel.NewLocation(9, 9)
+	o, _ := NewOrder(uuid.New(), newValidLocation(), 10, 0, PriorityStandard, testIds, testClock)
+
+	err := o.FailDelivery("customer not home", depot, false, testIds, testClock)
+	if err == nil {
+		t.Error("order w/o courier must not fail delivery")
+	}
 
+	_ = o.AssignCourier(uuid.New(), testIds, testClock)
+	o.ClearDomainEvents()
+
+	err = o.FailDelivery("customer not home", kernel.Location{}, false, testIds, testClock)
+	if err == nil {
+		t.Error("empty return location")
+	}
+
+	err = o.FailDelivery("customer not home", depot, false, testIds, testClock)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if o.Status() != StatusDeliveryFailed || !o.CurrentTarget().Equals(depot) || !o.Status().IsInProgress() {
+		t.Error("courier must carry the parcel back to depot")
+	}
+
+	if len(o.GetDomainEvents()) != 2 {
+		t.Fatal("expected delivery failed and updated events")
+	}
+
+	if e, ok := o.GetDomainEvents()[0].(*DeliveryFailedDomainEvent); !ok || e.Reason != "customer not home" {
+		t.Error("wrong delivery failed event")
+	}
+
+	err = o.Return(testIds, testClock)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if o.Status() != StatusReturned || o.Status().IsInProgress() {
+		t.Error("status != returned")
+	}
+
+	err = o.Return(testIds, testClock)
+	if err == nil {
+		t.Error("already returned")
+	}
+
+	err = o.Complete(testIds, testClock)
+	if err == nil {
+		t.Error("returned order must not be completed")
+	}
+}
+
+func TestOrder_FailDeliveryWithRetry(t *testing.T) {
+	depot, _ := kernel.NewLocation(9, 9)
+	o, _ := NewOrder(uuid.New(), newValidLocation(), 10, 0, PriorityStandard, testIds, testClock)
+	_ = o.AssignCourier(uuid.New(), testIds, testClock)
+
+	err := o.FailDelivery("customer not home", depot, true, testIds, testClock)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = o.Return(testIds, testClock)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// заказ снова ждет курьера, товар теперь забирается со склада
+	if o.Status() != StatusCreated || o.CourierId() != nil || !o.PickupLocation().Equals(depot) {
+		t.Error("order must wait for a new courier")
+	}
+
+	_ = o.AssignCourier(uuid.New(), testIds, testClock)
+	if o.Status() != StatusAssignedToPickup || !o.CurrentTarget().Equals(depot) {
+		t.Error("new courier must pick up the parcel at depot")
+	}
+}
+
+func TestOrder_FailDispatch(t *testing.T) {
+	o, _ := NewOrder(uuid.New(), newValidLocation(), 10, 0, PriorityStandard, testIds, testClock)
+	o.ClearDomainEvents()
+
+	err := o.FailDispatch(0, "no matching courier", testIds, testClock)
+	if err == nil {
+		t.Error("invalid maxAttempts")
+	}
+
+	err = o.FailDispatch(2, "no matching courier", testIds, testClock)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if o.Status() != StatusCreated || o.DispatchAttempts() != 1 || len(o.GetDomainEvents()) != 0 {
+		t.Error("order must stay in created status")
+	}
+
+	err = o.FailDispatch(2, "no matching courier", testIds, testClock)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if o.Status() != StatusUndeliverable || o.DispatchAttempts() != 2 {
+		t.Error("status != undeliverable")
+	}
+
+	if len(o.GetDomainEvents()) != 2 {
+		t.Fatal("expected rejected and updated events")
+	}
+
+	if e, ok := o.GetDomainEvents()[0].(*RejectedDomainEvent); !ok || e.OrderId != o.Id() || e.Reason != "no matching courier" {
+		t.Error("wrong rejected event")
+	}
+
+	err = o.FailDispatch(2, "no matching courier", testIds, testClock)
+	if err == nil {
+		t.Error("already undeliverable")
+	}
+
+	err = o.AssignCourier(uuid.New(), testIds, testClock)
+	if err == nil {
+		t.Error("undeliverable order must not be assigned")
+	}
+
+	err = o.Complete(testIds, testClock)
+	if err == nil {
+		t.Error("undeliverable order must not be completed")
+	}
+}
+
+func TestOrder_RequireHandling(t *testing.T) {
+	o, _ := NewOrder(uuid.New(), newValidLocation(), 10, 0, PriorityStandard, testIds, testClock)
+	if o.Handling() != HandlingStandard {
+		t.Fatal("new order must have standard handling")
+	}
+
+	if err := o.RequireHandling("unknown"); err == nil {
+		t.Fatal("unknown handling must be rejected")
+	}
+
+	if err := o.RequireHandling(HandlingFragile); err != nil || o.Handling() != HandlingFragile {
+		t.Fatal("handling must be set on created order")
+	}
+
+	_ = o.AssignCourier(uuid.New(), testIds, testClock)
+	if err := o.RequireHandling(HandlingHot); err == nil {
+		t.Fatal("handling must not change after assignment")
+	}
+}
+
+func TestOrder_AddRestriction(t *testing.T) {
+	o, _ := NewOrder(uuid.New(), newValidLocation(), 10, 0, PriorityStandard, testIds, testClock)
+
+	if err := o.AddRestriction("unknown"); err == nil {
+		t.Fatal("unknown restriction must be rejected")
+	}
+
+	_ = o.AddRestriction(RestrictionAlcohol)
+	_ = o.AddRestriction(RestrictionAlcohol)
+	if len(o.Restrictions()) != 1 || o.Restrictions()[0] != RestrictionAlcohol {
+		t.Fatal("restriction must be added once")
+	}
+
+	_ = o.AssignCourier(uuid.New(), testIds, testClock)
+	if err := o.AddRestriction(RestrictionMedicine); err == nil {
+		t.Fatal("restrictions must not change after assignment")
+	}
+}
+
+func TestOrder_Split(t *testing.T) {
+	pickup, _ := kernel.NewLocation(2, 2)
+	o, _ := NewPickupOrder(uuid.New(), pickup, newValidLocation(), 12, 0, PriorityExpress, testIds, testClock)
+	_ = o.RequireHandling(HandlingFrozen)
+	_ = o.AddRestriction(RestrictionAlcohol)
+	_ = o.RequireConfirmation(testRnd)
+	o.ClearDomainEvents()
+
+	if _, err := o.Split([][]Item{newItems(t, 12)}, testIds, testClock); err == nil {
+		t.Fatal("single shipment must be rejected")
+	}
+
+	shipments, err := o.Split([][]Item{newItems(t, 5, 2), newItems(t, 5)}, testIds, testClock)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if o.Status() != StatusSplit || len(shipments) != 2 {
+		t.Fatal("order must be split into two shipments")
+	}
+
+	for _, s := range shipments {
+		if !s.IsShipment() || *s.ParentId() != o.Id() || s.Status() != StatusCreated {
+			t.Error("shipment must be created and reference parent order")
+		}
+
+		if !s.PickupLocation().Equals(pickup) || s.Priority() != PriorityExpress || s.Handling() != HandlingFrozen ||
+			len(s.Restrictions()) != 1 || s.ConfirmationCode() != o.ConfirmationCode() {
+			t.Error("shipment must inherit order requirements")
+		}
+
+		if len(s.GetDomainEvents()) != 0 {
+			t.Error("shipment must not raise created event")
+		}
+	}
+
+	if shipments[0].Volume() != 7 || shipments[1].Volume() != 5 {
+		t.Error("shipment volume must be sum of its items")
+	}
+
+	if _, err := shipments[0].Split([][]Item{newItems(t, 5), newItems(t, 2)}, testIds, testClock); err == nil {
+		t.Error("shipment must not be split")
+	}
+
+	if err := o.AssignCourier(uuid.New(), testIds, testClock); err == nil {
+		t.Error("split order must not be assigned")
+	}
+}
+
+func TestOrder_Split_ByHandling(t *testing.T) {
+	o, _ := NewOrder(uuid.New(), newValidLocation(), 6, 0, PriorityStandard, testIds, testClock)
+	o.ClearDomainEvents()
+
+	hot, _ := NewItem(2, 0, HandlingHot)
+	frozen, _ := NewItem(3, 0, HandlingFrozen)
+	standard, _ := NewItem(1, 0, HandlingStandard)
+
+	if _, err := o.Split([][]Item{{hot, frozen}, {standard}}, testIds, testClock); err == nil {
+		t.Fatal("shipment with incompatible items must be rejected")
+	}
+
+	shipments, err := o.Split([][]Item{{hot, standard}, {frozen}}, testIds, testClock)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if shipments[0].Handling() != HandlingHot || shipments[1].Handling() != HandlingFrozen {
+		t.Error("shipment must take handling of its items")
+	}
+}
+
+func TestOrder_Reject(t *testing.T) {
+	o, _ := NewOrder(uuid.New(), newValidLocation(), 6, 0, PriorityStandard, testIds, testClock)
+	o.ClearDomainEvents()
+
+	if err := o.Reject("incompatible handling requirements", testIds, testClock); err != nil {
+		t.Fatal(err)
+	}
+
+	if o.Status() != StatusUndeliverable {
+		t.Fatal("rejected order must be undeliverable")
+	}
+
+	if e, ok := o.GetDomainEvents()[0].(*RejectedDomainEvent); !ok || e.OrderId != o.Id() {
+		t.Error("expected rejected event")
+	}
+
+	if err := o.Reject("again", testIds, testClock); err == nil {
+		t.Error("undeliverable order must not be rejected again")
+	}
+}
+
+func TestOrder_FinishShipments(t *testing.T) {
+	o, _ := NewOrder(uuid.New(), newValidLocation(), 12, 0, PriorityStandard, testIds, testClock)
+	o.ClearDomainEvents()
+	shipments, _ := o.Split([][]Item{newItems(t, 7), newItems(t, 5)}, testIds, testClock)
+
+	courierId := uuid.New()
+	for _, s := range shipments {
+		_ = s.AssignCourier(courierId, testIds, testClock)
+	}
+
+	_ = shipments[0].Complete(testIds, testClock)
+	for _, e := range shipments[0].GetDomainEvents() {
+		if _, ok := e.(*CompletedDomainEvent); ok {
+			t.Error("shipment must not raise completed event")
+		}
+	}
+
+	finished, err := o.FinishShipments(shipments, testIds, testClock)
+	if err != nil || finished || o.Status() != StatusSplit {
+		t.Fatal("order must wait for all shipments")
+	}
+
+	_ = shipments[1].Complete(testIds, testClock)
+	finished, err = o.FinishShipments(shipments, testIds, testClock)
+	if err != nil || !finished || o.Status() != StatusCompleted {
+		t.Fatal("order must be completed after all shipments")
+	}
+
+	if len(o.GetDomainEvents()) != 3 {
+		t.Fatal("expected split, completed and updated events")
+	}
+
+	if e, ok := o.GetDomainEvents()[1].(*CompletedDomainEvent); !ok || e.OrderId != o.Id() || e.CourierId != courierId {
+		t.Error("wrong completed event")
+	}
+
+	if _, err := o.FinishShipments(shipments, testIds, testClock); err == nil {
+		t.Error("already completed")
+	}
+}
+
+func TestOrder_FinishShipments_MixedOutcomes(t *testing.T) {
+	returnTo, _ := kernel.NewLocation(5, 5)
+
+	tests := map[string]struct {
+		outcomes []Status
+		expected Status
+	}{
+		"completed and returned":       {[]Status{StatusCompleted, StatusReturned}, StatusReturned},
+		"returned and undeliverable":   {[]Status{StatusReturned, StatusUndeliverable}, StatusUndeliverable},
+		"completed and undeliverable":  {[]Status{StatusCompleted, StatusUndeliverable}, StatusUndeliverable},
+		"all returned":                 {[]Status{StatusReturned, StatusReturned}, StatusReturned},
+		"completed, returned, waiting": {[]Status{StatusCompleted, StatusReturned, StatusCreated}, StatusSplit},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			o, _ := NewOrder(uuid.New(), newValidLocation(), 15, 0, PriorityStandard, testIds, testClock)
+			groups := make([][]Item, 0, len(test.outcomes))
+			for range test.outcomes {
+				groups = append(groups, newItems(t, 5))
+			}
+
+			shipments, _ := o.Split(groups, testIds, testClock)
+			o.ClearDomainEvents()
+
+			for i, outcome := range test.outcomes {
+				s := shipments[i]
+				switch outcome {
+				case StatusCompleted:
+					_ = s.AssignCourier(uuid.New(), testIds, testClock)
+					_ = s.Complete(testIds, testClock)
+				case StatusReturned:
+					_ = s.AssignCourier(uuid.New(), testIds, testClock)
+					_ = s.FailDelivery("nobody home", returnTo, false, testIds, testClock)
+					_ = s.Return(testIds, testClock)
+				case StatusUndeliverable:
+					_ = s.FailDispatch(1, "no couriers", testIds, testClock)
+				}
+
+				if s.Status() != outcome {
+					t.Fatal("wrong test setup")
+				}
+			}
+
+			finished, err := o.FinishShipments(shipments, testIds, testClock)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if finished != (test.expected != StatusSplit) || o.Status() != test.expected {
+				t.Fatalf("expected %s, got %s", test.expected, o.Status())
+			}
+
+			// о недоставке получатель уже узнал из событий отправлений
+			for _, e := range o.GetDomainEvents() {
+				if _, ok := e.(*CompletedDomainEvent); ok {
+					t.Error("partially delivered order must not raise completed event")
+				}
+			}
+
+			if finished && !o.Status().IsFinished() {
+				t.Error("closed order must be finished")
+			}
+		})
+	}
+}
+
+func TestOrder_Assign(t *testing.T) {
+	o, _ := NewOrder(uuid.New(), newValidLocation(), 10, 0, PriorityStandard, testIds, testClock)
+	o.ClearDomainEvents()
+	courierId := uuid.New()
+
+	if err := o.Assign(courierId, "", testIds, testClock); err == nil {
+		t.Fatal("operator is required")
+	}
+
+	if err := o.Assign(courierId, "operator", testIds, testClock); err != nil {
+		t.Fatal(err)
+	}
+
+	if o.Status() != StatusAssigned || *o.CourierId() != courierId || o.AssignedBy() != "operator" {
+		t.Error("order must be assigned by operator")
+	}
+
+	if len(o.GetDomainEvents()) != 2 {
+		t.Fatal("expected updated and assigned events")
+	}
+
+	if e, ok := o.GetDomainEvents()[1].(*AssignedDomainEvent); !ok || e.CourierId != courierId || e.Operator != "operator" {
+		t.Error("wrong assigned event")
+	}
+
+	if err := o.Assign(uuid.New(), "operator", testIds, testClock); !errors.Is(err, ErrAlreadyAssigned) {
+		t.Error("assigned order must not be assigned again")
+	}
+}
+
+func TestOrder_Unassign(t *testing.T) {
+	o, _ := NewOrder(uuid.New(), newValidLocation(), 10, 0, PriorityStandard, testIds, testClock)
+
+	if err := o.Unassign("operator", testIds, testClock); !errors.Is(err, ErrNotReassignable) {
+		t.Fatal("created order must not be unassigned")
+	}
+
+	courierId := uuid.New()
+	_ = o.Assign(courierId, "operator", testIds, testClock)
+	o.ClearDomainEvents()
+
+	if err := o.Unassign("operator", testIds, testClock); err != nil {
+		t.Fatal(err)
+	}
+
+	if o.Status() != StatusCreated || o.CourierId() != nil || o.AssignedBy() != "" {
+		t.Error("order must wait for dispatch again")
+	}
+
+	if e, ok := o.GetDomainEvents()[0].(*UnassignedDomainEvent); !ok || e.CourierId != courierId {
+		t.Error("wrong unassigned event")
+	}
+
+	pickup, _ := kernel.NewLocation(2, 2)
+	o, _ = NewPickupOrder(uuid.New(), pickup, newValidLocation(), 10, 0, PriorityStandard, testIds, testClock)
+	_ = o.AssignCourier(uuid.New(), testIds, testClock)
+	_ = o.PickUp(testIds, testClock)
+
+	if err := o.Unassign("operator", testIds, testClock); !errors.Is(err, ErrNotReassignable) {
+		t.Error("picked up order must not be unassigned")
+	}
+}
+
+func TestOrder_Reassign(t *testing.T) {
+	pickup, _ := kernel.NewLocation(2, 2)
+	o, _ := NewPickupOrder(uuid.New(), pickup, newValidLocation(), 10, 0, PriorityStandard, testIds, testClock)
+	from, to := uuid.New(), uuid.New()
+	_ = o.AssignCourier(from, testIds, testClock)
+	o.ClearDomainEvents()
+
+	if err := o.Reassign(from, "operator", testIds, testClock); !errors.Is(err, ErrAlreadyAssigned) {
+		t.Fatal("order must not be reassigned to the same courier")
+	}
+
+	if err := o.Reassign(to, "operator", testIds, testClock); err != nil {
+		t.Fatal(err)
+	}
+
+	if o.Status() != StatusAssignedToPickup || *o.CourierId() != to || o.AssignedBy() != "operator" {
+		t.Error("order must move to another courier keeping its status")
+	}
+
+	if e, ok := o.GetDomainEvents()[0].(*ReassignedDomainEvent); !ok || e.FromCourierId != from || e.ToCourierId != to {
+		t.Error("wrong reassigned event")
+	}
+}
+
+func TestOrder_RaisesUpdatedEvents(t *testing.T) {
+	o, _ := NewPickupOrder(uuid.New(), newValidLocation(), newValidLocation(), 10, 0, PriorityStandard, testIds, testClock)
+	courierId := uuid.New()
+	_ = o.AssignCourier(courierId, testIds, testClock)
+	_ = o.PickUp(testIds, testClock)
+	_ = o.Complete(testIds, testClock)
+
+	var statuses []string
+	for _, e := range o.GetDomainEvents() {
+		if updated, ok := e.(*UpdatedDomainEvent); ok {
+			statuses = append(statuses, updated.Status)
+			if updated.Status != string(StatusCreated) && updated.CourierId != courierId {
+				t.Errorf("%s: wrong courier", updated.Status)
+			}
+		}
+	}
+
+	want := []string{"created", "assigned_to_pickup", "picked_up", "completed"}
+	if !slices.Equal(statuses, want) {
+		t.Errorf("statuses = %v, want %v", statuses, want)
+	}
 }
@@ -0,0 +1,84 @@
+package order
+
+import (
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/pkg/ddd"
+	"delivery/internal/pkg/errs"
+	"github.com/google/uuid"
+	"reflect"
+	"time"
+)
+
+var _ ddd.DomainEvent = &UpdatedDomainEvent{}
+
+// UpdatedDomainEvent - состояние заказа после смены статуса или курьера. Нужно наблюдателям
+// (карта диспетчера), получателю и внешним системам о таких изменениях не сообщается
+type UpdatedDomainEvent struct {
+	Id         uuid.UUID
+	Name       string
+	OccurredAt time.Time
+
+	OrderId uuid.UUID
+	// CourierId - курьер заказа, uuid.Nil - курьер не назначен
+	CourierId uuid.UUID
+	Status    string
+	LocationX int
+	LocationY int
+
+	isValid bool
+}
+
+func NewUpdatedDomainEvent(orderId uuid.UUID, courierId *uuid.UUID, status Status, location kernel.Location,
+	ids kernel.IDGenerator, clock kernel.Clock) (ddd.DomainEvent, error) {
+
+	event := &UpdatedDomainEvent{}
+	if orderId == uuid.Nil {
+		return event, errs.NewValueIsRequiredError("orderId")
+	}
+
+	if status == "" {
+		return event, errs.NewValueIsRequiredError("status")
+	}
+
+	if location.IsEmpty() {
+		return event, errs.NewValueIsRequiredError("location")
+	}
+
+	if ids == nil {
+		return event, errs.NewValueIsRequiredError("ids")
+	}
+
+	if clock == nil {
+		return event, errs.NewValueIsRequiredError("clock")
+	}
+
+	event.Id = ids.NewId()
+	event.Name = reflect.TypeOf(event).Elem().Name()
+	event.OccurredAt = clock.Now().UTC()
+	event.OrderId = orderId
+	if courierId != nil {
+		event.CourierId = *courierId
+	}
+	event.Status = string(status)
+	event.LocationX = location.X()
+	event.LocationY = location.Y()
+	event.isValid = true
+
+	return event, nil
+}
+
+func (e *UpdatedDomainEvent) GetID() uuid.UUID {
+	return e.Id
+}
+
+func (e *UpdatedDomainEvent) GetName() string {
+	return e.Name
+}
+
+func (e *UpdatedDomainEvent) GetOccurredAt() time.Time {
+	return e.OccurredAt
+}
+
+func (e *UpdatedDomainEvent) IsValid() bool {
+	return e.isValid
+}
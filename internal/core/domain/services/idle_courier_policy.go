@@ -0,0 +1,64 @@
+package services
+
+import (
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/pkg/errs"
+)
+
+// IdleCourierPolicy определяет, куда направляется курьер без заказов
+type IdleCourierPolicy interface {
+	// Destination возвращает точку, куда должен вернуться свободный курьер. false - курьер остается на месте
+	Destination(c *courier.Courier) (kernel.Location, bool)
+}
+
+var _ IdleCourierPolicy = &stayPolicy{}
+var _ IdleCourierPolicy = &returnToDepotPolicy{}
+var _ IdleCourierPolicy = &returnToStagingPointPolicy{}
+
+type stayPolicy struct {
+}
+
+// NewStayPolicy - свободный курьер остается там, где доставил последний заказ
+func NewStayPolicy() IdleCourierPolicy {
+	return &stayPolicy{}
+}
+
+func (p *stayPolicy) Destination(_ *courier.Courier) (kernel.Location, bool) {
+	return kernel.Location{}, false
+}
+
+type returnToDepotPolicy struct {
+	fallback kernel.Location
+}
+
+// NewReturnToDepotPolicy - свободный курьер возвращается на свой склад. Курьер без склада идет
+// в fallback, если он задан, иначе остается на месте
+func NewReturnToDepotPolicy(fallback kernel.Location) IdleCourierPolicy {
+	return &returnToDepotPolicy{fallback: fallback}
+}
+
+func (p *returnToDepotPolicy) Destination(c *courier.Courier) (kernel.Location, bool) {
+	if !c.HomeDepot().IsEmpty() {
+		return c.HomeDepot(), true
+	}
+
+	return p.fallback, !p.fallback.IsEmpty()
+}
+
+type returnToStagingPointPolicy struct {
+	point kernel.Location
+}
+
+// NewReturnToStagingPointPolicy - все свободные курьеры собираются в одной точке ожидания
+func NewReturnToStagingPointPolicy(point kernel.Location) (IdleCourierPolicy, error) {
+	if point.IsEmpty() {
+		return nil, errs.NewValueIsRequiredError("point")
+	}
+
+	return &returnToStagingPointPolicy{point: point}, nil
+}
+
+func (p *returnToStagingPointPolicy) Destination(_ *courier.Courier) (kernel.Location, bool) {
+	return p.point, true
+}
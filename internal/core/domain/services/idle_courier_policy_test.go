@@ -0,0 +1,47 @@
+package services
+
+import (
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/courier"
+	"testing"
+)
+
+func TestIdleCourierPolicy_Destination(t *testing.T) {
+	loc, _ := kernel.NewLocation(1, 1)
+	depot, _ := kernel.NewLocation(5, 5)
+	staging, _ := kernel.NewLocation(3, 3)
+
+	withDepot, _ := courier.NewCourier("with depot", 1, loc, testIds)
+	_ = withDepot.SetHomeDepot(depot)
+	withoutDepot, _ := courier.NewCourier("without depot", 1, loc, testIds)
+
+	stagingPolicy, err := NewReturnToStagingPointPolicy(staging)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = NewReturnToStagingPointPolicy(kernel.Location{}); err == nil {
+		t.Fatal("expected error for empty staging point")
+	}
+
+	tests := map[string]struct {
+		policy   IdleCourierPolicy
+		courier  *courier.Courier
+		expected kernel.Location
+	}{
+		"stay":                             {NewStayPolicy(), withDepot, kernel.Location{}},
+		"depot":                            {NewReturnToDepotPolicy(kernel.Location{}), withDepot, depot},
+		"no depot, no fallback":            {NewReturnToDepotPolicy(kernel.Location{}), withoutDepot, kernel.Location{}},
+		"no depot, fallback staging point": {NewReturnToDepotPolicy(staging), withoutDepot, staging},
+		"staging point":                    {stagingPolicy, withDepot, staging},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			destination, ok := test.policy.Destination(test.courier)
+			if ok == test.expected.IsEmpty() || !destination.Equals(test.expected) {
+				t.Fatalf("destination: %v %v, expected: %v", destination, ok, test.expected)
+			}
+		})
+	}
+}
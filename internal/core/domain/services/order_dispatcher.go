@@ -1,28 +1,55 @@
 package services
 
 import (
+	"delivery/internal/core/domain/kernel"
 	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/dispatch"
 	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
 	"errors"
 	"math"
 )
 
+var ErrNoMatchingCourier = errors.New("no matching courier")
+
 type OrderDispatcher interface {
-	Dispatch(*order.Order, []*courier.Courier) (*courier.Courier, error)
+	// Dispatch назначает заказ самому быстрому курьеру. Decision возвращается
+	// и в случае ошибки ErrNoMatchingCourier, чтобы можно было понять причины отказа.
+	Dispatch(*order.Order, []*courier.Courier) (*courier.Courier, *dispatch.Decision, error)
 }
 
 var _ OrderDispatcher = &orderDispatcher{}
 
 type orderDispatcher struct {
+	calc  kernel.DistanceCalculator
+	ids   kernel.IDGenerator
+	clock kernel.Clock
 }
 
-func NewOrderDispatcher() OrderDispatcher {
-	return &orderDispatcher{}
+func NewOrderDispatcher(calc kernel.DistanceCalculator, ids kernel.IDGenerator, clock kernel.Clock) (OrderDispatcher, error) {
+	if calc == nil {
+		return nil, errs.NewValueIsRequiredError("calc")
+	}
+
+	if ids == nil {
+		return nil, errs.NewValueIsRequiredError("ids")
+	}
+
+	if clock == nil {
+		return nil, errs.NewValueIsRequiredError("clock")
+	}
+
+	return &orderDispatcher{calc: calc, ids: ids, clock: clock}, nil
 }
 
-func (od *orderDispatcher) Dispatch(o *order.Order, couriers []*courier.Courier) (*courier.Courier, error) {
+func (od *orderDispatcher) Dispatch(o *order.Order, couriers []*courier.Courier) (*courier.Courier, *dispatch.Decision, error) {
 	if o.Status() != order.StatusCreated {
-		return nil, errors.New("invalid order status")
+		return nil, nil, errors.New("invalid order status")
+	}
+
+	decision, err := dispatch.NewDecision(o.Id(), od.ids, od.clock)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	fastestDeliveryTime := math.MaxFloat64
@@ -30,12 +57,32 @@ func (od *orderDispatcher) Dispatch(o *order.Order, couriers []*courier.Courier)
 
 	for _, c := range couriers {
 
-		if ok, _ := c.CanTakeOrder(o); !ok {
-			continue
+		rejectionReason := ""
+		missingQualification := courier.Qualification("")
+
+		deliveryTime, err := c.CalculateDeliveryTime(o, od.calc)
+		if err != nil {
+			rejectionReason = err.Error()
+		} else if missingQualification, err = c.MissingQualification(o); err != nil {
+			return nil, nil, err
+		} else if missingQualification != "" {
+			rejectionReason = "missing qualification"
+		} else if ok, err := c.CanTakeOrder(o); !ok {
+			rejectionReason = err.Error()
+		}
+
+		candidate, err := dispatch.NewCandidate(c.Id(), c.Name(), deliveryTime, rejectionReason,
+			missingQualification.String())
+		if err != nil {
+			return nil, nil, err
 		}
 
-		deliveryTime, err := c.CalculateTimeToLocation(o.Location())
+		err = decision.AddCandidate(candidate)
 		if err != nil {
+			return nil, nil, err
+		}
+
+		if !candidate.IsEligible() {
 			continue
 		}
 
@@ -46,18 +93,23 @@ func (od *orderDispatcher) Dispatch(o *order.Order, couriers []*courier.Courier)
 	}
 
 	if fastestCourier == nil {
-		return nil, errors.New("no matching courier")
+		return nil, decision, ErrNoMatchingCourier
+	}
+
+	err = fastestCourier.TakeOrder(o)
+	if err != nil {
+		return nil, decision, err
 	}
 
-	err := fastestCourier.TakeOrder(o)
+	err = o.AssignCourier(fastestCourier.Id(), od.ids, od.clock)
 	if err != nil {
-		return nil, err
+		return nil, decision, err
 	}
 
-	err = o.AssignCourier(fastestCourier.Id())
+	err = decision.ChooseCourier(fastestCourier.Id())
 	if err != nil {
-		return nil, err
+		return nil, decision, err
 	}
 
-	return fastestCourier, nil
+	return fastestCourier, decision, nil
 }
@@ -4,42 +4,54 @@ import (
 	"delivery/internal/core/domain/kernel"
 	"delivery/internal/core/domain/model/courier"
 	"delivery/internal/core/domain/model/order"
+	"errors"
 	"github.com/google/uuid"
+	"strings"
 	"testing"
+	"time"
 )
 
+var testIds = kernel.IDGeneratorFunc(uuid.New)
+var testClock = kernel.ClockFunc(func() time.Time { return time.Now().UTC() })
+
 func TestOrderDispatcher_Dispatch(t *testing.T) {
 	// create order dispatcher
-	dispatcher := NewOrderDispatcher()
+	dispatcher, _ := NewOrderDispatcher(kernel.NewGridDistanceCalculator(), testIds, testClock)
 
 	// create 3 couriers
 	loc, _ := kernel.NewLocation(1, 1)
-	alice, _ := courier.NewCourier("Alice", 1, loc)
+	alice, _ := courier.NewCourier("Alice", 1, loc, testIds)
 
 	loc, _ = kernel.NewLocation(7, 5)
-	bob, _ := courier.NewCourier("Bob", 1, loc)
+	bob, _ := courier.NewCourier("Bob", 1, loc, testIds)
 
 	loc, _ = kernel.NewLocation(3, 4)
-	mallory, _ := courier.NewCourier("Mallory", 1, loc)
+	mallory, _ := courier.NewCourier("Mallory", 1, loc, testIds)
 
 	couriers := []*courier.Courier{alice, bob, mallory}
 
 	// create order that can't be taken (large volume)
 	loc, _ = kernel.NewLocation(10, 10)
-	o, _ := order.NewOrder(uuid.New(), loc, 100)
+	o, _ := order.NewOrder(uuid.New(), loc, 100, 0, order.PriorityStandard, testIds, testClock)
 
 	// should be error
-	_, err := dispatcher.Dispatch(o, couriers)
-	if err == nil {
-		t.Error("should be error")
+	_, decision, err := dispatcher.Dispatch(o, couriers)
+	if !errors.Is(err, ErrNoMatchingCourier) {
+		t.Error("should be ErrNoMatchingCourier")
+	}
+
+	// all couriers should be recorded as rejected
+	if decision == nil || decision.CourierId() != nil || len(decision.Candidates()) != len(couriers) ||
+		len(decision.RankedCandidates()) != 0 {
+		t.Error("decision should contain rejected candidates only")
 	}
 
 	// create regular order
 	loc, _ = kernel.NewLocation(10, 10)
-	o, _ = order.NewOrder(uuid.New(), loc, 8)
+	o, _ = order.NewOrder(uuid.New(), loc, 8, 0, order.PriorityStandard, testIds, testClock)
 
 	// dispatch the order
-	courier, err := dispatcher.Dispatch(o, couriers)
+	courier, decision, err := dispatcher.Dispatch(o, couriers)
 	if err != nil {
 		t.Error(err)
 	}
@@ -49,12 +61,23 @@ func TestOrderDispatcher_Dispatch(t *testing.T) {
 		t.Fail()
 	}
 
+	// decision should explain the choice
+	if decision == nil || decision.OrderId() != o.Id() || decision.CourierId() == nil || *decision.CourierId() != bob.Id() {
+		t.Fatal("decision should record Bob as chosen courier")
+	}
+
+	ranked := decision.RankedCandidates()
+	if len(ranked) != 3 || ranked[0].CourierId() != bob.Id() || ranked[1].CourierId() != mallory.Id() ||
+		ranked[2].CourierId() != alice.Id() {
+		t.Error("candidates should be ranked by eta")
+	}
+
 	// create another order
 	loc, _ = kernel.NewLocation(10, 10)
-	o, _ = order.NewOrder(uuid.New(), loc, 8)
+	o, _ = order.NewOrder(uuid.New(), loc, 8, 0, order.PriorityStandard, testIds, testClock)
 
 	// dispatch the order
-	courier, err = dispatcher.Dispatch(o, couriers)
+	courier, _, err = dispatcher.Dispatch(o, couriers)
 	if err != nil {
 		t.Error(err)
 	}
@@ -65,8 +88,80 @@ func TestOrderDispatcher_Dispatch(t *testing.T) {
 	}
 
 	// try to dispatch the same order
-	courier, err = dispatcher.Dispatch(o, couriers)
+	courier, _, err = dispatcher.Dispatch(o, couriers)
 	if err == nil {
 		t.Error("order was already dispatched")
 	}
 }
+
+func TestOrderDispatcher_DispatchWithCityMap(t *testing.T) {
+	// river between Alice and the order, bridge is far away
+	cityMap, _ := kernel.ParseCityMap(strings.NewReader(`
+..#.......
+..#.......
+..#.......
+..#.......
+..........
+`))
+	calc, _ := kernel.NewPathDistanceCalculator(cityMap)
+	dispatcher, _ := NewOrderDispatcher(calc, testIds, testClock)
+
+	loc, _ := kernel.NewLocation(1, 1)
+	alice, _ := courier.NewCourier("Alice", 1, loc, testIds)
+
+	loc, _ = kernel.NewLocation(9, 5)
+	bob, _ := courier.NewCourier("Bob", 1, loc, testIds)
+
+	loc, _ = kernel.NewLocation(4, 1)
+	o, _ := order.NewOrder(uuid.New(), loc, 8, 0, order.PriorityStandard, testIds, testClock)
+
+	// Alice is 3 cells away in a straight line, but 11 by route; Bob is 9 by route
+	courier, decision, err := dispatcher.Dispatch(o, []*courier.Courier{alice, bob})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if courier.Id() != bob.Id() {
+		t.Error("Bob had to take this order")
+	}
+
+	ranked := decision.RankedCandidates()
+	if len(ranked) != 2 || ranked[0].Eta() != 9 || ranked[1].Eta() != 11 {
+		t.Error("eta should follow the route")
+	}
+}
+
+func TestOrderDispatcher_DispatchRestrictedOrder(t *testing.T) {
+	dispatcher, _ := NewOrderDispatcher(kernel.NewGridDistanceCalculator(), testIds, testClock)
+
+	// Alice is closer, but only Bob may hand over alcohol
+	loc, _ := kernel.NewLocation(5, 5)
+	alice, _ := courier.NewCourier("Alice", 1, loc, testIds)
+
+	loc, _ = kernel.NewLocation(1, 1)
+	bob, _ := courier.NewCourier("Bob", 1, loc, testIds)
+	_ = bob.GrantQualification(courier.QualificationAlcohol)
+
+	loc, _ = kernel.NewLocation(6, 6)
+	o, _ := order.NewOrder(uuid.New(), loc, 5, 0, order.PriorityStandard, testIds, testClock)
+	_ = o.AddRestriction(order.RestrictionAlcohol)
+
+	chosen, decision, err := dispatcher.Dispatch(o, []*courier.Courier{alice, bob})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if chosen.Id() != bob.Id() {
+		t.Error("Bob had to take this order")
+	}
+
+	for _, c := range decision.Candidates() {
+		if c.CourierId() == alice.Id() && (c.IsEligible() || c.MissingQualification() != "alcohol") {
+			t.Error("decision should record the qualification Alice is missing")
+		}
+
+		if c.CourierId() == bob.Id() && c.MissingQualification() != "" {
+			t.Error("Bob is qualified")
+		}
+	}
+}
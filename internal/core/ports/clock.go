@@ -0,0 +1,6 @@
+package ports
+
+import "delivery/internal/core/domain/kernel"
+
+// Clock - источник текущего времени. Позволяет управлять временем в тестах и симуляции
+type Clock = kernel.Clock
@@ -9,5 +9,7 @@ import (
 type CourierRepository interface {
 	Get(ctx context.Context, id uuid.UUID) (*courier.Courier, error)
 	GetAllFree(ctx context.Context) ([]*courier.Courier, error)
+	// GetAll возвращает всех курьеров, включая занятых
+	GetAll(ctx context.Context) ([]*courier.Courier, error)
 	Save(ctx context.Context, couriers ...*courier.Courier) error
 }
@@ -0,0 +1,13 @@
+package ports
+
+import (
+	"context"
+	"delivery/internal/core/domain/model/dispatch"
+	"github.com/google/uuid"
+)
+
+type DispatchDecisionRepository interface {
+	GetAllByOrderId(ctx context.Context, orderId uuid.UUID) ([]*dispatch.Decision, error)
+	GetLastByOrderId(ctx context.Context, orderId uuid.UUID) (*dispatch.Decision, error)
+	Save(ctx context.Context, decisions ...*dispatch.Decision) error
+}
@@ -6,6 +6,7 @@ import (
 )
 
 type GeoClient interface {
-	GetGeolocation(ctx context.Context, address string) (kernel.Location, error)
+	// GetGeolocation возвращает клетку адреса и его точные координаты. Пустые координаты - сервис знает только клетку
+	GetGeolocation(ctx context.Context, address string) (kernel.Location, kernel.GeoLocation, error)
 	Close() error
 }
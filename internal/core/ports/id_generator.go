@@ -0,0 +1,6 @@
+package ports
+
+import "delivery/internal/core/domain/kernel"
+
+// IDGenerator - источник идентификаторов. С детерминированным генератором прогоны воспроизводимы
+type IDGenerator = kernel.IDGenerator
@@ -0,0 +1,48 @@
+package ports
+
+import (
+	"context"
+	"delivery/internal/core/domain/kernel"
+	"github.com/google/uuid"
+	"time"
+)
+
+type LiveUpdateType string
+
+const (
+	LiveUpdateCourierMoved LiveUpdateType = "courier.moved"
+	LiveUpdateOrderUpdated LiveUpdateType = "order.updated"
+)
+
+// LiveUpdate - изменение на карте диспетчера: новое положение курьера или новое состояние заказа
+type LiveUpdate struct {
+	Type LiveUpdateType
+	// CourierId - курьер, который переместился, или курьер заказа. uuid.Nil - заказ без курьера
+	CourierId uuid.UUID
+	// OrderId - uuid.Nil для перемещения курьера
+	OrderId uuid.UUID
+	// Status - статус заказа, пустой для перемещения курьера
+	Status string
+	// Location - положение курьера или точка доставки заказа
+	Location   kernel.Location
+	OccurredAt time.Time
+}
+
+// LiveUpdatesFilter выбирает изменения для подписчика. Изменение подходит, если совпадает хотя бы
+// с одним условием. Пустой фильтр пропускает все изменения
+type LiveUpdatesFilter struct {
+	CourierIds []uuid.UUID
+	OrderIds   []uuid.UUID
+	// AreaFrom, AreaTo - углы прямоугольника на карте, включительно. Пустые - район не задан
+	AreaFrom kernel.Location
+	AreaTo   kernel.Location
+}
+
+type LiveUpdatesPublisher interface {
+	Publish(ctx context.Context, update LiveUpdate) error
+}
+
+type LiveUpdatesSubscriber interface {
+	// Subscribe возвращает канал изменений и функцию отписки. Канал закрывается после отписки
+	Subscribe(filter LiveUpdatesFilter) (<-chan LiveUpdate, func())
+}
@@ -9,6 +9,13 @@ import (
 type OrderRepository interface {
 	Get(ctx context.Context, id uuid.UUID) (*order.Order, error)
 	GetFirstInCreatedStatus(ctx context.Context) (*order.Order, error)
+	// GetAllInCreatedStatus возвращает первые limit новых заказов в порядке очереди на назначение
+	GetAllInCreatedStatus(ctx context.Context, limit int) ([]*order.Order, error)
+	// GetAllInAssignedStatus возвращает заказы в работе у курьеров, включая ожидающие забора и забранные
 	GetAllInAssignedStatus(ctx context.Context) ([]*order.Order, error)
+	// GetActiveByCourier возвращает заказы в работе у курьера courierId
+	GetActiveByCourier(ctx context.Context, courierId uuid.UUID) ([]*order.Order, error)
+	// GetShipments возвращает отправления, на которые разделен заказ
+	GetShipments(ctx context.Context, parentId uuid.UUID) ([]*order.Order, error)
 	Save(ctx context.Context, orders ...*order.Order) error
 }
@@ -0,0 +1,6 @@
+package ports
+
+import "delivery/internal/core/domain/kernel"
+
+// RandomSource - источник случайных чисел. С фиксированным seed прогоны воспроизводимы
+type RandomSource = kernel.RandomSource
@@ -0,0 +1,20 @@
+package ports
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"time"
+)
+
+var (
+	ErrTrackingTokenInvalid = errors.New("tracking token is invalid")
+	ErrTrackingTokenExpired = errors.New("tracking token is expired")
+)
+
+// TrackingTokens выпускает и проверяет токены ссылок, по которым получатель отслеживает свой заказ.
+// Токен подписан и дает доступ только к отслеживанию одного заказа до истечения срока действия
+type TrackingTokens interface {
+	Issue(orderId uuid.UUID, issuedAt time.Time) (string, error)
+	// Verify возвращает заказ токена или ErrTrackingTokenInvalid, ErrTrackingTokenExpired
+	Verify(token string, now time.Time) (uuid.UUID, error)
+}
@@ -7,6 +7,7 @@ import (
 type UnitOfWorkComponents interface {
 	OrderRepository() OrderRepository
 	CourierRepository() CourierRepository
+	DispatchDecisionRepository() DispatchDecisionRepository
 }
 
 type UnitOfWorkDoFunc = func(ctx context.Context, uowc UnitOfWorkComponents) error
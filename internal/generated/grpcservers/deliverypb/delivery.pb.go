@@ -0,0 +1,628 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.0
+// source: api/proto/delivery.proto
+
+package deliverypb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderRequest) Reset() {
+	*x = GetOrderRequest{}
+	mi := &file_api_proto_delivery_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderRequest) ProtoMessage() {}
+
+func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_delivery_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_delivery_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type ListCouriersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCouriersRequest) Reset() {
+	*x = ListCouriersRequest{}
+	mi := &file_api_proto_delivery_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCouriersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCouriersRequest) ProtoMessage() {}
+
+func (x *ListCouriersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_delivery_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCouriersRequest.ProtoReflect.Descriptor instead.
+func (*ListCouriersRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_delivery_proto_rawDescGZIP(), []int{1}
+}
+
+type ListCouriersReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Couriers      []*Courier             `protobuf:"bytes,1,rep,name=couriers,proto3" json:"couriers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCouriersReply) Reset() {
+	*x = ListCouriersReply{}
+	mi := &file_api_proto_delivery_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCouriersReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCouriersReply) ProtoMessage() {}
+
+func (x *ListCouriersReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_delivery_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCouriersReply.ProtoReflect.Descriptor instead.
+func (*ListCouriersReply) Descriptor() ([]byte, []int) {
+	return file_api_proto_delivery_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListCouriersReply) GetCouriers() []*Courier {
+	if x != nil {
+		return x.Couriers
+	}
+	return nil
+}
+
+type GetOrderEtaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderEtaRequest) Reset() {
+	*x = GetOrderEtaRequest{}
+	mi := &file_api_proto_delivery_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderEtaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderEtaRequest) ProtoMessage() {}
+
+func (x *GetOrderEtaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_delivery_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderEtaRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderEtaRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_delivery_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetOrderEtaRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type GetOrderEtaReply struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	OrderId   string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CourierId string                 `protobuf:"bytes,2,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	// Сколько секунд осталось до прибытия курьера к получателю
+	EtaSeconds    float64 `protobuf:"fixed64,3,opt,name=eta_seconds,json=etaSeconds,proto3" json:"eta_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderEtaReply) Reset() {
+	*x = GetOrderEtaReply{}
+	mi := &file_api_proto_delivery_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderEtaReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderEtaReply) ProtoMessage() {}
+
+func (x *GetOrderEtaReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_delivery_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderEtaReply.ProtoReflect.Descriptor instead.
+func (*GetOrderEtaReply) Descriptor() ([]byte, []int) {
+	return file_api_proto_delivery_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetOrderEtaReply) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *GetOrderEtaReply) GetCourierId() string {
+	if x != nil {
+		return x.CourierId
+	}
+	return ""
+}
+
+func (x *GetOrderEtaReply) GetEtaSeconds() float64 {
+	if x != nil {
+		return x.EtaSeconds
+	}
+	return 0
+}
+
+type WatchOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchOrderRequest) Reset() {
+	*x = WatchOrderRequest{}
+	mi := &file_api_proto_delivery_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchOrderRequest) ProtoMessage() {}
+
+func (x *WatchOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_delivery_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchOrderRequest.ProtoReflect.Descriptor instead.
+func (*WatchOrderRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_delivery_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *WatchOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+// Координаты на сетке сервиса
+type Location struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             int32                  `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int32                  `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Location) Reset() {
+	*x = Location{}
+	mi := &file_api_proto_delivery_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Location) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Location) ProtoMessage() {}
+
+func (x *Location) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_delivery_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
+	return file_api_proto_delivery_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Location) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *Location) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+type Order struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Пустой - курьер не назначен
+	CourierId string    `protobuf:"bytes,2,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	Status    string    `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Priority  string    `protobuf:"bytes,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	Location  *Location `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	// Пустая - товар уже у курьера
+	PickupLocation *Location              `protobuf:"bytes,6,opt,name=pickup_location,json=pickupLocation,proto3" json:"pickup_location,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Пустой - обычный заказ, иначе заказ, из которого выделено отправление
+	ParentId      string `protobuf:"bytes,8,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Order) Reset() {
+	*x = Order{}
+	mi := &file_api_proto_delivery_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order) ProtoMessage() {}
+
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_delivery_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_api_proto_delivery_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Order) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Order) GetCourierId() string {
+	if x != nil {
+		return x.CourierId
+	}
+	return ""
+}
+
+func (x *Order) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Order) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+func (x *Order) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *Order) GetPickupLocation() *Location {
+	if x != nil {
+		return x.PickupLocation
+	}
+	return nil
+}
+
+func (x *Order) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Order) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+type Courier struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Location      *Location              `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Courier) Reset() {
+	*x = Courier{}
+	mi := &file_api_proto_delivery_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Courier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Courier) ProtoMessage() {}
+
+func (x *Courier) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_delivery_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Courier.ProtoReflect.Descriptor instead.
+func (*Courier) Descriptor() ([]byte, []int) {
+	return file_api_proto_delivery_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Courier) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Courier) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Courier) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+var File_api_proto_delivery_proto protoreflect.FileDescriptor
+
+const file_api_proto_delivery_proto_rawDesc = "" +
+	"\n" +
+	"\x18api/proto/delivery.proto\x12\bdelivery\x1a\x1fgoogle/protobuf/timestamp.proto\",\n" +
+	"\x0fGetOrderRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\"\x15\n" +
+	"\x13ListCouriersRequest\"B\n" +
+	"\x11ListCouriersReply\x12-\n" +
+	"\bcouriers\x18\x01 \x03(\v2\x11.delivery.CourierR\bcouriers\"/\n" +
+	"\x12GetOrderEtaRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\"m\n" +
+	"\x10GetOrderEtaReply\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x1d\n" +
+	"\n" +
+	"courier_id\x18\x02 \x01(\tR\tcourierId\x12\x1f\n" +
+	"\veta_seconds\x18\x03 \x01(\x01R\n" +
+	"etaSeconds\".\n" +
+	"\x11WatchOrderRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\"&\n" +
+	"\bLocation\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x05R\x01y\"\xaf\x02\n" +
+	"\x05Order\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"courier_id\x18\x02 \x01(\tR\tcourierId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1a\n" +
+	"\bpriority\x18\x04 \x01(\tR\bpriority\x12.\n" +
+	"\blocation\x18\x05 \x01(\v2\x12.delivery.LocationR\blocation\x12;\n" +
+	"\x0fpickup_location\x18\x06 \x01(\v2\x12.delivery.LocationR\x0epickupLocation\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1b\n" +
+	"\tparent_id\x18\b \x01(\tR\bparentId\"]\n" +
+	"\aCourier\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12.\n" +
+	"\blocation\x18\x03 \x01(\v2\x12.delivery.LocationR\blocation2\x95\x02\n" +
+	"\bDelivery\x126\n" +
+	"\bGetOrder\x12\x19.delivery.GetOrderRequest\x1a\x0f.delivery.Order\x12J\n" +
+	"\fListCouriers\x12\x1d.delivery.ListCouriersRequest\x1a\x1b.delivery.ListCouriersReply\x12G\n" +
+	"\vGetOrderEta\x12\x1c.delivery.GetOrderEtaRequest\x1a\x1a.delivery.GetOrderEtaReply\x12<\n" +
+	"\n" +
+	"WatchOrder\x12\x1b.delivery.WatchOrderRequest\x1a\x0f.delivery.Order0\x01BL\n" +
+	"\x10servers.deliveryB\rDeliveryProtoZ\x16grpcservers/deliverypb\xaa\x02\x10Servers.Deliveryb\x06proto3"
+
+var (
+	file_api_proto_delivery_proto_rawDescOnce sync.Once
+	file_api_proto_delivery_proto_rawDescData []byte
+)
+
+func file_api_proto_delivery_proto_rawDescGZIP() []byte {
+	file_api_proto_delivery_proto_rawDescOnce.Do(func() {
+		file_api_proto_delivery_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_proto_delivery_proto_rawDesc), len(file_api_proto_delivery_proto_rawDesc)))
+	})
+	return file_api_proto_delivery_proto_rawDescData
+}
+
+var file_api_proto_delivery_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_api_proto_delivery_proto_goTypes = []any{
+	(*GetOrderRequest)(nil),       // 0: delivery.GetOrderRequest
+	(*ListCouriersRequest)(nil),   // 1: delivery.ListCouriersRequest
+	(*ListCouriersReply)(nil),     // 2: delivery.ListCouriersReply
+	(*GetOrderEtaRequest)(nil),    // 3: delivery.GetOrderEtaRequest
+	(*GetOrderEtaReply)(nil),      // 4: delivery.GetOrderEtaReply
+	(*WatchOrderRequest)(nil),     // 5: delivery.WatchOrderRequest
+	(*Location)(nil),              // 6: delivery.Location
+	(*Order)(nil),                 // 7: delivery.Order
+	(*Courier)(nil),               // 8: delivery.Courier
+	(*timestamppb.Timestamp)(nil), // 9: google.protobuf.Timestamp
+}
+var file_api_proto_delivery_proto_depIdxs = []int32{
+	8, // 0: delivery.ListCouriersReply.couriers:type_name -> delivery.Courier
+	6, // 1: delivery.Order.location:type_name -> delivery.Location
+	6, // 2: delivery.Order.pickup_location:type_name -> delivery.Location
+	9, // 3: delivery.Order.created_at:type_name -> google.protobuf.Timestamp
+	6, // 4: delivery.Courier.location:type_name -> delivery.Location
+	0, // 5: delivery.Delivery.GetOrder:input_type -> delivery.GetOrderRequest
+	1, // 6: delivery.Delivery.ListCouriers:input_type -> delivery.ListCouriersRequest
+	3, // 7: delivery.Delivery.GetOrderEta:input_type -> delivery.GetOrderEtaRequest
+	5, // 8: delivery.Delivery.WatchOrder:input_type -> delivery.WatchOrderRequest
+	7, // 9: delivery.Delivery.GetOrder:output_type -> delivery.Order
+	2, // 10: delivery.Delivery.ListCouriers:output_type -> delivery.ListCouriersReply
+	4, // 11: delivery.Delivery.GetOrderEta:output_type -> delivery.GetOrderEtaReply
+	7, // 12: delivery.Delivery.WatchOrder:output_type -> delivery.Order
+	9, // [9:13] is the sub-list for method output_type
+	5, // [5:9] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_api_proto_delivery_proto_init() }
+func file_api_proto_delivery_proto_init() {
+	if File_api_proto_delivery_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_delivery_proto_rawDesc), len(file_api_proto_delivery_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_proto_delivery_proto_goTypes,
+		DependencyIndexes: file_api_proto_delivery_proto_depIdxs,
+		MessageInfos:      file_api_proto_delivery_proto_msgTypes,
+	}.Build()
+	File_api_proto_delivery_proto = out.File
+	file_api_proto_delivery_proto_goTypes = nil
+	file_api_proto_delivery_proto_depIdxs = nil
+}
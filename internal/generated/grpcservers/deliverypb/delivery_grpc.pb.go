@@ -0,0 +1,251 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.33.0
+// source: api/proto/delivery.proto
+
+package deliverypb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Delivery_GetOrder_FullMethodName     = "/delivery.Delivery/GetOrder"
+	Delivery_ListCouriers_FullMethodName = "/delivery.Delivery/ListCouriers"
+	Delivery_GetOrderEta_FullMethodName  = "/delivery.Delivery/GetOrderEta"
+	Delivery_WatchOrder_FullMethodName   = "/delivery.Delivery/WatchOrder"
+)
+
+// DeliveryClient is the client API for Delivery service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// API сервиса доставки для других микросервисов
+type DeliveryClient interface {
+	// Заказ по идентификатору
+	GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*Order, error)
+	// Все курьеры с текущими координатами
+	ListCouriers(ctx context.Context, in *ListCouriersRequest, opts ...grpc.CallOption) (*ListCouriersReply, error)
+	// Оценка времени до прибытия курьера к получателю
+	GetOrderEta(ctx context.Context, in *GetOrderEtaRequest, opts ...grpc.CallOption) (*GetOrderEtaReply, error)
+	// Текущее состояние заказа, затем каждое его изменение. Поток завершается, когда заказ закрыт
+	WatchOrder(ctx context.Context, in *WatchOrderRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Order], error)
+}
+
+type deliveryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDeliveryClient(cc grpc.ClientConnInterface) DeliveryClient {
+	return &deliveryClient{cc}
+}
+
+func (c *deliveryClient) GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*Order, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Order)
+	err := c.cc.Invoke(ctx, Delivery_GetOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deliveryClient) ListCouriers(ctx context.Context, in *ListCouriersRequest, opts ...grpc.CallOption) (*ListCouriersReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCouriersReply)
+	err := c.cc.Invoke(ctx, Delivery_ListCouriers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deliveryClient) GetOrderEta(ctx context.Context, in *GetOrderEtaRequest, opts ...grpc.CallOption) (*GetOrderEtaReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrderEtaReply)
+	err := c.cc.Invoke(ctx, Delivery_GetOrderEta_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deliveryClient) WatchOrder(ctx context.Context, in *WatchOrderRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Order], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Delivery_ServiceDesc.Streams[0], Delivery_WatchOrder_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchOrderRequest, Order]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Delivery_WatchOrderClient = grpc.ServerStreamingClient[Order]
+
+// DeliveryServer is the server API for Delivery service.
+// All implementations must embed UnimplementedDeliveryServer
+// for forward compatibility.
+//
+// API сервиса доставки для других микросервисов
+type DeliveryServer interface {
+	// Заказ по идентификатору
+	GetOrder(context.Context, *GetOrderRequest) (*Order, error)
+	// Все курьеры с текущими координатами
+	ListCouriers(context.Context, *ListCouriersRequest) (*ListCouriersReply, error)
+	// Оценка времени до прибытия курьера к получателю
+	GetOrderEta(context.Context, *GetOrderEtaRequest) (*GetOrderEtaReply, error)
+	// Текущее состояние заказа, затем каждое его изменение. Поток завершается, когда заказ закрыт
+	WatchOrder(*WatchOrderRequest, grpc.ServerStreamingServer[Order]) error
+	mustEmbedUnimplementedDeliveryServer()
+}
+
+// UnimplementedDeliveryServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDeliveryServer struct{}
+
+func (UnimplementedDeliveryServer) GetOrder(context.Context, *GetOrderRequest) (*Order, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
+}
+func (UnimplementedDeliveryServer) ListCouriers(context.Context, *ListCouriersRequest) (*ListCouriersReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCouriers not implemented")
+}
+func (UnimplementedDeliveryServer) GetOrderEta(context.Context, *GetOrderEtaRequest) (*GetOrderEtaReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderEta not implemented")
+}
+func (UnimplementedDeliveryServer) WatchOrder(*WatchOrderRequest, grpc.ServerStreamingServer[Order]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchOrder not implemented")
+}
+func (UnimplementedDeliveryServer) mustEmbedUnimplementedDeliveryServer() {}
+func (UnimplementedDeliveryServer) testEmbeddedByValue()                  {}
+
+// UnsafeDeliveryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DeliveryServer will
+// result in compilation errors.
+type UnsafeDeliveryServer interface {
+	mustEmbedUnimplementedDeliveryServer()
+}
+
+func RegisterDeliveryServer(s grpc.ServiceRegistrar, srv DeliveryServer) {
+	// If the following call pancis, it indicates UnimplementedDeliveryServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Delivery_ServiceDesc, srv)
+}
+
+func _Delivery_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeliveryServer).GetOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Delivery_GetOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeliveryServer).GetOrder(ctx, req.(*GetOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Delivery_ListCouriers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCouriersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeliveryServer).ListCouriers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Delivery_ListCouriers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeliveryServer).ListCouriers(ctx, req.(*ListCouriersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Delivery_GetOrderEta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderEtaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeliveryServer).GetOrderEta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Delivery_GetOrderEta_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeliveryServer).GetOrderEta(ctx, req.(*GetOrderEtaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Delivery_WatchOrder_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchOrderRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DeliveryServer).WatchOrder(m, &grpc.GenericServerStream[WatchOrderRequest, Order]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Delivery_WatchOrderServer = grpc.ServerStreamingServer[Order]
+
+// Delivery_ServiceDesc is the grpc.ServiceDesc for Delivery service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Delivery_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "delivery.Delivery",
+	HandlerType: (*DeliveryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetOrder",
+			Handler:    _Delivery_GetOrder_Handler,
+		},
+		{
+			MethodName: "ListCouriers",
+			Handler:    _Delivery_ListCouriers_Handler,
+		},
+		{
+			MethodName: "GetOrderEta",
+			Handler:    _Delivery_GetOrderEta_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchOrder",
+			Handler:       _Delivery_WatchOrder_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/proto/delivery.proto",
+}
@@ -0,0 +1,230 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.0
+// source: api/proto/courier_location.proto
+
+package locationpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Отметка GPS
+type LocationPing struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CourierId string                 `protobuf:"bytes,1,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	Lat       float64                `protobuf:"fixed64,2,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lon       float64                `protobuf:"fixed64,3,opt,name=lon,proto3" json:"lon,omitempty"`
+	// Время отметки на телефоне. Пустое - время получения
+	RecordedAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=recorded_at,json=recordedAt,proto3" json:"recorded_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocationPing) Reset() {
+	*x = LocationPing{}
+	mi := &file_api_proto_courier_location_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocationPing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocationPing) ProtoMessage() {}
+
+func (x *LocationPing) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_courier_location_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocationPing.ProtoReflect.Descriptor instead.
+func (*LocationPing) Descriptor() ([]byte, []int) {
+	return file_api_proto_courier_location_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LocationPing) GetCourierId() string {
+	if x != nil {
+		return x.CourierId
+	}
+	return ""
+}
+
+func (x *LocationPing) GetLat() float64 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *LocationPing) GetLon() float64 {
+	if x != nil {
+		return x.Lon
+	}
+	return 0
+}
+
+func (x *LocationPing) GetRecordedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RecordedAt
+	}
+	return nil
+}
+
+// Итог обработки потока
+type ReportLocationsReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Отметки, по которым курьер перемещен
+	Accepted int32 `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	// Отметки не новее уже принятых
+	Ignored int32 `protobuf:"varint,2,opt,name=ignored,proto3" json:"ignored,omitempty"`
+	// Отметки с ошибками: неизвестный курьер, точка вне зоны обслуживания и т.п.
+	Rejected      int32 `protobuf:"varint,3,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportLocationsReply) Reset() {
+	*x = ReportLocationsReply{}
+	mi := &file_api_proto_courier_location_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportLocationsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportLocationsReply) ProtoMessage() {}
+
+func (x *ReportLocationsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_courier_location_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportLocationsReply.ProtoReflect.Descriptor instead.
+func (*ReportLocationsReply) Descriptor() ([]byte, []int) {
+	return file_api_proto_courier_location_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReportLocationsReply) GetAccepted() int32 {
+	if x != nil {
+		return x.Accepted
+	}
+	return 0
+}
+
+func (x *ReportLocationsReply) GetIgnored() int32 {
+	if x != nil {
+		return x.Ignored
+	}
+	return 0
+}
+
+func (x *ReportLocationsReply) GetRejected() int32 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+var File_api_proto_courier_location_proto protoreflect.FileDescriptor
+
+const file_api_proto_courier_location_proto_rawDesc = "" +
+	"\n" +
+	" api/proto/courier_location.proto\x12\x10courier_location\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8e\x01\n" +
+	"\fLocationPing\x12\x1d\n" +
+	"\n" +
+	"courier_id\x18\x01 \x01(\tR\tcourierId\x12\x10\n" +
+	"\x03lat\x18\x02 \x01(\x01R\x03lat\x12\x10\n" +
+	"\x03lon\x18\x03 \x01(\x01R\x03lon\x12;\n" +
+	"\vrecorded_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"recordedAt\"h\n" +
+	"\x14ReportLocationsReply\x12\x1a\n" +
+	"\baccepted\x18\x01 \x01(\x05R\baccepted\x12\x18\n" +
+	"\aignored\x18\x02 \x01(\x05R\aignored\x12\x1a\n" +
+	"\brejected\x18\x03 \x01(\x05R\brejected2n\n" +
+	"\x0fCourierLocation\x12[\n" +
+	"\x0fReportLocations\x12\x1e.courier_location.LocationPing\x1a&.courier_location.ReportLocationsReply(\x01Ba\n" +
+	"\x17servers.courierlocationB\x14CourierLocationProtoZ\x16grpcservers/locationpb\xaa\x02\x17Servers.CourierLocationb\x06proto3"
+
+var (
+	file_api_proto_courier_location_proto_rawDescOnce sync.Once
+	file_api_proto_courier_location_proto_rawDescData []byte
+)
+
+func file_api_proto_courier_location_proto_rawDescGZIP() []byte {
+	file_api_proto_courier_location_proto_rawDescOnce.Do(func() {
+		file_api_proto_courier_location_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_proto_courier_location_proto_rawDesc), len(file_api_proto_courier_location_proto_rawDesc)))
+	})
+	return file_api_proto_courier_location_proto_rawDescData
+}
+
+var file_api_proto_courier_location_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_api_proto_courier_location_proto_goTypes = []any{
+	(*LocationPing)(nil),          // 0: courier_location.LocationPing
+	(*ReportLocationsReply)(nil),  // 1: courier_location.ReportLocationsReply
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_api_proto_courier_location_proto_depIdxs = []int32{
+	2, // 0: courier_location.LocationPing.recorded_at:type_name -> google.protobuf.Timestamp
+	0, // 1: courier_location.CourierLocation.ReportLocations:input_type -> courier_location.LocationPing
+	1, // 2: courier_location.CourierLocation.ReportLocations:output_type -> courier_location.ReportLocationsReply
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_api_proto_courier_location_proto_init() }
+func file_api_proto_courier_location_proto_init() {
+	if File_api_proto_courier_location_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_courier_location_proto_rawDesc), len(file_api_proto_courier_location_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_proto_courier_location_proto_goTypes,
+		DependencyIndexes: file_api_proto_courier_location_proto_depIdxs,
+		MessageInfos:      file_api_proto_courier_location_proto_msgTypes,
+	}.Build()
+	File_api_proto_courier_location_proto = out.File
+	file_api_proto_courier_location_proto_goTypes = nil
+	file_api_proto_courier_location_proto_depIdxs = nil
+}
@@ -0,0 +1,120 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.33.0
+// source: api/proto/courier_location.proto
+
+package locationpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CourierLocation_ReportLocations_FullMethodName = "/courier_location.CourierLocation/ReportLocations"
+)
+
+// CourierLocationClient is the client API for CourierLocation service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Прием координат от приложения курьера
+type CourierLocationClient interface {
+	// Приложение копит отметки GPS и отправляет их пачкой в одном потоке
+	ReportLocations(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[LocationPing, ReportLocationsReply], error)
+}
+
+type courierLocationClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCourierLocationClient(cc grpc.ClientConnInterface) CourierLocationClient {
+	return &courierLocationClient{cc}
+}
+
+func (c *courierLocationClient) ReportLocations(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[LocationPing, ReportLocationsReply], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CourierLocation_ServiceDesc.Streams[0], CourierLocation_ReportLocations_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[LocationPing, ReportLocationsReply]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CourierLocation_ReportLocationsClient = grpc.ClientStreamingClient[LocationPing, ReportLocationsReply]
+
+// CourierLocationServer is the server API for CourierLocation service.
+// All implementations must embed UnimplementedCourierLocationServer
+// for forward compatibility.
+//
+// Прием координат от приложения курьера
+type CourierLocationServer interface {
+	// Приложение копит отметки GPS и отправляет их пачкой в одном потоке
+	ReportLocations(grpc.ClientStreamingServer[LocationPing, ReportLocationsReply]) error
+	mustEmbedUnimplementedCourierLocationServer()
+}
+
+// UnimplementedCourierLocationServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCourierLocationServer struct{}
+
+func (UnimplementedCourierLocationServer) ReportLocations(grpc.ClientStreamingServer[LocationPing, ReportLocationsReply]) error {
+	return status.Errorf(codes.Unimplemented, "method ReportLocations not implemented")
+}
+func (UnimplementedCourierLocationServer) mustEmbedUnimplementedCourierLocationServer() {}
+func (UnimplementedCourierLocationServer) testEmbeddedByValue()                         {}
+
+// UnsafeCourierLocationServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CourierLocationServer will
+// result in compilation errors.
+type UnsafeCourierLocationServer interface {
+	mustEmbedUnimplementedCourierLocationServer()
+}
+
+func RegisterCourierLocationServer(s grpc.ServiceRegistrar, srv CourierLocationServer) {
+	// If the following call pancis, it indicates UnimplementedCourierLocationServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CourierLocation_ServiceDesc, srv)
+}
+
+func _CourierLocation_ReportLocations_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CourierLocationServer).ReportLocations(&grpc.GenericServerStream[LocationPing, ReportLocationsReply]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CourierLocation_ReportLocationsServer = grpc.ClientStreamingServer[LocationPing, ReportLocationsReply]
+
+// CourierLocation_ServiceDesc is the grpc.ServiceDesc for CourierLocation service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CourierLocation_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "courier_location.CourierLocation",
+	HandlerType: (*CourierLocationServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ReportLocations",
+			Handler:       _CourierLocation_ReportLocations_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "api/proto/courier_location.proto",
+}
@@ -34,6 +34,7 @@ type BasketConfirmedIntegrationEvent struct {
 	Items          []*Item         `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty"`
 	DeliveryPeriod *DeliveryPeriod `protobuf:"bytes,7,opt,name=delivery_period,json=deliveryPeriod,proto3" json:"delivery_period,omitempty"`
 	Volume         int32           `protobuf:"varint,8,opt,name=volume,proto3" json:"volume,omitempty"`
+	Priority       string          `protobuf:"bytes,9,opt,name=priority,proto3" json:"priority,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -124,6 +125,13 @@ func (x *BasketConfirmedIntegrationEvent) GetVolume() int32 {
 	return 0
 }
 
+func (x *BasketConfirmedIntegrationEvent) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
 type BasketCancelledIntegrationEvent struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Metadata
@@ -285,6 +293,10 @@ type Item struct {
 	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
 	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
 	Quantity      int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Weight        int32                  `protobuf:"varint,6,opt,name=weight,proto3" json:"weight,omitempty"`          // вес одной единицы товара в граммах
+	Handling      string                 `protobuf:"bytes,7,opt,name=handling,proto3" json:"handling,omitempty"`       // условия перевозки: standard, hot, frozen, fragile
+	Restriction   string                 `protobuf:"bytes,8,opt,name=restriction,proto3" json:"restriction,omitempty"` // ограничение на вручение: alcohol, medicine
+	Volume        int32                  `protobuf:"varint,9,opt,name=volume,proto3" json:"volume,omitempty"`          // объем одной единицы товара
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -354,6 +366,34 @@ func (x *Item) GetQuantity() int32 {
 	return 0
 }
 
+func (x *Item) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Item) GetHandling() string {
+	if x != nil {
+		return x.Handling
+	}
+	return ""
+}
+
+func (x *Item) GetRestriction() string {
+	if x != nil {
+		return x.Restriction
+	}
+	return ""
+}
+
+func (x *Item) GetVolume() int32 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
 type DeliveryPeriod struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	From          int32                  `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
@@ -410,7 +450,7 @@ var File_api_proto_baskets_events_proto protoreflect.FileDescriptor
 
 const file_api_proto_baskets_events_proto_rawDesc = "" +
 	"\n" +
-	"\x1eapi/proto/baskets_events.proto\x12\fbasket_event\x1a\x1fgoogle/protobuf/timestamp.proto\"\xef\x02\n" +
+	"\x1eapi/proto/baskets_events.proto\x12\fbasket_event\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8b\x03\n" +
 	"\x1fBasketConfirmedIntegrationEvent\x12\x19\n" +
 	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1d\n" +
 	"\n" +
@@ -421,7 +461,8 @@ const file_api_proto_baskets_events_proto_rawDesc = "" +
 	"\aaddress\x18\x05 \x01(\v2\x15.basket_event.AddressR\aaddress\x12(\n" +
 	"\x05items\x18\x06 \x03(\v2\x12.basket_event.ItemR\x05items\x12E\n" +
 	"\x0fdelivery_period\x18\a \x01(\v2\x1c.basket_event.DeliveryPeriodR\x0edeliveryPeriod\x12\x16\n" +
-	"\x06volume\x18\b \x01(\x05R\x06volume\"\xcd\x01\n" +
+	"\x06volume\x18\b \x01(\x05R\x06volume\x12\x1a\n" +
+	"\bpriority\x18\t \x01(\tR\bpriority\"\xcd\x01\n" +
 	"\x1fBasketCancelledIntegrationEvent\x12\x19\n" +
 	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1d\n" +
 	"\n" +
@@ -435,13 +476,17 @@ const file_api_proto_baskets_events_proto_rawDesc = "" +
 	"\x04city\x18\x02 \x01(\tR\x04city\x12\x16\n" +
 	"\x06street\x18\x03 \x01(\tR\x06street\x12\x14\n" +
 	"\x05house\x18\x04 \x01(\tR\x05house\x12\x1c\n" +
-	"\tapartment\x18\x05 \x01(\tR\tapartment\"w\n" +
+	"\tapartment\x18\x05 \x01(\tR\tapartment\"\xe5\x01\n" +
 	"\x04Item\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\agood_id\x18\x02 \x01(\tR\x06goodId\x12\x14\n" +
 	"\x05title\x18\x03 \x01(\tR\x05title\x12\x14\n" +
 	"\x05price\x18\x04 \x01(\x01R\x05price\x12\x1a\n" +
-	"\bquantity\x18\x05 \x01(\x05R\bquantity\"4\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\x12\x16\n" +
+	"\x06weight\x18\x06 \x01(\x05R\x06weight\x12\x1a\n" +
+	"\bhandling\x18\a \x01(\tR\bhandling\x12 \n" +
+	"\vrestriction\x18\b \x01(\tR\vrestriction\x12\x16\n" +
+	"\x06volume\x18\t \x01(\x05R\x06volume\"4\n" +
 	"\x0eDeliveryPeriod\x12\x12\n" +
 	"\x04from\x18\x01 \x01(\x05R\x04from\x12\x0e\n" +
 	"\x02to\x18\x02 \x01(\x05R\x02toBC\n" +
@@ -29,7 +29,11 @@ type OrderCreatedIntegrationEvent struct {
 	EventType  string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
 	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
 	// Payload
-	OrderId       string `protobuf:"bytes,4,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	OrderId string `protobuf:"bytes,4,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// Код подтверждения доставки для получателя. Пустой - заказ завершается без подтверждения
+	ConfirmationCode string `protobuf:"bytes,5,opt,name=confirmation_code,json=confirmationCode,proto3" json:"confirmation_code,omitempty"`
+	// Токен ссылки для отслеживания заказа получателем: GET /track/{tracking_token}
+	TrackingToken string `protobuf:"bytes,6,opt,name=tracking_token,json=trackingToken,proto3" json:"tracking_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -92,6 +96,20 @@ func (x *OrderCreatedIntegrationEvent) GetOrderId() string {
 	return ""
 }
 
+func (x *OrderCreatedIntegrationEvent) GetConfirmationCode() string {
+	if x != nil {
+		return x.ConfirmationCode
+	}
+	return ""
+}
+
+func (x *OrderCreatedIntegrationEvent) GetTrackingToken() string {
+	if x != nil {
+		return x.TrackingToken
+	}
+	return ""
+}
+
 type OrderCompletedIntegrationEvent struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Metadata
@@ -170,18 +188,453 @@ func (x *OrderCompletedIntegrationEvent) GetCourierId() string {
 	return ""
 }
 
+type OrderRejectedIntegrationEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Metadata
+	EventId    string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType  string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// Payload
+	OrderId       string `protobuf:"bytes,4,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Reason        string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderRejectedIntegrationEvent) Reset() {
+	*x = OrderRejectedIntegrationEvent{}
+	mi := &file_api_proto_order_events_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderRejectedIntegrationEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderRejectedIntegrationEvent) ProtoMessage() {}
+
+func (x *OrderRejectedIntegrationEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_events_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderRejectedIntegrationEvent.ProtoReflect.Descriptor instead.
+func (*OrderRejectedIntegrationEvent) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *OrderRejectedIntegrationEvent) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *OrderRejectedIntegrationEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *OrderRejectedIntegrationEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *OrderRejectedIntegrationEvent) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *OrderRejectedIntegrationEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type OrderDeliveryFailedIntegrationEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Metadata
+	EventId    string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType  string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// Payload
+	OrderId       string `protobuf:"bytes,4,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CourierId     string `protobuf:"bytes,5,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	Reason        string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderDeliveryFailedIntegrationEvent) Reset() {
+	*x = OrderDeliveryFailedIntegrationEvent{}
+	mi := &file_api_proto_order_events_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderDeliveryFailedIntegrationEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderDeliveryFailedIntegrationEvent) ProtoMessage() {}
+
+func (x *OrderDeliveryFailedIntegrationEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_events_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderDeliveryFailedIntegrationEvent.ProtoReflect.Descriptor instead.
+func (*OrderDeliveryFailedIntegrationEvent) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *OrderDeliveryFailedIntegrationEvent) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *OrderDeliveryFailedIntegrationEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *OrderDeliveryFailedIntegrationEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *OrderDeliveryFailedIntegrationEvent) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *OrderDeliveryFailedIntegrationEvent) GetCourierId() string {
+	if x != nil {
+		return x.CourierId
+	}
+	return ""
+}
+
+func (x *OrderDeliveryFailedIntegrationEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Оператор вручную назначил заказ курьеру
+type OrderAssignedIntegrationEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Metadata
+	EventId    string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType  string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// Payload
+	OrderId       string `protobuf:"bytes,4,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CourierId     string `protobuf:"bytes,5,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	Operator      string `protobuf:"bytes,6,opt,name=operator,proto3" json:"operator,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderAssignedIntegrationEvent) Reset() {
+	*x = OrderAssignedIntegrationEvent{}
+	mi := &file_api_proto_order_events_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderAssignedIntegrationEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderAssignedIntegrationEvent) ProtoMessage() {}
+
+func (x *OrderAssignedIntegrationEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_events_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderAssignedIntegrationEvent.ProtoReflect.Descriptor instead.
+func (*OrderAssignedIntegrationEvent) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *OrderAssignedIntegrationEvent) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *OrderAssignedIntegrationEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *OrderAssignedIntegrationEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *OrderAssignedIntegrationEvent) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *OrderAssignedIntegrationEvent) GetCourierId() string {
+	if x != nil {
+		return x.CourierId
+	}
+	return ""
+}
+
+func (x *OrderAssignedIntegrationEvent) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+// Оператор снял заказ с курьера, заказ снова ждет назначения
+type OrderUnassignedIntegrationEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Metadata
+	EventId    string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType  string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// Payload
+	OrderId       string `protobuf:"bytes,4,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CourierId     string `protobuf:"bytes,5,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	Operator      string `protobuf:"bytes,6,opt,name=operator,proto3" json:"operator,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderUnassignedIntegrationEvent) Reset() {
+	*x = OrderUnassignedIntegrationEvent{}
+	mi := &file_api_proto_order_events_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderUnassignedIntegrationEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderUnassignedIntegrationEvent) ProtoMessage() {}
+
+func (x *OrderUnassignedIntegrationEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_events_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderUnassignedIntegrationEvent.ProtoReflect.Descriptor instead.
+func (*OrderUnassignedIntegrationEvent) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *OrderUnassignedIntegrationEvent) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *OrderUnassignedIntegrationEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *OrderUnassignedIntegrationEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *OrderUnassignedIntegrationEvent) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *OrderUnassignedIntegrationEvent) GetCourierId() string {
+	if x != nil {
+		return x.CourierId
+	}
+	return ""
+}
+
+func (x *OrderUnassignedIntegrationEvent) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+// Оператор передал заказ другому курьеру
+type OrderReassignedIntegrationEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Metadata
+	EventId    string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType  string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// Payload
+	OrderId       string `protobuf:"bytes,4,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	FromCourierId string `protobuf:"bytes,5,opt,name=from_courier_id,json=fromCourierId,proto3" json:"from_courier_id,omitempty"`
+	ToCourierId   string `protobuf:"bytes,6,opt,name=to_courier_id,json=toCourierId,proto3" json:"to_courier_id,omitempty"`
+	Operator      string `protobuf:"bytes,7,opt,name=operator,proto3" json:"operator,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderReassignedIntegrationEvent) Reset() {
+	*x = OrderReassignedIntegrationEvent{}
+	mi := &file_api_proto_order_events_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderReassignedIntegrationEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderReassignedIntegrationEvent) ProtoMessage() {}
+
+func (x *OrderReassignedIntegrationEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_events_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderReassignedIntegrationEvent.ProtoReflect.Descriptor instead.
+func (*OrderReassignedIntegrationEvent) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_events_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *OrderReassignedIntegrationEvent) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *OrderReassignedIntegrationEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *OrderReassignedIntegrationEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *OrderReassignedIntegrationEvent) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *OrderReassignedIntegrationEvent) GetFromCourierId() string {
+	if x != nil {
+		return x.FromCourierId
+	}
+	return ""
+}
+
+func (x *OrderReassignedIntegrationEvent) GetToCourierId() string {
+	if x != nil {
+		return x.ToCourierId
+	}
+	return ""
+}
+
+func (x *OrderReassignedIntegrationEvent) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
 var File_api_proto_order_events_proto protoreflect.FileDescriptor
 
 const file_api_proto_order_events_proto_rawDesc = "" +
 	"\n" +
-	"\x1capi/proto/order_events.proto\x12\vorder_event\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb0\x01\n" +
+	"\x1capi/proto/order_events.proto\x12\vorder_event\x1a\x1fgoogle/protobuf/timestamp.proto\"\x84\x02\n" +
 	"\x1cOrderCreatedIntegrationEvent\x12\x19\n" +
 	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1d\n" +
 	"\n" +
 	"event_type\x18\x02 \x01(\tR\teventType\x12;\n" +
 	"\voccurred_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"occurredAt\x12\x19\n" +
-	"\border_id\x18\x04 \x01(\tR\aorderId\"\xd1\x01\n" +
+	"\border_id\x18\x04 \x01(\tR\aorderId\x12+\n" +
+	"\x11confirmation_code\x18\x05 \x01(\tR\x10confirmationCode\x12%\n" +
+	"\x0etracking_token\x18\x06 \x01(\tR\rtrackingToken\"\xd1\x01\n" +
 	"\x1eOrderCompletedIntegrationEvent\x12\x19\n" +
 	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1d\n" +
 	"\n" +
@@ -190,7 +643,55 @@ const file_api_proto_order_events_proto_rawDesc = "" +
 	"occurredAt\x12\x19\n" +
 	"\border_id\x18\x04 \x01(\tR\aorderId\x12\x1d\n" +
 	"\n" +
-	"courier_id\x18\x05 \x01(\tR\tcourierIdB?\n" +
+	"courier_id\x18\x05 \x01(\tR\tcourierId\"\xc9\x01\n" +
+	"\x1dOrderRejectedIntegrationEvent\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\tR\teventType\x12;\n" +
+	"\voccurred_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12\x19\n" +
+	"\border_id\x18\x04 \x01(\tR\aorderId\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"\xee\x01\n" +
+	"#OrderDeliveryFailedIntegrationEvent\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\tR\teventType\x12;\n" +
+	"\voccurred_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12\x19\n" +
+	"\border_id\x18\x04 \x01(\tR\aorderId\x12\x1d\n" +
+	"\n" +
+	"courier_id\x18\x05 \x01(\tR\tcourierId\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\"\xec\x01\n" +
+	"\x1dOrderAssignedIntegrationEvent\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\tR\teventType\x12;\n" +
+	"\voccurred_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12\x19\n" +
+	"\border_id\x18\x04 \x01(\tR\aorderId\x12\x1d\n" +
+	"\n" +
+	"courier_id\x18\x05 \x01(\tR\tcourierId\x12\x1a\n" +
+	"\boperator\x18\x06 \x01(\tR\boperator\"\xee\x01\n" +
+	"\x1fOrderUnassignedIntegrationEvent\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\tR\teventType\x12;\n" +
+	"\voccurred_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12\x19\n" +
+	"\border_id\x18\x04 \x01(\tR\aorderId\x12\x1d\n" +
+	"\n" +
+	"courier_id\x18\x05 \x01(\tR\tcourierId\x12\x1a\n" +
+	"\boperator\x18\x06 \x01(\tR\boperator\"\x9b\x02\n" +
+	"\x1fOrderReassignedIntegrationEvent\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\tR\teventType\x12;\n" +
+	"\voccurred_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12\x19\n" +
+	"\border_id\x18\x04 \x01(\tR\aorderId\x12&\n" +
+	"\x0ffrom_courier_id\x18\x05 \x01(\tR\rfromCourierId\x12\"\n" +
+	"\rto_courier_id\x18\x06 \x01(\tR\vtoCourierId\x12\x1a\n" +
+	"\boperator\x18\a \x01(\tR\boperatorB?\n" +
 	"\fqueues.orderB\x10OrderEventsProtoZ\x0equeues/orderpb\xaa\x02\fQueues.Orderb\x06proto3"
 
 var (
@@ -205,20 +706,30 @@ func file_api_proto_order_events_proto_rawDescGZIP() []byte {
 	return file_api_proto_order_events_proto_rawDescData
 }
 
-var file_api_proto_order_events_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_api_proto_order_events_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_api_proto_order_events_proto_goTypes = []any{
-	(*OrderCreatedIntegrationEvent)(nil),   // 0: order_event.OrderCreatedIntegrationEvent
-	(*OrderCompletedIntegrationEvent)(nil), // 1: order_event.OrderCompletedIntegrationEvent
-	(*timestamppb.Timestamp)(nil),          // 2: google.protobuf.Timestamp
+	(*OrderCreatedIntegrationEvent)(nil),        // 0: order_event.OrderCreatedIntegrationEvent
+	(*OrderCompletedIntegrationEvent)(nil),      // 1: order_event.OrderCompletedIntegrationEvent
+	(*OrderRejectedIntegrationEvent)(nil),       // 2: order_event.OrderRejectedIntegrationEvent
+	(*OrderDeliveryFailedIntegrationEvent)(nil), // 3: order_event.OrderDeliveryFailedIntegrationEvent
+	(*OrderAssignedIntegrationEvent)(nil),       // 4: order_event.OrderAssignedIntegrationEvent
+	(*OrderUnassignedIntegrationEvent)(nil),     // 5: order_event.OrderUnassignedIntegrationEvent
+	(*OrderReassignedIntegrationEvent)(nil),     // 6: order_event.OrderReassignedIntegrationEvent
+	(*timestamppb.Timestamp)(nil),               // 7: google.protobuf.Timestamp
 }
 var file_api_proto_order_events_proto_depIdxs = []int32{
-	2, // 0: order_event.OrderCreatedIntegrationEvent.occurred_at:type_name -> google.protobuf.Timestamp
-	2, // 1: order_event.OrderCompletedIntegrationEvent.occurred_at:type_name -> google.protobuf.Timestamp
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	7, // 0: order_event.OrderCreatedIntegrationEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	7, // 1: order_event.OrderCompletedIntegrationEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	7, // 2: order_event.OrderRejectedIntegrationEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	7, // 3: order_event.OrderDeliveryFailedIntegrationEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	7, // 4: order_event.OrderAssignedIntegrationEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	7, // 5: order_event.OrderUnassignedIntegrationEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	7, // 6: order_event.OrderReassignedIntegrationEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_order_events_proto_init() }
@@ -232,7 +743,7 @@ func file_api_proto_order_events_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_order_events_proto_rawDesc), len(file_api_proto_order_events_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -14,13 +14,54 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
+	"github.com/oapi-codegen/runtime"
 	strictecho "github.com/oapi-codegen/runtime/strictmiddleware/echo"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+const (
+	ApiKeyAuthScopes = "ApiKeyAuth.Scopes"
+	BearerAuthScopes = "BearerAuth.Scopes"
+)
+
+// Defines values for OrderHandling.
+const (
+	OrderHandlingFragile  OrderHandling = "fragile"
+	OrderHandlingFrozen   OrderHandling = "frozen"
+	OrderHandlingHot      OrderHandling = "hot"
+	OrderHandlingStandard OrderHandling = "standard"
+)
+
+// Defines values for OrderPriority.
+const (
+	OrderPriorityExpress  OrderPriority = "express"
+	OrderPriorityStandard OrderPriority = "standard"
+)
+
+// Defines values for OrderRestriction.
+const (
+	OrderRestrictionAlcohol  OrderRestriction = "alcohol"
+	OrderRestrictionMedicine OrderRestriction = "medicine"
+)
+
+// Defines values for Qualification.
+const (
+	QualificationAlcohol  Qualification = "alcohol"
+	QualificationMedicine Qualification = "medicine"
+)
+
+// Defines values for StoragePlaceType.
+const (
+	Padded       StoragePlaceType = "padded"
+	Refrigerated StoragePlaceType = "refrigerated"
+	Standard     StoragePlaceType = "standard"
+	Thermal      StoragePlaceType = "thermal"
+)
+
 // Courier defines model for Courier.
 type Courier struct {
 	// Id Идентификатор
@@ -31,6 +72,108 @@ type Courier struct {
 	Name string `json:"name"`
 }
 
+// CourierAssignment defines model for CourierAssignment.
+type CourierAssignment struct {
+	// CourierId Идентификатор курьера
+	CourierId openapi_types.UUID `json:"courierId"`
+}
+
+// CourierDetails defines model for CourierDetails.
+type CourierDetails struct {
+	// Id Идентификатор
+	Id       openapi_types.UUID `json:"id"`
+	Location Location           `json:"location"`
+
+	// Name Имя
+	Name           string          `json:"name"`
+	Qualifications []Qualification `json:"qualifications"`
+
+	// Speed Скорость - клеток в секунду, в режиме geo - км/ч
+	Speed         int            `json:"speed"`
+	StoragePlaces []StoragePlace `json:"storagePlaces"`
+}
+
+// CourierLocationReport defines model for CourierLocationReport.
+type CourierLocationReport struct {
+	// Lat Широта в градусах
+	Lat float64 `json:"lat"`
+
+	// Lon Долгота в градусах
+	Lon float64 `json:"lon"`
+
+	// ReportedAt Время отметки на телефоне курьера. Если не задано - время получения
+	ReportedAt *time.Time `json:"reportedAt,omitempty"`
+}
+
+// DeliveryConfirmation defines model for DeliveryConfirmation.
+type DeliveryConfirmation struct {
+	// Code Код, который получатель сообщил курьеру
+	Code string `json:"code"`
+}
+
+// DeliveryFailure defines model for DeliveryFailure.
+type DeliveryFailure struct {
+	// Reason Причина, например "получателя нет дома"
+	Reason string `json:"reason"`
+
+	// Retry Повторить доставку после возврата товара на склад
+	Retry *bool `json:"retry,omitempty"`
+}
+
+// DispatchCandidate defines model for DispatchCandidate.
+type DispatchCandidate struct {
+	// CourierId Идентификатор курьера
+	CourierId openapi_types.UUID `json:"courierId"`
+
+	// CourierName Имя курьера
+	CourierName string `json:"courierName"`
+
+	// Eta Время доставки
+	Eta float64 `json:"eta"`
+
+	// MissingQualification Допуск, которого не хватило курьеру
+	MissingQualification *string `json:"missingQualification,omitempty"`
+
+	// RejectionReason Причина, по которой курьер не подошел
+	RejectionReason *string `json:"rejectionReason,omitempty"`
+}
+
+// DispatchDecision defines model for DispatchDecision.
+type DispatchDecision struct {
+	// Candidates Рассмотренные курьеры
+	Candidates []DispatchCandidate `json:"candidates"`
+
+	// CourierId Идентификатор выбранного курьера
+	CourierId *openapi_types.UUID `json:"courierId,omitempty"`
+
+	// DecidedAt Время принятия решения
+	DecidedAt time.Time `json:"decidedAt"`
+
+	// Id Идентификатор
+	Id openapi_types.UUID `json:"id"`
+
+	// OrderId Идентификатор заказа
+	OrderId openapi_types.UUID `json:"orderId"`
+}
+
+// DispatchSimulation defines model for DispatchSimulation.
+type DispatchSimulation struct {
+	// Alternatives Другие подходящие курьеры по возрастанию времени доставки
+	Alternatives []SimulatedCourier `json:"alternatives"`
+	Courier      *SimulatedCourier  `json:"courier,omitempty"`
+}
+
+// DispatchSimulationRequest defines model for DispatchSimulationRequest.
+type DispatchSimulationRequest struct {
+	Location Location `json:"location"`
+
+	// Volume Объем
+	Volume int `json:"volume"`
+
+	// Weight Вес в граммах. Если не задан - вес не учитывается
+	Weight *int `json:"weight,omitempty"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	// Code Код ошибки
@@ -51,13 +194,30 @@ type Location struct {
 
 // NewCourier defines model for NewCourier.
 type NewCourier struct {
+	HomeDepot *Location `json:"homeDepot,omitempty"`
+	Location  *Location `json:"location,omitempty"`
+
 	// Name Имя
 	Name string `json:"name"`
 
-	// Speed Скорость
+	// Speed Скорость - клеток в секунду, в режиме geo - км/ч
 	Speed int `json:"speed"`
 }
 
+// NewOrder defines model for NewOrder.
+type NewOrder struct {
+	// Handling Условия перевозки заказа
+	Handling *OrderHandling `json:"handling,omitempty"`
+	Pickup   *Location      `json:"pickup,omitempty"`
+
+	// Priority Приоритет заказа
+	Priority     *OrderPriority      `json:"priority,omitempty"`
+	Restrictions *[]OrderRestriction `json:"restrictions,omitempty"`
+
+	// Weight Вес в граммах. Если не задан - вес при назначении не учитывается
+	Weight *int `json:"weight,omitempty"`
+}
+
 // Order defines model for Order.
 type Order struct {
 	// Id Идентификатор
@@ -65,9 +225,103 @@ type Order struct {
 	Location Location           `json:"location"`
 }
 
+// OrderHandling Условия перевозки заказа
+type OrderHandling string
+
+// OrderPriority Приоритет заказа
+type OrderPriority string
+
+// OrderRestriction Ограничение на вручение заказа, требует допуска курьера
+type OrderRestriction string
+
+// OrderTracking defines model for OrderTracking.
+type OrderTracking struct {
+	CourierLocation *Location `json:"courierLocation,omitempty"`
+
+	// EtaSeconds Сколько секунд осталось до прибытия курьера. Нет, если курьер к получателю не едет. У разделенного заказа - до прибытия последнего отправления, нет, если не все отправления в пути
+	EtaSeconds *float64 `json:"etaSeconds,omitempty"`
+
+	// Shipments Отправления разделенного заказа
+	Shipments *[]ShipmentTracking `json:"shipments,omitempty"`
+
+	// Status Статус заказа
+	Status string `json:"status"`
+}
+
+// Qualification Допуск курьера
+type Qualification string
+
+// ShipmentTracking defines model for ShipmentTracking.
+type ShipmentTracking struct {
+	CourierLocation *Location `json:"courierLocation,omitempty"`
+
+	// EtaSeconds Сколько секунд осталось до прибытия курьера. Нет, если курьер к получателю не едет
+	EtaSeconds *float64 `json:"etaSeconds,omitempty"`
+
+	// Status Статус отправления
+	Status string `json:"status"`
+}
+
+// SimulatedCourier defines model for SimulatedCourier.
+type SimulatedCourier struct {
+	// CourierId Идентификатор курьера
+	CourierId openapi_types.UUID `json:"courierId"`
+
+	// CourierName Имя курьера
+	CourierName string `json:"courierName"`
+
+	// Eta Время доставки
+	Eta float64 `json:"eta"`
+}
+
+// StoragePlace defines model for StoragePlace.
+type StoragePlace struct {
+	// Id Идентификатор
+	Id openapi_types.UUID `json:"id"`
+
+	// MaxWeight Допустимый вес в граммах, 0 - вес не ограничен
+	MaxWeight int `json:"maxWeight"`
+
+	// Name Название
+	Name string `json:"name"`
+
+	// OrderId Заказ, который лежит в месте хранения
+	OrderId *openapi_types.UUID `json:"orderId,omitempty"`
+
+	// TotalVolume Объем
+	TotalVolume int `json:"totalVolume"`
+
+	// Type Тип места хранения
+	Type StoragePlaceType `json:"type"`
+}
+
+// StoragePlaceType Тип места хранения
+type StoragePlaceType string
+
 // CreateCourierJSONRequestBody defines body for CreateCourier for application/json ContentType.
 type CreateCourierJSONRequestBody = NewCourier
 
+// ReportCourierLocationJSONRequestBody defines body for ReportCourierLocation for application/json ContentType.
+type ReportCourierLocationJSONRequestBody = CourierLocationReport
+
+// SimulateDispatchJSONRequestBody defines body for SimulateDispatch for application/json ContentType.
+type SimulateDispatchJSONRequestBody = DispatchSimulationRequest
+
+// CreateOrderJSONRequestBody defines body for CreateOrder for application/json ContentType.
+type CreateOrderJSONRequestBody = NewOrder
+
+// ManualAssignOrderJSONRequestBody defines body for ManualAssignOrder for application/json ContentType.
+type ManualAssignOrderJSONRequestBody = CourierAssignment
+
+// ReassignOrderJSONRequestBody defines body for ReassignOrder for application/json ContentType.
+type ReassignOrderJSONRequestBody = CourierAssignment
+
+// ConfirmDeliveryJSONRequestBody defines body for ConfirmDelivery for application/json ContentType.
+type ConfirmDeliveryJSONRequestBody = DeliveryConfirmation
+
+// FailDeliveryJSONRequestBody defines body for FailDelivery for application/json ContentType.
+type FailDeliveryJSONRequestBody = DeliveryFailure
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Получить всех курьеров
@@ -76,12 +330,48 @@ type ServerInterface interface {
 	// Добавить курьера
 	// (POST /api/v1/couriers)
 	CreateCourier(ctx echo.Context) error
+	// Получить курьера
+	// (GET /api/v1/couriers/{courierId})
+	GetCourier(ctx echo.Context, courierId openapi_types.UUID) error
+	// Сообщить координаты курьера
+	// (PUT /api/v1/couriers/{courierId}/location)
+	ReportCourierLocation(ctx echo.Context, courierId openapi_types.UUID) error
+	// Отозвать допуск у курьера
+	// (DELETE /api/v1/couriers/{courierId}/qualifications/{qualification})
+	RevokeQualification(ctx echo.Context, courierId openapi_types.UUID, qualification Qualification) error
+	// Выдать допуск курьеру
+	// (PUT /api/v1/couriers/{courierId}/qualifications/{qualification})
+	GrantQualification(ctx echo.Context, courierId openapi_types.UUID, qualification Qualification) error
+	// Пробная диспетчеризация заказа
+	// (POST /api/v1/dispatch/simulate)
+	SimulateDispatch(ctx echo.Context) error
 	// Создать заказ
 	// (POST /api/v1/orders)
 	CreateOrder(ctx echo.Context) error
 	// Получить все незавершенные заказы
 	// (GET /api/v1/orders/active)
 	GetOrders(ctx echo.Context) error
+	// Снять заказ с курьера
+	// (DELETE /api/v1/orders/{orderId}/assignment)
+	UnassignOrder(ctx echo.Context, orderId openapi_types.UUID) error
+	// Назначить заказ курьеру вручную
+	// (POST /api/v1/orders/{orderId}/assignment)
+	ManualAssignOrder(ctx echo.Context, orderId openapi_types.UUID) error
+	// Передать заказ другому курьеру
+	// (PUT /api/v1/orders/{orderId}/assignment)
+	ReassignOrder(ctx echo.Context, orderId openapi_types.UUID) error
+	// Подтвердить доставку заказа
+	// (POST /api/v1/orders/{orderId}/delivery-confirmation)
+	ConfirmDelivery(ctx echo.Context, orderId openapi_types.UUID) error
+	// Сообщить о неудачной доставке
+	// (POST /api/v1/orders/{orderId}/delivery-failure)
+	FailDelivery(ctx echo.Context, orderId openapi_types.UUID) error
+	// Получить историю назначения заказа
+	// (GET /api/v1/orders/{orderId}/dispatch-decisions)
+	GetOrderDispatchDecisions(ctx echo.Context, orderId openapi_types.UUID) error
+	// Отследить заказ
+	// (GET /track/{token})
+	TrackOrder(ctx echo.Context, token string) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -93,6 +383,10 @@ type ServerInterfaceWrapper struct {
 func (w *ServerInterfaceWrapper) GetCouriers(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.GetCouriers(ctx)
 	return err
@@ -102,15 +396,132 @@ func (w *ServerInterfaceWrapper) GetCouriers(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) CreateCourier(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.CreateCourier(ctx)
 	return err
 }
 
+// GetCourier converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCourier(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "courierId" -------------
+	var courierId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courierId", ctx.Param("courierId"), &courierId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCourier(ctx, courierId)
+	return err
+}
+
+// ReportCourierLocation converts echo context to params.
+func (w *ServerInterfaceWrapper) ReportCourierLocation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "courierId" -------------
+	var courierId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courierId", ctx.Param("courierId"), &courierId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ReportCourierLocation(ctx, courierId)
+	return err
+}
+
+// RevokeQualification converts echo context to params.
+func (w *ServerInterfaceWrapper) RevokeQualification(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "courierId" -------------
+	var courierId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courierId", ctx.Param("courierId"), &courierId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	// ------------- Path parameter "qualification" -------------
+	var qualification Qualification
+
+	err = runtime.BindStyledParameterWithOptions("simple", "qualification", ctx.Param("qualification"), &qualification, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter qualification: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RevokeQualification(ctx, courierId, qualification)
+	return err
+}
+
+// GrantQualification converts echo context to params.
+func (w *ServerInterfaceWrapper) GrantQualification(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "courierId" -------------
+	var courierId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courierId", ctx.Param("courierId"), &courierId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	// ------------- Path parameter "qualification" -------------
+	var qualification Qualification
+
+	err = runtime.BindStyledParameterWithOptions("simple", "qualification", ctx.Param("qualification"), &qualification, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter qualification: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GrantQualification(ctx, courierId, qualification)
+	return err
+}
+
+// SimulateDispatch converts echo context to params.
+func (w *ServerInterfaceWrapper) SimulateDispatch(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SimulateDispatch(ctx)
+	return err
+}
+
 // CreateOrder converts echo context to params.
 func (w *ServerInterfaceWrapper) CreateOrder(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.CreateOrder(ctx)
 	return err
@@ -120,11 +531,151 @@ func (w *ServerInterfaceWrapper) CreateOrder(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) GetOrders(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.GetOrders(ctx)
 	return err
 }
 
+// UnassignOrder converts echo context to params.
+func (w *ServerInterfaceWrapper) UnassignOrder(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orderId" -------------
+	var orderId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orderId", ctx.Param("orderId"), &orderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderId: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UnassignOrder(ctx, orderId)
+	return err
+}
+
+// ManualAssignOrder converts echo context to params.
+func (w *ServerInterfaceWrapper) ManualAssignOrder(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orderId" -------------
+	var orderId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orderId", ctx.Param("orderId"), &orderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderId: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ManualAssignOrder(ctx, orderId)
+	return err
+}
+
+// ReassignOrder converts echo context to params.
+func (w *ServerInterfaceWrapper) ReassignOrder(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orderId" -------------
+	var orderId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orderId", ctx.Param("orderId"), &orderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderId: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ReassignOrder(ctx, orderId)
+	return err
+}
+
+// ConfirmDelivery converts echo context to params.
+func (w *ServerInterfaceWrapper) ConfirmDelivery(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orderId" -------------
+	var orderId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orderId", ctx.Param("orderId"), &orderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderId: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ConfirmDelivery(ctx, orderId)
+	return err
+}
+
+// FailDelivery converts echo context to params.
+func (w *ServerInterfaceWrapper) FailDelivery(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orderId" -------------
+	var orderId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orderId", ctx.Param("orderId"), &orderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderId: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.FailDelivery(ctx, orderId)
+	return err
+}
+
+// GetOrderDispatchDecisions converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOrderDispatchDecisions(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orderId" -------------
+	var orderId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orderId", ctx.Param("orderId"), &orderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderId: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetOrderDispatchDecisions(ctx, orderId)
+	return err
+}
+
+// TrackOrder converts echo context to params.
+func (w *ServerInterfaceWrapper) TrackOrder(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "token", ctx.Param("token"), &token, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TrackOrder(ctx, token)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -155,8 +706,20 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 
 	router.GET(baseURL+"/api/v1/couriers", wrapper.GetCouriers)
 	router.POST(baseURL+"/api/v1/couriers", wrapper.CreateCourier)
+	router.GET(baseURL+"/api/v1/couriers/:courierId", wrapper.GetCourier)
+	router.PUT(baseURL+"/api/v1/couriers/:courierId/location", wrapper.ReportCourierLocation)
+	router.DELETE(baseURL+"/api/v1/couriers/:courierId/qualifications/:qualification", wrapper.RevokeQualification)
+	router.PUT(baseURL+"/api/v1/couriers/:courierId/qualifications/:qualification", wrapper.GrantQualification)
+	router.POST(baseURL+"/api/v1/dispatch/simulate", wrapper.SimulateDispatch)
 	router.POST(baseURL+"/api/v1/orders", wrapper.CreateOrder)
 	router.GET(baseURL+"/api/v1/orders/active", wrapper.GetOrders)
+	router.DELETE(baseURL+"/api/v1/orders/:orderId/assignment", wrapper.UnassignOrder)
+	router.POST(baseURL+"/api/v1/orders/:orderId/assignment", wrapper.ManualAssignOrder)
+	router.PUT(baseURL+"/api/v1/orders/:orderId/assignment", wrapper.ReassignOrder)
+	router.POST(baseURL+"/api/v1/orders/:orderId/delivery-confirmation", wrapper.ConfirmDelivery)
+	router.POST(baseURL+"/api/v1/orders/:orderId/delivery-failure", wrapper.FailDelivery)
+	router.GET(baseURL+"/api/v1/orders/:orderId/dispatch-decisions", wrapper.GetOrderDispatchDecisions)
+	router.GET(baseURL+"/track/:token", wrapper.TrackOrder)
 
 }
 
@@ -234,55 +797,655 @@ func (response CreateCourierdefaultJSONResponse) VisitCreateCourierResponse(w ht
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type CreateOrderRequestObject struct {
+type GetCourierRequestObject struct {
+	CourierId openapi_types.UUID `json:"courierId"`
 }
 
-type CreateOrderResponseObject interface {
-	VisitCreateOrderResponse(w http.ResponseWriter) error
+type GetCourierResponseObject interface {
+	VisitGetCourierResponse(w http.ResponseWriter) error
 }
 
-type CreateOrder201Response struct {
+type GetCourier200JSONResponse CourierDetails
+
+func (response GetCourier200JSONResponse) VisitGetCourierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response CreateOrder201Response) VisitCreateOrderResponse(w http.ResponseWriter) error {
-	w.WriteHeader(201)
-	return nil
+type GetCourier404JSONResponse Error
+
+func (response GetCourier404JSONResponse) VisitGetCourierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateOrderdefaultJSONResponse struct {
+type GetCourierdefaultJSONResponse struct {
 	Body       Error
 	StatusCode int
 }
 
-func (response CreateOrderdefaultJSONResponse) VisitCreateOrderResponse(w http.ResponseWriter) error {
+func (response GetCourierdefaultJSONResponse) VisitGetCourierResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(response.StatusCode)
 
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetOrdersRequestObject struct {
+type ReportCourierLocationRequestObject struct {
+	CourierId openapi_types.UUID `json:"courierId"`
+	Body      *ReportCourierLocationJSONRequestBody
 }
 
-type GetOrdersResponseObject interface {
-	VisitGetOrdersResponse(w http.ResponseWriter) error
+type ReportCourierLocationResponseObject interface {
+	VisitReportCourierLocationResponse(w http.ResponseWriter) error
 }
 
-type GetOrders200JSONResponse []Order
+type ReportCourierLocation204Response struct {
+}
 
-func (response GetOrders200JSONResponse) VisitGetOrdersResponse(w http.ResponseWriter) error {
+func (response ReportCourierLocation204Response) VisitReportCourierLocationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type ReportCourierLocation400JSONResponse Error
+
+func (response ReportCourierLocation400JSONResponse) VisitReportCourierLocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReportCourierLocation404JSONResponse Error
+
+func (response ReportCourierLocation404JSONResponse) VisitReportCourierLocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReportCourierLocation409JSONResponse Error
+
+func (response ReportCourierLocation409JSONResponse) VisitReportCourierLocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReportCourierLocationdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response ReportCourierLocationdefaultJSONResponse) VisitReportCourierLocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RevokeQualificationRequestObject struct {
+	CourierId     openapi_types.UUID `json:"courierId"`
+	Qualification Qualification      `json:"qualification"`
+}
+
+type RevokeQualificationResponseObject interface {
+	VisitRevokeQualificationResponse(w http.ResponseWriter) error
+}
+
+type RevokeQualification204Response struct {
+}
+
+func (response RevokeQualification204Response) VisitRevokeQualificationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RevokeQualification400JSONResponse Error
+
+func (response RevokeQualification400JSONResponse) VisitRevokeQualificationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeQualification404JSONResponse Error
+
+func (response RevokeQualification404JSONResponse) VisitRevokeQualificationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeQualificationdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response RevokeQualificationdefaultJSONResponse) VisitRevokeQualificationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GrantQualificationRequestObject struct {
+	CourierId     openapi_types.UUID `json:"courierId"`
+	Qualification Qualification      `json:"qualification"`
+}
+
+type GrantQualificationResponseObject interface {
+	VisitGrantQualificationResponse(w http.ResponseWriter) error
+}
+
+type GrantQualification204Response struct {
+}
+
+func (response GrantQualification204Response) VisitGrantQualificationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type GrantQualification400JSONResponse Error
+
+func (response GrantQualification400JSONResponse) VisitGrantQualificationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GrantQualification404JSONResponse Error
+
+func (response GrantQualification404JSONResponse) VisitGrantQualificationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GrantQualificationdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GrantQualificationdefaultJSONResponse) VisitGrantQualificationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SimulateDispatchRequestObject struct {
+	Body *SimulateDispatchJSONRequestBody
+}
+
+type SimulateDispatchResponseObject interface {
+	VisitSimulateDispatchResponse(w http.ResponseWriter) error
+}
+
+type SimulateDispatch200JSONResponse DispatchSimulation
+
+func (response SimulateDispatch200JSONResponse) VisitSimulateDispatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SimulateDispatch400JSONResponse Error
+
+func (response SimulateDispatch400JSONResponse) VisitSimulateDispatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SimulateDispatchdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response SimulateDispatchdefaultJSONResponse) VisitSimulateDispatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateOrderRequestObject struct {
+	Body *CreateOrderJSONRequestBody
+}
+
+type CreateOrderResponseObject interface {
+	VisitCreateOrderResponse(w http.ResponseWriter) error
+}
+
+type CreateOrder201Response struct {
+}
+
+func (response CreateOrder201Response) VisitCreateOrderResponse(w http.ResponseWriter) error {
+	w.WriteHeader(201)
+	return nil
+}
+
+type CreateOrder400JSONResponse Error
+
+func (response CreateOrder400JSONResponse) VisitCreateOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrderdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response CreateOrderdefaultJSONResponse) VisitCreateOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetOrdersRequestObject struct {
+}
+
+type GetOrdersResponseObject interface {
+	VisitGetOrdersResponse(w http.ResponseWriter) error
+}
+
+type GetOrders200JSONResponse []Order
+
+func (response GetOrders200JSONResponse) VisitGetOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrdersdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetOrdersdefaultJSONResponse) VisitGetOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UnassignOrderRequestObject struct {
+	OrderId openapi_types.UUID `json:"orderId"`
+}
+
+type UnassignOrderResponseObject interface {
+	VisitUnassignOrderResponse(w http.ResponseWriter) error
+}
+
+type UnassignOrder204Response struct {
+}
+
+func (response UnassignOrder204Response) VisitUnassignOrderResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type UnassignOrder400JSONResponse Error
+
+func (response UnassignOrder400JSONResponse) VisitUnassignOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnassignOrder404JSONResponse Error
+
+func (response UnassignOrder404JSONResponse) VisitUnassignOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnassignOrder409JSONResponse Error
+
+func (response UnassignOrder409JSONResponse) VisitUnassignOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnassignOrderdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response UnassignOrderdefaultJSONResponse) VisitUnassignOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ManualAssignOrderRequestObject struct {
+	OrderId openapi_types.UUID `json:"orderId"`
+	Body    *ManualAssignOrderJSONRequestBody
+}
+
+type ManualAssignOrderResponseObject interface {
+	VisitManualAssignOrderResponse(w http.ResponseWriter) error
+}
+
+type ManualAssignOrder204Response struct {
+}
+
+func (response ManualAssignOrder204Response) VisitManualAssignOrderResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type ManualAssignOrder400JSONResponse Error
+
+func (response ManualAssignOrder400JSONResponse) VisitManualAssignOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ManualAssignOrder404JSONResponse Error
+
+func (response ManualAssignOrder404JSONResponse) VisitManualAssignOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ManualAssignOrder409JSONResponse Error
+
+func (response ManualAssignOrder409JSONResponse) VisitManualAssignOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ManualAssignOrderdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response ManualAssignOrderdefaultJSONResponse) VisitManualAssignOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ReassignOrderRequestObject struct {
+	OrderId openapi_types.UUID `json:"orderId"`
+	Body    *ReassignOrderJSONRequestBody
+}
+
+type ReassignOrderResponseObject interface {
+	VisitReassignOrderResponse(w http.ResponseWriter) error
+}
+
+type ReassignOrder204Response struct {
+}
+
+func (response ReassignOrder204Response) VisitReassignOrderResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type ReassignOrder400JSONResponse Error
+
+func (response ReassignOrder400JSONResponse) VisitReassignOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReassignOrder404JSONResponse Error
+
+func (response ReassignOrder404JSONResponse) VisitReassignOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReassignOrder409JSONResponse Error
+
+func (response ReassignOrder409JSONResponse) VisitReassignOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReassignOrderdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response ReassignOrderdefaultJSONResponse) VisitReassignOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ConfirmDeliveryRequestObject struct {
+	OrderId openapi_types.UUID `json:"orderId"`
+	Body    *ConfirmDeliveryJSONRequestBody
+}
+
+type ConfirmDeliveryResponseObject interface {
+	VisitConfirmDeliveryResponse(w http.ResponseWriter) error
+}
+
+type ConfirmDelivery200Response struct {
+}
+
+func (response ConfirmDelivery200Response) VisitConfirmDeliveryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type ConfirmDelivery400JSONResponse Error
+
+func (response ConfirmDelivery400JSONResponse) VisitConfirmDeliveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmDelivery404JSONResponse Error
+
+func (response ConfirmDelivery404JSONResponse) VisitConfirmDeliveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmDelivery409JSONResponse Error
+
+func (response ConfirmDelivery409JSONResponse) VisitConfirmDeliveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmDeliverydefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response ConfirmDeliverydefaultJSONResponse) VisitConfirmDeliveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type FailDeliveryRequestObject struct {
+	OrderId openapi_types.UUID `json:"orderId"`
+	Body    *FailDeliveryJSONRequestBody
+}
+
+type FailDeliveryResponseObject interface {
+	VisitFailDeliveryResponse(w http.ResponseWriter) error
+}
+
+type FailDelivery200Response struct {
+}
+
+func (response FailDelivery200Response) VisitFailDeliveryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type FailDelivery400JSONResponse Error
+
+func (response FailDelivery400JSONResponse) VisitFailDeliveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FailDelivery404JSONResponse Error
+
+func (response FailDelivery404JSONResponse) VisitFailDeliveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FailDelivery409JSONResponse Error
+
+func (response FailDelivery409JSONResponse) VisitFailDeliveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FailDeliverydefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response FailDeliverydefaultJSONResponse) VisitFailDeliveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetOrderDispatchDecisionsRequestObject struct {
+	OrderId openapi_types.UUID `json:"orderId"`
+}
+
+type GetOrderDispatchDecisionsResponseObject interface {
+	VisitGetOrderDispatchDecisionsResponse(w http.ResponseWriter) error
+}
+
+type GetOrderDispatchDecisions200JSONResponse []DispatchDecision
+
+func (response GetOrderDispatchDecisions200JSONResponse) VisitGetOrderDispatchDecisionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrderDispatchDecisionsdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetOrderDispatchDecisionsdefaultJSONResponse) VisitGetOrderDispatchDecisionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type TrackOrderRequestObject struct {
+	Token string `json:"token"`
+}
+
+type TrackOrderResponseObject interface {
+	VisitTrackOrderResponse(w http.ResponseWriter) error
+}
+
+type TrackOrder200JSONResponse OrderTracking
+
+func (response TrackOrder200JSONResponse) VisitTrackOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TrackOrder404JSONResponse Error
+
+func (response TrackOrder404JSONResponse) VisitTrackOrderResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetOrdersdefaultJSONResponse struct {
+type TrackOrder410JSONResponse Error
+
+func (response TrackOrder410JSONResponse) VisitTrackOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(410)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TrackOrder429JSONResponse Error
+
+func (response TrackOrder429JSONResponse) VisitTrackOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TrackOrderdefaultJSONResponse struct {
 	Body       Error
 	StatusCode int
 }
 
-func (response GetOrdersdefaultJSONResponse) VisitGetOrdersResponse(w http.ResponseWriter) error {
+func (response TrackOrderdefaultJSONResponse) VisitTrackOrderResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(response.StatusCode)
 
@@ -297,12 +1460,48 @@ type StrictServerInterface interface {
 	// Добавить курьера
 	// (POST /api/v1/couriers)
 	CreateCourier(ctx context.Context, request CreateCourierRequestObject) (CreateCourierResponseObject, error)
+	// Получить курьера
+	// (GET /api/v1/couriers/{courierId})
+	GetCourier(ctx context.Context, request GetCourierRequestObject) (GetCourierResponseObject, error)
+	// Сообщить координаты курьера
+	// (PUT /api/v1/couriers/{courierId}/location)
+	ReportCourierLocation(ctx context.Context, request ReportCourierLocationRequestObject) (ReportCourierLocationResponseObject, error)
+	// Отозвать допуск у курьера
+	// (DELETE /api/v1/couriers/{courierId}/qualifications/{qualification})
+	RevokeQualification(ctx context.Context, request RevokeQualificationRequestObject) (RevokeQualificationResponseObject, error)
+	// Выдать допуск курьеру
+	// (PUT /api/v1/couriers/{courierId}/qualifications/{qualification})
+	GrantQualification(ctx context.Context, request GrantQualificationRequestObject) (GrantQualificationResponseObject, error)
+	// Пробная диспетчеризация заказа
+	// (POST /api/v1/dispatch/simulate)
+	SimulateDispatch(ctx context.Context, request SimulateDispatchRequestObject) (SimulateDispatchResponseObject, error)
 	// Создать заказ
 	// (POST /api/v1/orders)
 	CreateOrder(ctx context.Context, request CreateOrderRequestObject) (CreateOrderResponseObject, error)
 	// Получить все незавершенные заказы
 	// (GET /api/v1/orders/active)
 	GetOrders(ctx context.Context, request GetOrdersRequestObject) (GetOrdersResponseObject, error)
+	// Снять заказ с курьера
+	// (DELETE /api/v1/orders/{orderId}/assignment)
+	UnassignOrder(ctx context.Context, request UnassignOrderRequestObject) (UnassignOrderResponseObject, error)
+	// Назначить заказ курьеру вручную
+	// (POST /api/v1/orders/{orderId}/assignment)
+	ManualAssignOrder(ctx context.Context, request ManualAssignOrderRequestObject) (ManualAssignOrderResponseObject, error)
+	// Передать заказ другому курьеру
+	// (PUT /api/v1/orders/{orderId}/assignment)
+	ReassignOrder(ctx context.Context, request ReassignOrderRequestObject) (ReassignOrderResponseObject, error)
+	// Подтвердить доставку заказа
+	// (POST /api/v1/orders/{orderId}/delivery-confirmation)
+	ConfirmDelivery(ctx context.Context, request ConfirmDeliveryRequestObject) (ConfirmDeliveryResponseObject, error)
+	// Сообщить о неудачной доставке
+	// (POST /api/v1/orders/{orderId}/delivery-failure)
+	FailDelivery(ctx context.Context, request FailDeliveryRequestObject) (FailDeliveryResponseObject, error)
+	// Получить историю назначения заказа
+	// (GET /api/v1/orders/{orderId}/dispatch-decisions)
+	GetOrderDispatchDecisions(ctx context.Context, request GetOrderDispatchDecisionsRequestObject) (GetOrderDispatchDecisionsResponseObject, error)
+	// Отследить заказ
+	// (GET /track/{token})
+	TrackOrder(ctx context.Context, request TrackOrderRequestObject) (TrackOrderResponseObject, error)
 }
 
 type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
@@ -369,10 +1568,153 @@ func (sh *strictHandler) CreateCourier(ctx echo.Context) error {
 	return nil
 }
 
+// GetCourier operation middleware
+func (sh *strictHandler) GetCourier(ctx echo.Context, courierId openapi_types.UUID) error {
+	var request GetCourierRequestObject
+
+	request.CourierId = courierId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCourier(ctx.Request().Context(), request.(GetCourierRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCourier")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCourierResponseObject); ok {
+		return validResponse.VisitGetCourierResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ReportCourierLocation operation middleware
+func (sh *strictHandler) ReportCourierLocation(ctx echo.Context, courierId openapi_types.UUID) error {
+	var request ReportCourierLocationRequestObject
+
+	request.CourierId = courierId
+
+	var body ReportCourierLocationJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ReportCourierLocation(ctx.Request().Context(), request.(ReportCourierLocationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReportCourierLocation")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ReportCourierLocationResponseObject); ok {
+		return validResponse.VisitReportCourierLocationResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// RevokeQualification operation middleware
+func (sh *strictHandler) RevokeQualification(ctx echo.Context, courierId openapi_types.UUID, qualification Qualification) error {
+	var request RevokeQualificationRequestObject
+
+	request.CourierId = courierId
+	request.Qualification = qualification
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RevokeQualification(ctx.Request().Context(), request.(RevokeQualificationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevokeQualification")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(RevokeQualificationResponseObject); ok {
+		return validResponse.VisitRevokeQualificationResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GrantQualification operation middleware
+func (sh *strictHandler) GrantQualification(ctx echo.Context, courierId openapi_types.UUID, qualification Qualification) error {
+	var request GrantQualificationRequestObject
+
+	request.CourierId = courierId
+	request.Qualification = qualification
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GrantQualification(ctx.Request().Context(), request.(GrantQualificationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GrantQualification")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GrantQualificationResponseObject); ok {
+		return validResponse.VisitGrantQualificationResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// SimulateDispatch operation middleware
+func (sh *strictHandler) SimulateDispatch(ctx echo.Context) error {
+	var request SimulateDispatchRequestObject
+
+	var body SimulateDispatchJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SimulateDispatch(ctx.Request().Context(), request.(SimulateDispatchRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SimulateDispatch")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SimulateDispatchResponseObject); ok {
+		return validResponse.VisitSimulateDispatchResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // CreateOrder operation middleware
 func (sh *strictHandler) CreateOrder(ctx echo.Context) error {
 	var request CreateOrderRequestObject
 
+	var body CreateOrderJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.CreateOrder(ctx.Request().Context(), request.(CreateOrderRequestObject))
 	}
@@ -415,24 +1757,277 @@ func (sh *strictHandler) GetOrders(ctx echo.Context) error {
 	return nil
 }
 
+// UnassignOrder operation middleware
+func (sh *strictHandler) UnassignOrder(ctx echo.Context, orderId openapi_types.UUID) error {
+	var request UnassignOrderRequestObject
+
+	request.OrderId = orderId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UnassignOrder(ctx.Request().Context(), request.(UnassignOrderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UnassignOrder")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(UnassignOrderResponseObject); ok {
+		return validResponse.VisitUnassignOrderResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ManualAssignOrder operation middleware
+func (sh *strictHandler) ManualAssignOrder(ctx echo.Context, orderId openapi_types.UUID) error {
+	var request ManualAssignOrderRequestObject
+
+	request.OrderId = orderId
+
+	var body ManualAssignOrderJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ManualAssignOrder(ctx.Request().Context(), request.(ManualAssignOrderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ManualAssignOrder")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ManualAssignOrderResponseObject); ok {
+		return validResponse.VisitManualAssignOrderResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ReassignOrder operation middleware
+func (sh *strictHandler) ReassignOrder(ctx echo.Context, orderId openapi_types.UUID) error {
+	var request ReassignOrderRequestObject
+
+	request.OrderId = orderId
+
+	var body ReassignOrderJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ReassignOrder(ctx.Request().Context(), request.(ReassignOrderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReassignOrder")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ReassignOrderResponseObject); ok {
+		return validResponse.VisitReassignOrderResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ConfirmDelivery operation middleware
+func (sh *strictHandler) ConfirmDelivery(ctx echo.Context, orderId openapi_types.UUID) error {
+	var request ConfirmDeliveryRequestObject
+
+	request.OrderId = orderId
+
+	var body ConfirmDeliveryJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ConfirmDelivery(ctx.Request().Context(), request.(ConfirmDeliveryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ConfirmDelivery")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ConfirmDeliveryResponseObject); ok {
+		return validResponse.VisitConfirmDeliveryResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// FailDelivery operation middleware
+func (sh *strictHandler) FailDelivery(ctx echo.Context, orderId openapi_types.UUID) error {
+	var request FailDeliveryRequestObject
+
+	request.OrderId = orderId
+
+	var body FailDeliveryJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.FailDelivery(ctx.Request().Context(), request.(FailDeliveryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FailDelivery")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(FailDeliveryResponseObject); ok {
+		return validResponse.VisitFailDeliveryResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetOrderDispatchDecisions operation middleware
+func (sh *strictHandler) GetOrderDispatchDecisions(ctx echo.Context, orderId openapi_types.UUID) error {
+	var request GetOrderDispatchDecisionsRequestObject
+
+	request.OrderId = orderId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOrderDispatchDecisions(ctx.Request().Context(), request.(GetOrderDispatchDecisionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOrderDispatchDecisions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetOrderDispatchDecisionsResponseObject); ok {
+		return validResponse.VisitGetOrderDispatchDecisionsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// TrackOrder operation middleware
+func (sh *strictHandler) TrackOrder(ctx echo.Context, token string) error {
+	var request TrackOrderRequestObject
+
+	request.Token = token
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TrackOrder(ctx.Request().Context(), request.(TrackOrderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TrackOrder")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TrackOrderResponseObject); ok {
+		return validResponse.VisitTrackOrderResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9xVT28bRRT/KqsHx1Vtt1zYIwEhpIgeuIAQh8V+caby7iyz47RWtFLsQBspUXPhgHoo",
-	"KnyBjcnKi0Pcr/DeN0IzEyduPHFcEVURF8u7O/Pm9++92YW2TDKZYqpziHYhb29jEtu/G7KvBCrzN1My",
-	"Q6UF2g+iY347mLeVyLSQKURAv9EpVXTOI6r5Z6ppSiWPaMZ7EMKWVEmsIYJ+X3QgBD3IECLItRJpF4oQ",
-	"erIdu0K78LHCLYjgo8YVsMYFqsbmfF0RQhon6MXxDx8vn1GEoPCnvlDYgeh7sDBshYXDf7jcJX98gm1t",
-	"TvlCKemRoC07vsNf0YxOA5rxAdV0QlOqF9mLVD96eAVNpBq7qMwpCeZ53PVV/IMqmvKQR9erruZn8V3V",
-	"9THbXND8XXLPlnF8a4qJVCT9BKKmj8JgedN3t2y6hvkZmCo+qF/j0xvDeEsMEpFuYtrV2xC1PMHLM0Rf",
-	"mt/Q1GSXZkZ6Plok0rqVyEWuXG0fn8eqc1/7ytcnKxrErBfplvQAf80jGlPFL6ikyuR3QmXA+/zCPU15",
-	"n/f4iCqjMo3DwPDkIb01n+2iParNHn5ONb80n60ZVNKYZjQN330z5X0jgNA9A++bp3G3iyr4HHtiB9UA",
-	"QthBlTtkrQfNB02jjswwjTMBETyyr0LIYr1trWjEmWjstBptFzv7rovaQ/N3mtHEQjrjY0ftrX0wTGsT",
-	"noDGPKSKf1kiDRaDsuJ+1YEIvkS9MT/RGJFnMs1dOB42m27wpBpTCyTOsp5wzjSe5M5kZ6gNk8Ykv833",
-	"eVcVl8bGSsUD5+s1on9emHNA53xIf5t55AwegV28Ffd7+r0grkLm5q4Px+vLMVjauOb9JInVYO7FesIX",
-	"IWQyX9PPU5rRiU3ZRdnFauWSiRsKY41zaV0/Ya4/k53BncmzMBF9Gr26AgjFUpBaHtqr3f3kPcP3n50N",
-	"aEwlnVFNp24CUO1wfPrBcfCha2g6N3OYaj4O6MSOpnMzsAI6oxn9ZSdzfW864dfVkTWr5yNOmsvIJmPt",
-	"juChfWWsscUnVBoQNAl4GPBzquiMj/hlwCOjkL26bNtR6QS8oWXctXgHcb0XFry5QSOP+I24rcUO3sEl",
-	"E9iUTqzzFe/xgc2s0ahagMCHvpvnsQvCh7h3nNP/61tnbSeKoij+DQAA//8JDQamhg0AAA==",
+	"H4sIAAAAAAAC/+xc72/bxvn/Vwh+vy9agLGcNBswv0uTtmubtVmSrR3SYGCks81GIhWSSuMFAiypqdM5",
+	"iIGuQ4diaZv2xfaSVqSYkSX5X7j7j4bnuSN5JE8SFceOkvpNYtPk3XPPj889v+7u6mWnVndsYvuevnJX",
+	"98rrpGbij+edhmsRF36su06duL5F8A9WBf6tEK/sWnXfcmx9Raf/oj3apyPWpiH7koZ0QAPWpmO2qRv6",
+	"quPWTF9f0RsNq6Ibur9RJ/qK7vmuZa/pTUOvOmWTD3RX/3+XrOor+v+VEsJKgqrSxei9pqHbZo0o6Riy",
+	"nfwcTUN3ya2G5ZKKvnJNRzJwBGny6/FXzo3PSdmHWQQTznmetWbXiO3n2VHmr7w/F1c0OmAdtske0D7b",
+	"pMFsJmUWkEw6heoLxDetqvd6SNDQbzXMqrVq8QH4OnxS82ZN+Uf5M70ZD2y6rrkBv3t1QlQMeUwHsHw6",
+	"Zi3WZg+0Uxod0H3aB67QgUa7GmvRPgiSjmiPdQx8tEn79CkN6ZD2tTXi8K+GJbaVLMmyfbJGXJzbd1xz",
+	"jVyqmmVSfElXpK/yK5qi63ypkriyFOS4PEW9IlleJnXHVRhG1fQVXP0vDZGnbRoAv+gT0H9gH2vRgN2T",
+	"la3iNG5UScI3u1G7wdlWdWzF0N/SMd2nTw47uIvrIZVzKvK/QQEP2Y6G0wxRHQY01OiIBhpr0z6qyJd0",
+	"TEe0nzHzJY3+k7XoPn+9r9E9oI4GdETHoCjdZPADWAvrsC2wSRqynRTtpk9O+VaNzMQJkAFnl0qQF0jV",
+	"uk3cjfOOvWrB2MKCswBXUdnp93RMe4aGRoI4wbbpM5nwgHODPQA7GdMx3WVf05Dup5jCOrqh1yz7IrHX",
+	"/HV95fRs5KuQqYt517SqDZfk1+ES01PqzY9sk4Zsi4YgQwMlSQ/gGYp3U/tMV6xqB0XI2hrt0TEd0uAz",
+	"fdZKYCG+u8EpWDUbVV9fWTWrHjFyFNEx7XKu0hDBh/Y4DtGAdoF/yGjUpb5Gu3RM91B7AlR9+JJ2aQAP",
+	"hGK2ELsC2ktU5objVIlp5zgs+KTkseXVTb+8ft60KxZo4cvaDo1ono+mbCL5UXOjEN+cbuQpttOwGILU",
+	"LM+z7LX0zqPGqwPEpoFsR3QMGMYRgt1DObbBbug4tSDWSaaWNQxkhahcUNsP6Dg9+bPUNJwOeAt5cR+U",
+	"fw73JC0ozvBpmnWBlC1PDUORznmKRf1EA9ZiLTqEdaD0RnTEtjMIzLZ1o9gOm1d0hePwnKreZdt0Fzem",
+	"ER1Fwp5b/yukbFVmb1Icx0ZsBynZQf+E3Z9zUzGOwGN03Mr8rIP9ckAD+H9ulxlfiWaV+WfIqjVNN69Y",
+	"tUZ1wiZpVn3i2qZv3Vbq57dgr/QJDWNbYvfw3x3cE7NqKqwScR1RnUMQyOxh7CZwIaogqpgTyVdDKlGg",
+	"N1nD5x8qw/sUd4qx+DK51SCeyq18jlDjtlNtKPeJH+gu+zswk+/dVq1Rk3duyVP/glhr60pjo33WkrzN",
+	"ITgD7N5kXw89Pf4R/AG9CtjktwHqwaVgLbYj07Ocpyfr5iX+vFiqisnvuK7jzuffaQj5Id3Nbn6W7b91",
+	"RhnS1IjnmWuqEX/GaKnF2tlRC3h8ybiqlV2UdCK9uDt5Oj6dwVtD38h/9Jf5BHJHh1FUpH5EvpiYWVl3",
+	"auQCqTv+PLp9BKH3DC/2+ALmaSaZ4Xgqwp3A+I8B/RVsN+1KFdY2g4P4+e+jl5uGXrfKNxv1eRhfdy3H",
+	"tfyNQlNdil7GpQL/y/OlPnCUy8mXKog/ElxDr4MHUnvwbxTG0vBwmJcT6gSJLkKCS+V+TM01ptUrT/8v",
+	"yHYI7EKeIeij7fDgD1MQafeI2MDIa7rnm3bFdGH+dceHxbrO34iNP5hrVlWG1GTBaQ2cEEZEASoPhGdO",
+	"T+7UXeJ5k+eTdVW1WT8RbnMYK1Sfx7fgE7GO/FQixtBETLDLOknILiIvGqR8rxTpZrXsrDtV3HwqVtmy",
+	"p7DqqmuWbwrBKSPii8+B08Q3r5CyY1e8SXAL+RX4PwWtWuwR7uNPPH0QBQO7bFsEA7ns1CNgj6HRfmTh",
+	"qUBwoMruPBQw0AfrYu0ljf6ioZT24AHAvxzmSFLRTk2iKs5s0B6Mzb+E+PQAB+6KQUO2Y4gkjEwyUtMF",
+	"dkz4CDENpN8uGs9761a9FpVIskqpnKIQBwp76mL6WMVUSWzf9BtKLQE9YG1Q9uzk090uMaIKqeZIbBza",
+	"uHKL/3XbV0GFLaQNSus4jF7k4sCT7GBOOIfOlKWKP8fg+dTMO59M8hFjQ4eh6ZBXALpqx9HQljORLx2L",
+	"N+INXRlQTghWHiGOdfnntD9fjum7CAjzBYx9Ho+Am9DVsAIAq4NUrKBVkTubxDvf8c3qn4skH/LL5k+K",
+	"FwKvwvvTin84YJomWbqzdO3qRl21iJ9pSA8SPgUqPilcQX+duDUT0N8lq661RlzADd3Q62alkorgJBjy",
+	"SLkB7ugVWD7X93N160Oyca7hrytoE2jHtQudvRCz2wCrbGtJoz9xgI8f0YDvkb0kKEEtGPEUHE+Po/nc",
+	"ww2BbaI33uJ7Ocy5TkyISCKt1T89de7S+6c+JBuJjE0kGdj7NjFd4kbE38Df3o106oNPrurZwtAHn1zV",
+	"ML7CbPwBTM0esIfa5StnfvNbDYsEofYO/CItDoxOK1dNq6a5TpUYmV2Hb1oQWDyN3RfpEwFKf0VNQr3D",
+	"2hHSmqxp3ffrehNkZNmrzgQ3CUx/i3MW2czDQPxNoggiHEPD3a6FQU4bXwIi92jAvuI5UBl5x1g/yRTI",
+	"gDbLrwJxV74w19aIq0UVQsiSEZdXGfTTS8tLywgWdWKbdUtf0d/CR6CK/joqWcmsW6Xbp0uCF/hsjagA",
+	"8UdeiUPO7/ClJVu6qOShb8ru5RatIw0uOicAWvp7xD8fzYgZgLpje1ztzywv8+3U9kVTilmvV4VHVvpc",
+	"VH44TBROF0xMBDebhioYPcBSwojD5lgIuM2LE6K8OQeJ0yjjmUsVHT/EicSAQ0SjVjPdjUgWxRgPiRnH",
+	"KyhPiB13UcvEsFk3Ii3E8y4xfRKxlqMz8fy3ncrGC2OPlFNU8ej7hEC9mVOk0xNSDZOle3Z5+YWRXkiy",
+	"Gm7z+zSkPY4ANOR0/O7Y6WDb3KCT/U2juwhNI+7Y7GOgF6I7uCiW8O10lYW3sxBXuht7ps3DwV1qLpCk",
+	"5FK1tCi8jx6CuxiqfIhJ0Ig47Zo14iMwXztMWIE7OKB+sn/L/nniWPlugxiSxGZVIq8fEr4LoHbU7Dc/",
+	"WJ9dPnsM6vl9rqUAssPPuHAWd8+Yz1JKcpq43ihqMjkP7GHWbOQOKk6YsBtIUUAStsf7OSClzovH7126",
+	"sqSluS4yxrzyHEc4uwisT0EY7D7r4NyiajPAGk2QSWxgfg17jNgWb0UCl3kXyQgiJzQbGmtI/Sa+GGQT",
+	"/xq+N9Bg/RiQ4S84KawcIssOsItDP7q2fMK28CZZm/u6mKkRH0bLRWj5Oqk/CObkIIW3MWZ6G18ddHnx",
+	"XoW6zVNtUEknZCCYm15O3u04+wq7HQuBl8fl/ciijQiBIlRftLKkUvXP0h1H2EwW5lKdHKNk6wzYwwgK",
+	"svbep88WZnt4nEVhJfzOuWekO65Ld1O/N7mZVIlPim0me6J5tc/aMZFy02BSKgv4X+OaANtGh2ycr7Kx",
+	"HfDKlrQkbca2M4kzzOaNNNYBDMcsH90TyW6xDyQS7mhRWUcBwredmyRdX3gVINiYVgNRT3wrs8jJk89x",
+	"ukHhaZ4A7evgmP7A2sLWg7gfPK6xsU5W1ZtGYfeTlypFX+iLRQztDRTrgNc+wUEzeH4dTHQTYaFLgzfz",
+	"0Z1r2v4JCJyAwAkIpEDgG7ZNe5EV9iYU2Vkn5XJURGdtyRPFWQxNi2Y7WUc0cLXRdjEyUxwR6NI9tgMn",
+	"e3bB/4H01wFqUz9ffInBQxMdS8mo/dQJKHAnYLx4WDmixHI1FtRxeOnARIuOo+wRX0MOXaIqddRzfERJ",
+	"2cktzSqp/6MQzwrEVctHuIDnSi4tBowsRnYJ6w27aFDYPRBOLHHtpJt1ZIvGqrY3nxm38FEvt4PD/s2+",
+	"4sf02EM8v8iL+bwyQoMJ+Vde1eDdl0dW0+DDq3gcxyGvVT1jYUJclaYoVLBkluFExwuohvKAcA+hHbaw",
+	"+/IBLsndVNUBPubmcBwFUqGQr3N5tLAkFOpwV/TbNEtm6r6EedIXY5EYEt476wB2YTIph1u57rrvpL+K",
+	"JFUAOeUeHzrXja7AtT/ZnPQI2Z476Mi0eeY9/+Qk2ossK52d1usUszLPvV+fx/+d5IcqejFfXsZVIiwm",
+	"QlLbiNzkjHecdMMyDHd9F2g7KWK883Vg5DEixSQBZwgAHHyTmXOHb4eZ3AnryCXqscpJy/d4/MG0G2b1",
+	"3KuGHEdWMpKuy5kRbGfOvQ+zspTa8OYJgqbDYNakTsBvAcEvArUJ8KcgeMgL6IAQIiGAaQlAg4WBw0d5",
+	"nJLAaULadQRV+XlSuiqEjOptsWefYWzkqErk9MShdRVKLmn03zFI4vjiIGd8YCfT9wN1wi5Gv2N0yeRT",
+	"f9kakHmCpK8IkkpadYKjC+tEYmvMnnxoNEaBVxpNf8yupiB4TQ9eK6JN/FQ5ey1WYQ81A+RyOK1AfX61",
+	"Dh3mzqJk781S3K2Vz8xxoqVe918lfiqvNlNCKL/mgt/JwnM0mzxnEKcICqXcJxtgrm5xfDj5iPbFisTu",
+	"PuCrFVa/lwk1x3gRQy86ojGZJy8DYF8emKaKg9hLGF2KFbVF4qV2ytObC5XuS4TZm3it3PSqgwomV6UL",
+	"954TIbPtrYbGtnj4nVFR1qE9+dht5CLz1byRvbrP0Irc3PdmtlE27l6SsyxRAytr43WN+Wv10jgM1xCe",
+	"gHD6SkaVyqauo+OSQRlD3MMrzflDts8DyCfdDguGqgoiks70+ORlWvj9xe0EHRfQ3f4MRBUF91MVcQni",
+	"XAcNla0aoeK4m8Y249sSAzzUGnJlVCQ7jVktGtlWf+FZYMNGutXjAM8L9Lk7jnsmDbkVAoSO+WGfKSW+",
+	"7A2R3itYnjmCmmSWLa9teTLkySbcLh4qq3kK58WHW0NKd33nJrGnnWNjHX4Ohm2J5gxx3Jv3P3yFZ2Dy",
+	"jsSShmSKkmh0okVjLfmWjdRFRHSM3RYj7mtlLnLMXR8SarIzg5giro+UDgvlbpmGIz19HngEPK/H7kX4",
+	"RIdLGv0PWvl+5F4d8CvbODRgO2cvua4Gbs/exF6kQNXome/gxntaiqXufkbeQVIX0AgRYSAwQRwqeCqg",
+	"SYg35DXMscyjsdTcEh3xmWndqA9Tbfs4T/Cl749a1AN8ibRQkQBan4kW3jC691zKz+/N8DFOH4fD9Rh3",
+	"vIGWoXYno3AcWvp0gJSdOQ7v5zGizX3cVIeQbUtdECWZ5EsGZHHjhr5y7Xq2Hz0++BPmW4XSn6av6bh2",
+	"HTql5bsvrl1vXm/+bwDLHMvSi2QAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,48 @@
+package jobs
+
+import (
+	"context"
+	outb "delivery/internal/adapters/out/outbox"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+	"github.com/labstack/gommon/log"
+	"github.com/robfig/cron/v3"
+	"time"
+)
+
+var _ cron.Job = &outboxCleanupJob{}
+
+// outboxCleanupJob удаляет опубликованные сообщения outbox, чтобы таблица не росла бесконечно.
+// Сообщения хранятся еще retention после публикации - для разбора инцидентов
+type outboxCleanupJob struct {
+	ob        outb.OutboxRepository
+	retention time.Duration
+	clock     ports.Clock
+}
+
+func NewOutboxCleanupJob(ob outb.OutboxRepository, retention time.Duration, clock ports.Clock) (cron.Job, error) {
+	if ob == nil {
+		return nil, errs.NewValueIsRequiredError("ob")
+	}
+
+	if retention <= 0 {
+		return nil, errs.NewValueIsInvalidError("retention")
+	}
+
+	if clock == nil {
+		return nil, errs.NewValueIsRequiredError("clock")
+	}
+
+	return &outboxCleanupJob{
+		ob:        ob,
+		retention: retention,
+		clock:     clock,
+	}, nil
+}
+
+func (job *outboxCleanupJob) Run() {
+	err := job.ob.DeleteProcessedMessages(context.Background(), job.clock.Now().UTC().Add(-job.retention))
+	if err != nil {
+		log.Error(err)
+	}
+}
@@ -3,12 +3,12 @@ package jobs
 import (
 	"context"
 	outb "delivery/internal/adapters/out/outbox"
+	"delivery/internal/core/ports"
 	"delivery/internal/pkg/ddd"
 	"delivery/internal/pkg/errs"
 	"delivery/internal/pkg/outbox"
 	"github.com/labstack/gommon/log"
 	"github.com/robfig/cron/v3"
-	"time"
 )
 
 var _ cron.Job = &moveCouriersJob{}
@@ -17,9 +17,11 @@ type outboxJob struct {
 	ob       outb.OutboxRepository
 	mediatr  ddd.Mediatr
 	registry outbox.EventRegistry
+	clock    ports.Clock
 }
 
-func NewOutboxJob(ob outb.OutboxRepository, mediatr ddd.Mediatr, registry outbox.EventRegistry) (cron.Job, error) {
+func NewOutboxJob(ob outb.OutboxRepository, mediatr ddd.Mediatr, registry outbox.EventRegistry,
+	clock ports.Clock) (cron.Job, error) {
 	if ob == nil {
 		return nil, errs.NewValueIsRequiredError("ob")
 	}
@@ -32,10 +34,15 @@ func NewOutboxJob(ob outb.OutboxRepository, mediatr ddd.Mediatr, registry outbox
 		return nil, errs.NewValueIsRequiredError("registry")
 	}
 
+	if clock == nil {
+		return nil, errs.NewValueIsRequiredError("clock")
+	}
+
 	return &outboxJob{
 		ob:       ob,
 		mediatr:  mediatr,
 		registry: registry,
+		clock:    clock,
 	}, nil
 }
 
@@ -71,7 +78,7 @@ func (job *outboxJob) Run() {
 			continue
 		}
 
-		now := time.Now().UTC()
+		now := job.clock.Now().UTC()
 		msg.ProcessedAtUtc = &now
 		processedMessages = append(processedMessages, msg)
 	}
@@ -2,9 +2,23 @@ package ddd
 
 import (
 	"github.com/google/uuid"
+	"time"
 )
 
 type DomainEvent interface {
 	GetID() uuid.UUID
 	GetName() string
+	GetOccurredAt() time.Time
+}
+
+// TransientEvent - событие, потеря которого при сбое допустима, например очередное положение курьера.
+// Такие события не сохраняются в outbox, а публикуются сразу после фиксации транзакции
+type TransientEvent interface {
+	DomainEvent
+	IsTransient() bool
+}
+
+func IsTransient(event DomainEvent) bool {
+	transient, ok := event.(TransientEvent)
+	return ok && transient.IsTransient()
 }
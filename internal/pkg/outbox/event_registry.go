@@ -6,7 +6,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
-	"time"
 )
 
 type EventRegistry interface {
@@ -45,7 +44,7 @@ func EncodeDomainEvent(domainEvent ddd.DomainEvent) (Message, error) {
 		ID:             domainEvent.GetID(),
 		Name:           domainEvent.GetName(),
 		Payload:        payload,
-		OccurredAtUtc:  time.Now().UTC(),
+		OccurredAtUtc:  domainEvent.GetOccurredAt().UTC(),
 		ProcessedAtUtc: nil,
 	}, nil
 }
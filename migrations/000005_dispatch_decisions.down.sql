@@ -0,0 +1 @@
+drop table dispatch_decisions
\ No newline at end of file
@@ -0,0 +1,13 @@
+create table dispatch_decisions
+(
+    id         uuid                     not null
+        constraint dispatch_decisions_pk
+            primary key,
+    order_id   uuid                     not null,
+    courier_id uuid                     null,
+    candidates jsonb                    not null,
+    decided_at TIMESTAMP with time zone not null
+);
+
+create index dispatch_decisions_order_id_idx
+    on dispatch_decisions (order_id);
\ No newline at end of file
@@ -0,0 +1,2 @@
+alter table orders
+    drop column dispatch_attempts;
\ No newline at end of file
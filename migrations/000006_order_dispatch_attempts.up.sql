@@ -0,0 +1,2 @@
+alter table orders
+    add dispatch_attempts integer default 0 not null;
\ No newline at end of file
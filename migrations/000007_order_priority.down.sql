@@ -0,0 +1,7 @@
+drop index orders_status_created_at_idx;
+
+alter table orders
+    drop column created_at;
+
+alter table orders
+    drop column priority;
\ No newline at end of file
@@ -0,0 +1,8 @@
+alter table orders
+    add priority varchar(32) default 'standard' not null;
+
+alter table orders
+    add created_at TIMESTAMP with time zone default now() not null;
+
+create index orders_status_created_at_idx
+    on orders (status, created_at);
\ No newline at end of file
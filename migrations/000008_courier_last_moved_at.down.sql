@@ -0,0 +1,2 @@
+alter table couriers
+    drop column last_moved_at;
\ No newline at end of file
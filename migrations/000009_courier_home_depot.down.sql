@@ -0,0 +1,3 @@
+alter table couriers
+    drop column home_depot_x,
+    drop column home_depot_y;
\ No newline at end of file
@@ -0,0 +1,3 @@
+alter table couriers
+    add home_depot_x int null,
+    add home_depot_y int null;
\ No newline at end of file
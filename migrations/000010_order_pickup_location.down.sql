@@ -0,0 +1,3 @@
+alter table orders
+    drop column pickup_location_x,
+    drop column pickup_location_y;
\ No newline at end of file
@@ -0,0 +1,3 @@
+alter table orders
+    add pickup_location_x int null,
+    add pickup_location_y int null;
\ No newline at end of file
@@ -0,0 +1,3 @@
+alter table orders
+    drop column confirmation_code,
+    drop column delivery_attempts;
\ No newline at end of file
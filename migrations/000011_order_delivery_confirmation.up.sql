@@ -0,0 +1,3 @@
+alter table orders
+    add confirmation_code varchar(16) not null default '',
+    add delivery_attempts integer     not null default 0;
\ No newline at end of file
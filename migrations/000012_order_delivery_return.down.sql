@@ -0,0 +1,4 @@
+alter table orders
+    drop column return_location_x,
+    drop column return_location_y,
+    drop column retry_delivery;
\ No newline at end of file
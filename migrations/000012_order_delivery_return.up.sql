@@ -0,0 +1,4 @@
+alter table orders
+    add return_location_x int     null,
+    add return_location_y int     null,
+    add retry_delivery    boolean not null default false;
\ No newline at end of file
@@ -0,0 +1,5 @@
+alter table orders
+    drop column weight;
+
+alter table storage_places
+    drop column max_weight;
\ No newline at end of file
@@ -0,0 +1,5 @@
+alter table orders
+    add weight int not null default 0;
+
+alter table storage_places
+    add max_weight int not null default 0;
\ No newline at end of file
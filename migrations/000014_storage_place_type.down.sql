@@ -0,0 +1,5 @@
+alter table orders
+    drop column handling;
+
+alter table storage_places
+    drop column type;
\ No newline at end of file
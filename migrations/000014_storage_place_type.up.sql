@@ -0,0 +1,5 @@
+alter table orders
+    add handling varchar(32) not null default 'standard';
+
+alter table storage_places
+    add type varchar(32) not null default 'standard';
\ No newline at end of file
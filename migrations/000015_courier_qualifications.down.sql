@@ -0,0 +1,5 @@
+alter table orders
+    drop column restrictions;
+
+alter table couriers
+    drop column qualifications;
\ No newline at end of file
@@ -0,0 +1,5 @@
+alter table orders
+    add restrictions varchar(32)[] not null default '{}';
+
+alter table couriers
+    add qualifications varchar(32)[] not null default '{}';
\ No newline at end of file
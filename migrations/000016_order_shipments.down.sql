@@ -0,0 +1,4 @@
+drop index orders_parent_id_idx;
+
+alter table orders
+    drop column parent_id;
\ No newline at end of file
@@ -0,0 +1,5 @@
+alter table orders
+    add parent_id uuid null;
+
+create index orders_parent_id_idx
+    on orders (parent_id);
\ No newline at end of file
@@ -0,0 +1,2 @@
+alter table orders
+    drop column assigned_by;
\ No newline at end of file
@@ -0,0 +1,2 @@
+alter table orders
+    add assigned_by varchar(255) not null default '';
\ No newline at end of file
@@ -0,0 +1,2 @@
+alter table couriers
+    drop column location_reported_at;
\ No newline at end of file
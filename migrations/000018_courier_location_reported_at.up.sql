@@ -0,0 +1,2 @@
+alter table couriers
+    add location_reported_at TIMESTAMP with time zone null;
\ No newline at end of file
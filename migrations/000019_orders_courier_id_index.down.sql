@@ -0,0 +1 @@
+drop index orders_courier_id_idx;
\ No newline at end of file
@@ -0,0 +1,2 @@
+create index orders_courier_id_idx
+    on orders (courier_id);
\ No newline at end of file
@@ -0,0 +1,13 @@
+alter table couriers
+    drop column location_lat,
+    drop column location_lon,
+    drop column home_depot_lat,
+    drop column home_depot_lon;
+
+alter table orders
+    drop column location_lat,
+    drop column location_lon,
+    drop column pickup_location_lat,
+    drop column pickup_location_lon,
+    drop column return_location_lat,
+    drop column return_location_lon;
\ No newline at end of file
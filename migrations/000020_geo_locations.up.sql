@@ -0,0 +1,13 @@
+alter table couriers
+    add location_lat double precision null,
+    add location_lon double precision null,
+    add home_depot_lat double precision null,
+    add home_depot_lon double precision null;
+
+alter table orders
+    add location_lat double precision null,
+    add location_lon double precision null,
+    add pickup_location_lat double precision null,
+    add pickup_location_lon double precision null,
+    add return_location_lat double precision null,
+    add return_location_lon double precision null;
\ No newline at end of file